@@ -0,0 +1,7 @@
+data "pfsense_dnsresolver_domainoverride" "this" {
+  domain = "example.com"
+}
+
+output "domainoverride" {
+  value = data.pfsense_dnsresolver_domainoverride.this.ip_address
+}
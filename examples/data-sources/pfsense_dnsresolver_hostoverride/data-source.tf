@@ -0,0 +1,7 @@
+data "pfsense_dnsresolver_hostoverride" "this" {
+  fqdn = "host.example.com"
+}
+
+output "hostoverride" {
+  value = data.pfsense_dnsresolver_hostoverride.this.ip_addresses
+}
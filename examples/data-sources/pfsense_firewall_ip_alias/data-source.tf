@@ -0,0 +1,7 @@
+data "pfsense_firewall_ip_alias" "this" {
+  name = "example"
+}
+
+output "ip_alias" {
+  value = data.pfsense_firewall_ip_alias.this.entries
+}
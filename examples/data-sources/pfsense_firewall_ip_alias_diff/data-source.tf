@@ -0,0 +1,12 @@
+data "pfsense_firewall_ip_alias_diff" "this" {
+  name = "dns_servers"
+  type = "host"
+  entries = [
+    { address = "1.1.1.1" },
+    { address = "1.0.0.1" },
+  ]
+}
+
+output "dns_servers_matches" {
+  value = data.pfsense_firewall_ip_alias_diff.this.matches
+}
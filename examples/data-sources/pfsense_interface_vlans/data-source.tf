@@ -0,0 +1,5 @@
+data "pfsense_interface_vlans" "this" {}
+
+output "vlans" {
+  value = data.pfsense_interface_vlans.this.all
+}
@@ -0,0 +1,7 @@
+data "pfsense_system_certificate" "this" {
+  refid = "6531d2f7a1b3c"
+}
+
+output "webgui_certificate" {
+  value = data.pfsense_system_certificate.this.certificate
+}
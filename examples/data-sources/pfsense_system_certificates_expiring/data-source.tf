@@ -0,0 +1,7 @@
+data "pfsense_system_certificates_expiring" "this" {
+  threshold = "336h"
+}
+
+output "certificates_expiring" {
+  value = data.pfsense_system_certificates_expiring.this.certificates
+}
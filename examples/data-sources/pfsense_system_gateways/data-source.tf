@@ -0,0 +1,5 @@
+data "pfsense_system_gateways" "this" {}
+
+output "gateways" {
+  value = data.pfsense_system_gateways.this.all
+}
@@ -0,0 +1,5 @@
+data "pfsense_system_info" "this" {}
+
+output "disk_usage" {
+  value = data.pfsense_system_info.this.disk_usage
+}
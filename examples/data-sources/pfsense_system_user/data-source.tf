@@ -0,0 +1,7 @@
+data "pfsense_system_user" "admin" {
+  name = "admin"
+}
+
+output "admin_groups" {
+  value = data.pfsense_system_user.admin.groups
+}
@@ -0,0 +1,10 @@
+resource "pfsense_dnsresolver_configfile" "example" {
+  name = "wildcard-record-example"
+  content = provider::pfsense::dnsresolver_configfile_content([
+    {
+      name    = "subdomain.example.com"
+      type    = "redirect"
+      records = ["subdomain.example.com 3600 IN A 10.10.10.10"]
+    },
+  ])
+}
@@ -0,0 +1,7 @@
+data "pfsense_firewall_aliases" "all" {}
+
+output "blocklist" {
+  value = provider::pfsense::firewall_ip_alias_blocklist("blocked", {
+    for alias in data.pfsense_firewall_aliases.all.ip : alias.name => alias.entries[*].address
+  })
+}
@@ -0,0 +1,8 @@
+resource "pfsense_firewall_rule" "example" {
+  interface = "lan"
+  protocol  = "tcp"
+  destination = {
+    address = "10.0.0.10"
+    port    = provider::pfsense::firewall_service_port("https")
+  }
+}
@@ -17,3 +17,10 @@ provider "pfsense" {
   username = "some-user"
   password = var.pfsense_password
 }
+
+# self-signed Web GUI certificate, verified with its CA
+provider "pfsense" {
+  url            = "https://pfsense.lan"
+  password       = var.pfsense_password
+  ca_certificate = file("pfsense-ca.pem")
+}
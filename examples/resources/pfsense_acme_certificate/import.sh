@@ -0,0 +1 @@
+terraform import pfsense_acme_certificate.example pfsense_lan
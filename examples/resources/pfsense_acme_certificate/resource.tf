@@ -0,0 +1,16 @@
+resource "pfsense_acme_certificate" "example" {
+  name        = "pfsense_lan"
+  description = "web GUI certificate"
+  account     = "letsencrypt"
+  domains = [
+    {
+      name   = "pfsense.example.com"
+      method = "dns_cf"
+      credentials = {
+        dns_cfToken     = var.cloudflare_token
+        dns_cfAccountID = var.cloudflare_account_id
+      }
+    },
+  ]
+  issue_timeout = "10m"
+}
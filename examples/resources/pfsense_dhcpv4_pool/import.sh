@@ -0,0 +1 @@
+terraform import pfsense_dhcpv4_pool.example lan
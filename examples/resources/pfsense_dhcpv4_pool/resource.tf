@@ -0,0 +1,8 @@
+resource "pfsense_dhcpv4_pool" "this" {
+  interface  = "lan"
+  range_from = "192.168.1.100"
+  range_to   = "192.168.1.199"
+  additional_pools = [
+    { range_from = "192.168.1.220", range_to = "192.168.1.239" },
+  ]
+}
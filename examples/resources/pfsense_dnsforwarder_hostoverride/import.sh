@@ -0,0 +1,2 @@
+# specify in format 'host,domain'
+terraform import pfsense_dnsforwarder_hostoverride.example bar.baz,foo.com
@@ -0,0 +1,12 @@
+resource "pfsense_dnsforwarder_hostoverride" "example" {
+  host        = "foobar"
+  domain      = "example.com"
+  ip_address  = "1.1.1.1"
+  description = "an example"
+  aliases = [
+    {
+      host   = "second"
+      domain = "example.com"
+    },
+  ]
+}
@@ -6,11 +6,16 @@ resource "pfsense_dnsresolver_hostoverride" "example" {
   apply        = false
 }
 
+resource "pfsense_dnsresolver_domainoverride" "example" {
+  domain     = "corp.example.com"
+  ip_address = "10.20.20.20"
+  apply      = false
+}
+
 # apply once
 resource "pfsense_dnsresolver_apply" "example" {
-  lifecycle {
-    replace_triggered_by = [
-      pfsense_dnsresolver_hostoverride.example,
-    ]
-  }
+  triggers = merge(
+    { for k, v in pfsense_dnsresolver_hostoverride.example : "host_${k}" => jsonencode(v) },
+    { domain = jsonencode(pfsense_dnsresolver_domainoverride.example) },
+  )
 }
@@ -0,0 +1,14 @@
+# files removed from the list are deleted, other files in /var/unbound/conf.d are left unchanged
+resource "pfsense_dnsresolver_configfiles" "example" {
+  files = [
+    {
+      name    = "wildcard-record-example"
+      content = <<-EOT
+      server:
+      local-zone: "subdomain.example.com" redirect
+      local-data: "subdomain.example.com 3600 IN A 10.10.10.10"
+      EOT
+    },
+  ]
+  validate = true
+}
@@ -0,0 +1 @@
+terraform import pfsense_dnsresolver_domainoverride_group.example example.com
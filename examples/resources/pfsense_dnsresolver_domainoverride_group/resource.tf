@@ -0,0 +1,18 @@
+resource "pfsense_dnsresolver_domainoverride_group" "example" {
+  domain      = "servers.example.com"
+  description = "redundant DHCP/DNS for servers"
+  upstreams = [
+    { ip_address = "10.10.10.1:53" },
+    { ip_address = "10.10.10.2:53" },
+  ]
+}
+
+# SSL/TLS
+resource "pfsense_dnsresolver_domainoverride_group" "tls_example" {
+  domain      = "secure.example.com"
+  tls_queries = true
+  upstreams = [
+    { ip_address = "192.168.2.1:853", tls_hostname = "dns1.example.com" },
+    { ip_address = "192.168.2.2:853", tls_hostname = "dns2.example.com" },
+  ]
+}
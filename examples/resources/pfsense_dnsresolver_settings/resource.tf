@@ -0,0 +1,9 @@
+resource "pfsense_dnsresolver_settings" "example" {
+  listen_interfaces    = ["lan", "lo0"]
+  dnssec               = true
+  include_config_files = true
+  custom_options       = <<-EOT
+  server:
+  log-queries: yes
+  EOT
+}
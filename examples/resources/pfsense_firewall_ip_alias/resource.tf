@@ -31,3 +31,10 @@ resource "pfsense_firewall_ip_alias" "mixed_example" {
     { address = "ipcam01.lan" },
   ]
 }
+
+# unordered addresses example
+resource "pfsense_firewall_ip_alias" "addresses_example" {
+  name      = "dns_servers"
+  type      = "host"
+  addresses = ["1.1.1.1", "8.8.8.8", "9.9.9.9"]
+}
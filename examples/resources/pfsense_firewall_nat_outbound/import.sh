@@ -0,0 +1 @@
+terraform import pfsense_firewall_nat_outbound.example "VoIP phones"
@@ -0,0 +1,13 @@
+resource "pfsense_firewall_nat_outbound_mode" "example" {
+  mode = "hybrid"
+}
+
+resource "pfsense_firewall_nat_outbound" "example" {
+  description         = "VoIP phones"
+  interface           = "wan"
+  source              = "192.168.10.0/24"
+  translation_address = "203.0.113.10"
+  static_port         = true
+
+  depends_on = [pfsense_firewall_nat_outbound_mode.example]
+}
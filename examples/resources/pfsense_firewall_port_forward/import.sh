@@ -0,0 +1,5 @@
+# import by description
+terraform import pfsense_firewall_port_forward.example "web server"
+
+# import by control ID (position on the port forward page, starting at 0)
+terraform import pfsense_firewall_port_forward.example "id:3"
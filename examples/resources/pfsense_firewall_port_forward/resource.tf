@@ -0,0 +1,11 @@
+resource "pfsense_firewall_port_forward" "this" {
+  description = "web server"
+  interface   = "wan"
+  protocol    = "tcp"
+  destination = {
+    network = "wanip"
+    port    = "443"
+  }
+  target      = "10.0.0.10"
+  target_port = "443"
+}
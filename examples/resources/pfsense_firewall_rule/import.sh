@@ -0,0 +1 @@
+terraform import pfsense_firewall_rule.example 1700000000
@@ -0,0 +1,13 @@
+resource "pfsense_firewall_rule" "this" {
+  interface = "lan"
+  action    = "pass"
+  protocol  = "tcp"
+  source = {
+    network = "lan"
+  }
+  destination = {
+    address = "10.0.0.10"
+    port    = "443"
+  }
+  description = "allow HTTPS to web server"
+}
@@ -0,0 +1 @@
+terraform import pfsense_firewall_schedule.example business_hours
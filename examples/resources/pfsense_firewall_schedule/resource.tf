@@ -0,0 +1,17 @@
+resource "pfsense_firewall_schedule" "example" {
+  name        = "business_hours"
+  description = "weekday business hours"
+  time_ranges = [
+    {
+      days_of_week = [1, 2, 3, 4, 5]
+      start_time   = "08:00"
+      stop_time    = "17:00"
+    },
+    {
+      dates       = ["12-24", "12-31"]
+      start_time  = "08:00"
+      stop_time   = "12:00"
+      description = "holiday eves"
+    },
+  ]
+}
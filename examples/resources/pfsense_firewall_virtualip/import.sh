@@ -0,0 +1 @@
+terraform import pfsense_firewall_virtualip.example 192.168.1.1/24,1
@@ -0,0 +1,9 @@
+resource "pfsense_firewall_virtualip" "example" {
+  mode        = "carp"
+  interface   = "lan"
+  subnet      = "192.168.1.1/24"
+  vhid        = 1
+  advskew     = 0
+  password    = "example"
+  description = "LAN gateway"
+}
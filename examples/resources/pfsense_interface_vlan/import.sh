@@ -0,0 +1 @@
+terraform import pfsense_interface_vlan.example igb0.10
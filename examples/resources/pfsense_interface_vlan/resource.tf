@@ -0,0 +1,5 @@
+resource "pfsense_interface_vlan" "example" {
+  parent      = "igb0"
+  tag         = 10
+  description = "iot"
+}
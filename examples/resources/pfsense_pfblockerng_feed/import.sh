@@ -0,0 +1 @@
+terraform import pfsense_pfblockerng_feed.example ipv4/feed_name
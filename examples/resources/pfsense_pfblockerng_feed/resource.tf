@@ -0,0 +1,24 @@
+resource "pfsense_pfblockerng_feed" "ip" {
+  type        = "ipv4"
+  name        = "example_ip"
+  description = "Example IPv4 feed"
+  action      = "Deny_Inbound"
+  sources = [
+    {
+      url    = "https://www.spamhaus.org/drop/drop.txt"
+      header = "Spamhaus_DROP"
+    },
+  ]
+}
+
+resource "pfsense_pfblockerng_feed" "dnsbl" {
+  type   = "dnsbl"
+  name   = "example_dnsbl"
+  action = "unbound"
+  sources = [
+    {
+      url    = "https://raw.githubusercontent.com/StevenBlack/hosts/master/hosts"
+      header = "StevenBlack_ADs"
+    },
+  ]
+}
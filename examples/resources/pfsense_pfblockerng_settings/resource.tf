@@ -0,0 +1,3 @@
+resource "pfsense_pfblockerng_settings" "example" {
+  enable = true
+}
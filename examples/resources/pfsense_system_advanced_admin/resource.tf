@@ -0,0 +1,4 @@
+resource "pfsense_system_advanced_admin" "example" {
+  disable_http_redirect      = false
+  disable_http_referer_check = true
+}
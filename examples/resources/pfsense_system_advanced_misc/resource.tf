@@ -0,0 +1,6 @@
+resource "pfsense_system_advanced_misc" "this" {
+  crypto_hardware  = "aesni"
+  thermal_hardware = "coretemp"
+  power_saving     = true
+  power_ac_mode    = "max"
+}
@@ -0,0 +1,4 @@
+resource "pfsense_system_advanced_network_ipv6" "example" {
+  allow_ipv6  = true
+  prefer_ipv4 = true
+}
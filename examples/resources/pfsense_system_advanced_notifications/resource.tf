@@ -0,0 +1,9 @@
+resource "pfsense_system_advanced_notifications" "this" {
+  smtp_server   = "smtp.example.com"
+  smtp_port     = 465
+  smtp_secure   = true
+  smtp_from     = "pfsense@example.com"
+  smtp_to       = "admin@example.com"
+  smtp_username = "pfsense@example.com"
+  smtp_password = var.smtp_password
+}
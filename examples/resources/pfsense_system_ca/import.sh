@@ -0,0 +1 @@
+terraform import pfsense_system_ca.example 6531d2f7a1b3c
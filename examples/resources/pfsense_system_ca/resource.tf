@@ -0,0 +1,5 @@
+resource "pfsense_system_ca" "example" {
+  description = "internal"
+  method      = "internal"
+  common_name = "internal-ca"
+}
@@ -0,0 +1,5 @@
+resource "pfsense_system_certificate" "example" {
+  description = "webgui"
+  certificate = file("webgui.crt")
+  private_key = file("webgui.key")
+}
@@ -0,0 +1,4 @@
+resource "pfsense_system_crypto_module" "this" {
+  crypto_hardware  = "aesni"
+  thermal_hardware = "coretemp"
+}
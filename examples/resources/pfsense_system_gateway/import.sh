@@ -0,0 +1 @@
+terraform import pfsense_system_gateway.example LAN_ROUTER
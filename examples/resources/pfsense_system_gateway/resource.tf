@@ -0,0 +1,6 @@
+resource "pfsense_system_gateway" "example" {
+  name        = "LAN_ROUTER"
+  interface   = "lan"
+  address     = "192.168.1.254"
+  description = "internal router"
+}
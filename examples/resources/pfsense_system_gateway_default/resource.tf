@@ -0,0 +1,4 @@
+resource "pfsense_system_gateway_default" "this" {
+  ipv4 = "WAN_DHCP"
+  ipv6 = "automatic"
+}
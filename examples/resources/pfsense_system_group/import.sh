@@ -0,0 +1 @@
+terraform import pfsense_system_group.example operators
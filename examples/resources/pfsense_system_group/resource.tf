@@ -0,0 +1,5 @@
+resource "pfsense_system_group" "example" {
+  name        = "operators"
+  description = "dashboard access"
+  privileges  = ["page-dashboard-all"]
+}
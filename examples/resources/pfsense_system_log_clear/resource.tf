@@ -0,0 +1,9 @@
+# clear the firewall log whenever the rule changes
+resource "pfsense_system_log_clear" "example" {
+  log = "filter"
+  lifecycle {
+    replace_triggered_by = [
+      pfsense_firewall_rule.example,
+    ]
+  }
+}
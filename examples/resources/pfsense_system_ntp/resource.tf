@@ -0,0 +1,5 @@
+resource "pfsense_system_ntp" "example" {
+  time_servers      = ["0.pfsense.pool.ntp.org", "1.pfsense.pool.ntp.org"]
+  listen_interfaces = ["lan"]
+  timezone          = "America/New_York"
+}
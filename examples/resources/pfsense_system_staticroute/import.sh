@@ -0,0 +1 @@
+terraform import pfsense_system_staticroute.example 10.20.0.0/16
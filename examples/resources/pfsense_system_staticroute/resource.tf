@@ -0,0 +1,5 @@
+resource "pfsense_system_staticroute" "example" {
+  network     = "10.20.0.0/16"
+  gateway     = "LAN_GW"
+  description = "branch office"
+}
@@ -0,0 +1,5 @@
+resource "pfsense_system_syslog" "example" {
+  enable_remote  = true
+  remote_servers = ["10.0.0.10:514", "10.0.0.11:514"]
+  categories     = ["system", "firewall", "auth"]
+}
@@ -0,0 +1 @@
+terraform import pfsense_system_tunable.example net.inet.tcp.tso
@@ -0,0 +1,5 @@
+resource "pfsense_system_tunable" "example" {
+  name        = "net.inet.tcp.tso"
+  value       = "0"
+  description = "disable TCP segmentation offload"
+}
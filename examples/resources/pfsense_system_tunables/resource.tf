@@ -0,0 +1,7 @@
+resource "pfsense_system_tunables" "example" {
+  tunables = {
+    "net.inet.tcp.tso"              = "0"
+    "kern.ipc.maxsockbuf"           = "16777216"
+    "net.inet.ip.intr_queue_maxlen" = "2048"
+  }
+}
@@ -0,0 +1 @@
+terraform import pfsense_system_user.example jdoe
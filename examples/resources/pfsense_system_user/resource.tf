@@ -0,0 +1,7 @@
+resource "pfsense_system_user" "example" {
+  name       = "jdoe"
+  password   = var.jdoe_password
+  full_name  = "Jane Doe"
+  groups     = [pfsense_system_group.example.name]
+  privileges = ["user-shell-access"]
+}
@@ -0,0 +1 @@
+terraform import pfsense_system_user_authentication_server.example server_name
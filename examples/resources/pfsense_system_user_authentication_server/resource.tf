@@ -0,0 +1,24 @@
+resource "pfsense_system_user_authentication_server" "radius" {
+  name = "example-radius"
+  type = "radius"
+  host = "radius.example.com"
+  radius = {
+    secret          = var.radius_secret
+    accounting_port = 1813
+  }
+}
+
+resource "pfsense_system_user_authentication_server" "ldap" {
+  name = "example-ldap"
+  type = "ldap"
+  host = "ldap.example.com"
+  ldap = {
+    transport       = "SSL/TLS Encrypted"
+    port            = 636
+    scope           = "subtree"
+    base_dn         = "dc=example,dc=com"
+    auth_containers = "ou=users,dc=example,dc=com"
+    bind_dn         = "cn=pfsense,ou=services,dc=example,dc=com"
+    bind_password   = var.ldap_bind_password
+  }
+}
@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &ACMECertificateResource{}
+var _ resource.ResourceWithImportState = &ACMECertificateResource{}
+
+func NewACMECertificateResource() resource.Resource {
+	return &ACMECertificateResource{}
+}
+
+type ACMECertificateResource struct {
+	client *pfsense.Client
+}
+
+type ACMECertificateResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Enabled      types.Bool   `tfsdk:"enabled"`
+	Account      types.String `tfsdk:"account"`
+	KeyLength    types.String `tfsdk:"key_length"`
+	RenewAfter   types.Int64  `tfsdk:"renew_after"`
+	Domains      types.List   `tfsdk:"domains"`
+	Issue        types.Bool   `tfsdk:"issue"`
+	IssueTimeout types.String `tfsdk:"issue_timeout"`
+	LastRenewal  types.String `tfsdk:"last_renewal"`
+}
+
+type ACMECertificateDomainResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Method      types.String `tfsdk:"method"`
+	Credentials types.Map    `tfsdk:"credentials"`
+}
+
+func (r ACMECertificateDomainResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"method":      types.StringType,
+		"credentials": types.MapType{ElemType: types.StringType},
+	}}
+}
+
+func (r *ACMECertificateResourceModel) SetFromValue(ctx context.Context, cert *pfsense.ACMECertificate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(cert.Name)
+
+	if cert.Description != "" {
+		r.Description = types.StringValue(cert.Description)
+	}
+
+	r.Enabled = types.BoolValue(cert.Enabled)
+	r.Account = types.StringValue(cert.Account)
+	r.KeyLength = types.StringValue(cert.KeyLength)
+	r.RenewAfter = types.Int64Value(int64(cert.RenewAfter))
+
+	r.LastRenewal = types.StringNull()
+	if cert.LastRenewal != nil {
+		r.LastRenewal = types.StringValue(cert.LastRenewal.Format(time.RFC3339))
+	}
+
+	var prevDomainModels []ACMECertificateDomainResourceModel
+	if !r.Domains.IsNull() && !r.Domains.IsUnknown() {
+		diags = r.Domains.ElementsAs(ctx, &prevDomainModels, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	domains := []ACMECertificateDomainResourceModel{}
+	for i, domain := range cert.Domains {
+		domainModel := ACMECertificateDomainResourceModel{
+			Name:        types.StringValue(domain.Name),
+			Method:      types.StringValue(domain.Method),
+			Credentials: types.MapNull(types.StringType),
+		}
+
+		// only configured credentials are tracked, the remaining method settings are defaults of the package
+		if i < len(prevDomainModels) && !prevDomainModels[i].Credentials.IsNull() {
+			var prevCredentials map[string]string
+			diags = prevDomainModels[i].Credentials.ElementsAs(ctx, &prevCredentials, false)
+			if diags.HasError() {
+				return diags
+			}
+
+			credentials := map[string]string{}
+			for key := range prevCredentials {
+				if value, ok := domain.Credentials[key]; ok {
+					credentials[key] = value
+				}
+			}
+
+			domainModel.Credentials, diags = types.MapValueFrom(ctx, types.StringType, credentials)
+			if diags.HasError() {
+				return diags
+			}
+		}
+
+		domains = append(domains, domainModel)
+	}
+
+	r.Domains, diags = types.ListValueFrom(ctx, ACMECertificateDomainResourceModel{}.GetAttrType(), domains)
+	return diags
+}
+
+func (r ACMECertificateResourceModel) Value(ctx context.Context) (*pfsense.ACMECertificate, diag.Diagnostics) {
+	var cert pfsense.ACMECertificate
+	var err error
+	var diags diag.Diagnostics
+
+	var domainModels []*ACMECertificateDomainResourceModel
+	diags = r.Domains.ElementsAs(ctx, &domainModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = cert.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = cert.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = cert.SetEnabled(r.Enabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enabled"),
+			"Enabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = cert.SetAccount(r.Account.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("account"),
+			"Account cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = cert.SetKeyLength(r.KeyLength.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("key_length"),
+			"Key length cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = cert.SetRenewAfter(int(r.RenewAfter.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("renew_after"),
+			"Renew after cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	for i, domainModel := range domainModels {
+		var domain pfsense.ACMECertificateDomain
+
+		err = domain.SetName(domainModel.Name.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("domains").AtListIndex(i).AtName("name"),
+				"Domain name cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = domain.SetMethod(domainModel.Method.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("domains").AtListIndex(i).AtName("method"),
+				"Domain method cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if !domainModel.Credentials.IsNull() {
+			var credentials map[string]string
+			diags.Append(domainModel.Credentials.ElementsAs(ctx, &credentials, false)...)
+
+			err = domain.SetCredentials(credentials)
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("domains").AtListIndex(i).AtName("credentials"),
+					"Domain credentials cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		cert.Domains = append(cert.Domains, domain)
+	}
+
+	return &cert, diags
+}
+
+func (r ACMECertificateResourceModel) issueTimeout() (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	timeout, err := time.ParseDuration(r.IssueTimeout.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("issue_timeout"),
+			"Issue timeout cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return timeout, diags
+}
+
+func (r *ACMECertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_acme_certificate", req.ProviderTypeName)
+}
+
+func (r *ACMECertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "ACME certificate, a certificate issued and renewed by an ACME certificate authority (e.g. Let's Encrypt) and stored in the certificate manager. Requires the ACME package and an existing account key. Destroying the resource removes the certificate definition, issued certificates are left in the certificate manager.",
+		MarkdownDescription: "[ACME](https://docs.netgate.com/pfsense/en/latest/packages/acme/index.html) certificate, a certificate issued and renewed by an ACME certificate authority (e.g. Let's Encrypt) and stored in the certificate manager. Requires the ACME package and an existing account key. Destroying the resource removes the certificate definition, issued certificates are left in the certificate manager.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of certificate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description:         "Enable certificate, disabled certificates are not renewed, defaults to 'true'.",
+				MarkdownDescription: "Enable certificate, disabled certificates are not renewed, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"account": schema.StringAttribute{
+				Description: "Name of the account key used to register with the certificate authority.",
+				Required:    true,
+			},
+			"key_length": schema.StringAttribute{
+				Description:         fmt.Sprintf("Private key length, options: '%s', defaults to '%s'.", strings.Join(pfsense.ACMEKeyLengths(), "', '"), pfsense.DefaultACMEKeyLength),
+				MarkdownDescription: fmt.Sprintf("Private key length, options: `%s`, defaults to `%s`.", strings.Join(pfsense.ACMEKeyLengths(), "`, `"), pfsense.DefaultACMEKeyLength),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(pfsense.DefaultACMEKeyLength),
+			},
+			"renew_after": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Number of days after which the certificate is renewed, defaults to '%d'.", pfsense.DefaultACMERenewAfter),
+				MarkdownDescription: fmt.Sprintf("Number of days after which the certificate is renewed, defaults to `%d`.", pfsense.DefaultACMERenewAfter),
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(pfsense.DefaultACMERenewAfter),
+			},
+			"domains": schema.ListNestedAttribute{
+				Description: "Domain(s) of certificate, the first domain is the common name.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Domain name (e.g. 'www.example.com' or '*.example.com').",
+							Required:    true,
+						},
+						"method": schema.StringAttribute{
+							Description:         "Challenge method, 'standalone', 'webroot', or a DNS API of acme.sh (e.g. 'dns_cf').",
+							MarkdownDescription: "Challenge method, `standalone`, `webroot`, or a DNS API of acme.sh (e.g. `dns_cf`).",
+							Required:            true,
+						},
+						"credentials": schema.MapAttribute{
+							Description:         "Method specific settings keyed by ACME package field name (e.g. 'dns_cfToken').",
+							MarkdownDescription: "Method specific settings keyed by ACME package field name (e.g. `dns_cfToken`).",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+			"issue": schema.BoolAttribute{
+				Description:         "Issue the certificate after it is created or changed, defaults to 'true'.",
+				MarkdownDescription: "Issue the certificate after it is created or changed, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"issue_timeout": schema.StringAttribute{
+				Description:         fmt.Sprintf("Time to wait for issuance to complete (e.g. '10m'), DNS challenges may wait for propagation, defaults to '%s'.", pfsense.DefaultACMEIssueTimeout),
+				MarkdownDescription: fmt.Sprintf("Time to wait for issuance to complete (e.g. `10m`), DNS challenges may wait for propagation, defaults to `%s`.", pfsense.DefaultACMEIssueTimeout),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(pfsense.DefaultACMEIssueTimeout.String()),
+			},
+			"last_renewal": schema.StringAttribute{
+				Description: "Time of the last successful issuance or renewal, empty if never issued.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *ACMECertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ACMECertificateResource) issue(ctx context.Context, data *ACMECertificateResourceModel, diags *diag.Diagnostics) {
+	timeout, d := data.issueTimeout()
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	cert, err := r.client.IssueACMECertificate(ctx, data.Name.ValueString(), timeout)
+	if addError(diags, "Error issuing ACME certificate", err) {
+		return
+	}
+
+	diags.Append(data.SetFromValue(ctx, cert)...)
+}
+
+func (r *ACMECertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ACMECertificateResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.CreateACMECertificate(ctx, *certReq)
+	if addError(&resp.Diagnostics, "Error creating ACME certificate", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Issue.ValueBool() {
+		r.issue(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *ACMECertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ACMECertificateResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.GetACMECertificate(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading ACME certificate", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ACMECertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ACMECertificateResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.UpdateACMECertificate(ctx, *certReq)
+	if addError(&resp.Diagnostics, "Error updating ACME certificate", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Issue.ValueBool() {
+		r.issue(ctx, data, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+func (r *ACMECertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ACMECertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteACMECertificate(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting ACME certificate", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ACMECertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
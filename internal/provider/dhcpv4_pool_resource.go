@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DHCPv4PoolResource{}
+var _ resource.ResourceWithImportState = &DHCPv4PoolResource{}
+
+func NewDHCPv4PoolResource() resource.Resource {
+	return &DHCPv4PoolResource{}
+}
+
+type DHCPv4PoolResource struct {
+	client *pfsense.Client
+}
+
+type DHCPv4PoolResourceModel struct {
+	Interface       types.String `tfsdk:"interface"`
+	RangeFrom       types.String `tfsdk:"range_from"`
+	RangeTo         types.String `tfsdk:"range_to"`
+	AdditionalPools types.List   `tfsdk:"additional_pools"`
+	Apply           types.Bool   `tfsdk:"apply"`
+}
+
+type DHCPv4PoolRangeResourceModel struct {
+	RangeFrom types.String `tfsdk:"range_from"`
+	RangeTo   types.String `tfsdk:"range_to"`
+}
+
+func (r DHCPv4PoolRangeResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"range_from": types.StringType,
+		"range_to":   types.StringType,
+	}}
+}
+
+func (r *DHCPv4PoolResourceModel) SetFromValue(ctx context.Context, pool *pfsense.DHCPv4Pool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Interface = types.StringValue(pool.Interface)
+	r.RangeFrom = types.StringValue(pool.RangeFrom.String())
+	r.RangeTo = types.StringValue(pool.RangeTo.String())
+
+	// additional pools are only managed when configured
+	if r.AdditionalPools.IsNull() {
+		return diags
+	}
+
+	ranges := []DHCPv4PoolRangeResourceModel{}
+	for _, poolRange := range pool.AdditionalPools {
+		ranges = append(ranges, DHCPv4PoolRangeResourceModel{
+			RangeFrom: types.StringValue(poolRange.RangeFrom.String()),
+			RangeTo:   types.StringValue(poolRange.RangeTo.String()),
+		})
+	}
+
+	r.AdditionalPools, diags = types.ListValueFrom(ctx, DHCPv4PoolRangeResourceModel{}.GetAttrType(), ranges)
+
+	return diags
+}
+
+func (r DHCPv4PoolResourceModel) Value(ctx context.Context) (*pfsense.DHCPv4Pool, diag.Diagnostics) {
+	var pool pfsense.DHCPv4Pool
+	var err error
+	var diags diag.Diagnostics
+
+	err = pool.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pool.SetRangeFrom(r.RangeFrom.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("range_from"),
+			"Range from cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pool.SetRangeTo(r.RangeTo.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("range_to"),
+			"Range to cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if r.AdditionalPools.IsNull() {
+		return &pool, diags
+	}
+
+	var rangeModels []*DHCPv4PoolRangeResourceModel
+	diags.Append(r.AdditionalPools.ElementsAs(ctx, &rangeModels, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ranges := []pfsense.DHCPv4PoolRange{}
+	for i, rangeModel := range rangeModels {
+		var poolRange pfsense.DHCPv4PoolRange
+
+		err = poolRange.SetRangeFrom(rangeModel.RangeFrom.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("additional_pools").AtListIndex(i).AtName("range_from"),
+				"Additional pool range from cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = poolRange.SetRangeTo(rangeModel.RangeTo.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("additional_pools").AtListIndex(i).AtName("range_to"),
+				"Additional pool range to cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		ranges = append(ranges, poolRange)
+	}
+
+	err = pool.SetAdditionalPools(ranges)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("additional_pools"),
+			"Additional pools cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &pool, diags
+}
+
+func (r *DHCPv4PoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dhcpv4_pool", req.ProviderTypeName)
+}
+
+func (r *DHCPv4PoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DHCPv4 server address pools of an interface. Destroying the resource leaves the pools unchanged.",
+		MarkdownDescription: "[DHCPv4 server](https://docs.netgate.com/pfsense/en/latest/services/dhcp/ipv4.html) address pools of an interface. Destroying the resource leaves the pools unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"interface": schema.StringAttribute{
+				Description:         "Interface of the DHCPv4 server (e.g. 'lan', 'opt1').",
+				MarkdownDescription: "Interface of the DHCPv4 server (e.g. `lan`, `opt1`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"range_from": schema.StringAttribute{
+				Description: "First IPv4 address of the pool, must be within the interface subnet.",
+				Required:    true,
+			},
+			"range_to": schema.StringAttribute{
+				Description: "Last IPv4 address of the pool, must be within the interface subnet.",
+				Required:    true,
+			},
+			"additional_pools": schema.ListNestedAttribute{
+				Description: "Additional address pools, matched by position. Pools not covered by the list are removed, leave unset to not manage additional pools.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"range_from": schema.StringAttribute{
+							Description: "First IPv4 address of the pool, must be within the interface subnet.",
+							Required:    true,
+						},
+						"range_to": schema.StringAttribute{
+							Description: "Last IPv4 address of the pool, must be within the interface subnet.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DHCPv4PoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DHCPv4PoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DHCPv4PoolResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.UpdateDHCPv4Pool(ctx, *poolReq)
+	if addError(&resp.Diagnostics, "Error creating DHCPv4 pool", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, pool)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDHCPv4Changes(ctx, pool.Interface)
+		if addError(&resp.Diagnostics, "Error applying DHCPv4 pool", err) {
+			return
+		}
+	}
+}
+
+func (r *DHCPv4PoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DHCPv4PoolResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.GetDHCPv4Pool(ctx, data.Interface.ValueString())
+	if addError(&resp.Diagnostics, "Error reading DHCPv4 pool", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, pool)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DHCPv4PoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DHCPv4PoolResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.UpdateDHCPv4Pool(ctx, *poolReq)
+	if addError(&resp.Diagnostics, "Error updating DHCPv4 pool", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, pool)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDHCPv4Changes(ctx, pool.Interface)
+		if addError(&resp.Diagnostics, "Error applying DHCPv4 pool", err) {
+			return
+		}
+	}
+}
+
+func (r *DHCPv4PoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DHCPv4PoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("interface"), req, resp)
+}
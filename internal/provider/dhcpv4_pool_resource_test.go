@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDHCPv4PoolResource edits the primary range and adds a secondary pool, the LAN interface is expected to use
+// the default 192.168.1.1/24 address. The last step removes the secondary pool as destroying leaves pools unchanged.
+func TestAccDHCPv4PoolResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_dhcpv4_pool" "test" {
+  interface  = "lan"
+  range_from = "192.168.1.100"
+  range_to   = "192.168.1.149"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "range_from", "192.168.1.100"),
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "range_to", "192.168.1.149"),
+					resource.TestCheckNoResourceAttr("pfsense_dhcpv4_pool.test", "additional_pools"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_dhcpv4_pool" "test" {
+  interface  = "lan"
+  range_from = "192.168.1.100"
+  range_to   = "192.168.1.139"
+  additional_pools = [
+    { range_from = "192.168.1.200", range_to = "192.168.1.219" },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "range_to", "192.168.1.139"),
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "additional_pools.#", "1"),
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "additional_pools.0.range_from", "192.168.1.200"),
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "additional_pools.0.range_to", "192.168.1.219"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_dhcpv4_pool.test",
+				ImportState:                          true,
+				ImportStateId:                        "lan",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "interface",
+				ImportStateVerifyIgnore:              []string{"apply", "additional_pools"},
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_dhcpv4_pool" "test" {
+  interface        = "lan"
+  range_from       = "192.168.1.100"
+  range_to         = "192.168.1.199"
+  additional_pools = []
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "range_to", "192.168.1.199"),
+					resource.TestCheckResourceAttr("pfsense_dhcpv4_pool.test", "additional_pools.#", "0"),
+				),
+			},
+		},
+	})
+}
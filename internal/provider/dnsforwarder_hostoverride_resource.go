@@ -0,0 +1,426 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSForwarderHostOverrideResource{}
+var _ resource.ResourceWithImportState = &DNSForwarderHostOverrideResource{}
+
+func NewDNSForwarderHostOverrideResource() resource.Resource {
+	return &DNSForwarderHostOverrideResource{}
+}
+
+type DNSForwarderHostOverrideResource struct {
+	client *pfsense.Client
+}
+
+type DNSForwarderHostOverrideResourceModel struct {
+	Host        types.String `tfsdk:"host"`
+	Domain      types.String `tfsdk:"domain"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	Description types.String `tfsdk:"description"`
+	Apply       types.Bool   `tfsdk:"apply"`
+	FQDN        types.String `tfsdk:"fqdn"`
+	Aliases     types.List   `tfsdk:"aliases"`
+}
+
+type DNSForwarderHostOverrideAliasResourceModel struct {
+	Host        types.String `tfsdk:"host"`
+	Domain      types.String `tfsdk:"domain"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r DNSForwarderHostOverrideAliasResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"host":        types.StringType,
+		"domain":      types.StringType,
+		"description": types.StringType,
+	}}
+}
+
+func (r *DNSForwarderHostOverrideResourceModel) SetFromValue(ctx context.Context, hostOverride *pfsense.HostOverride) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if hostOverride.Host != "" {
+		r.Host = types.StringValue(hostOverride.Host)
+	}
+
+	r.Domain = types.StringValue(hostOverride.Domain)
+
+	// keep the configured address when equivalent, ignoring formatting (e.g. IPv6 compression)
+	if len(hostOverride.IPAddresses) == 1 && !equivalentIPAddresses([]types.String{r.IPAddress}, hostOverride.IPAddresses) {
+		r.IPAddress = types.StringValue(hostOverride.IPAddresses[0].String())
+	}
+
+	if hostOverride.Description != "" {
+		r.Description = types.StringValue(hostOverride.Description)
+	}
+
+	r.FQDN = types.StringValue(hostOverride.FQDN())
+
+	aliases := []DNSForwarderHostOverrideAliasResourceModel{}
+
+	for _, alias := range hostOverride.Aliases {
+		var aliasModel DNSForwarderHostOverrideAliasResourceModel
+
+		if alias.Host != "" {
+			aliasModel.Host = types.StringValue(alias.Host)
+		}
+
+		aliasModel.Domain = types.StringValue(alias.Domain)
+
+		if alias.Description != "" {
+			aliasModel.Description = types.StringValue(alias.Description)
+		}
+
+		aliases = append(aliases, aliasModel)
+	}
+
+	r.Aliases, diags = types.ListValueFrom(ctx, DNSForwarderHostOverrideAliasResourceModel{}.GetAttrType(), aliases)
+
+	return diags
+}
+
+func (r DNSForwarderHostOverrideResourceModel) Value(ctx context.Context) (*pfsense.HostOverride, diag.Diagnostics) {
+	var hostOverride pfsense.HostOverride
+	var err error
+	var diags diag.Diagnostics
+
+	var aliasModels []*DNSForwarderHostOverrideAliasResourceModel
+	diags = r.Aliases.ElementsAs(ctx, &aliasModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !r.Host.IsNull() {
+		err = hostOverride.SetHost(r.Host.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("host"),
+				"Host cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = hostOverride.SetDomain(r.Domain.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("domain"),
+			"Domain cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = hostOverride.SetIPAddresses([]string{r.IPAddress.ValueString()})
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ip_address"),
+			"IP address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = hostOverride.SetDescription(r.Description.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	for i, aliasModel := range aliasModels {
+		var alias pfsense.HostOverrideAlias
+
+		if !aliasModel.Host.IsNull() {
+			err = alias.SetHost(aliasModel.Host.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("aliases").AtListIndex(i).AtName("host"),
+					"Alias host cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		err = alias.SetDomain(aliasModel.Domain.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("aliases").AtListIndex(i).AtName("domain"),
+				"Alias domain cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if !aliasModel.Description.IsNull() {
+			err = alias.SetDescription(aliasModel.Description.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("aliases").AtListIndex(i).AtName("description"),
+					"Alias description cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		hostOverride.Aliases = append(hostOverride.Aliases, alias)
+	}
+
+	return &hostOverride, diags
+}
+
+func (r *DNSForwarderHostOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsforwarder_hostoverride", req.ProviderTypeName)
+}
+
+func (r *DNSForwarderHostOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DNS forwarder (dnsmasq) host override. Host for which the forwarder's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the forwarder.",
+		MarkdownDescription: "DNS forwarder (dnsmasq) [host override](https://docs.netgate.com/pfsense/en/latest/services/dns/forwarder-host-overrides.html). Host for which the forwarder's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the forwarder.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Name of the host, without the domain part.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "Parent domain of the host.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IPv4 or IPv6 address to be returned for the host.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"fqdn": schema.StringAttribute{
+				Description: "Fully qualified domain name of host.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aliases": schema.ListNestedAttribute{
+				Description:         "List of additional names for this host, defaults to '[]'.",
+				MarkdownDescription: "List of additional names for this host, defaults to `[]`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(DNSForwarderHostOverrideAliasResourceModel{}.GetAttrType(), []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "Name of the host, without the domain part.",
+							Optional:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "Parent domain of the host.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSForwarderHostOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSForwarderHostOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSForwarderHostOverrideResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostOverrideReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostOverride, err := r.client.CreateDNSForwarderHostOverride(ctx, *hostOverrideReq)
+	if addError(&resp.Diagnostics, "Error creating host override", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, hostOverride)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSForwarderChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying host override", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSForwarderHostOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSForwarderHostOverrideResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostOverride, err := r.client.GetDNSForwarderHostOverride(ctx, data.FQDN.ValueString())
+	if addError(&resp.Diagnostics, "Error reading host override", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, hostOverride)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSForwarderHostOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSForwarderHostOverrideResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostOverrideReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostOverride, err := r.client.UpdateDNSForwarderHostOverride(ctx, *hostOverrideReq)
+	if addError(&resp.Diagnostics, "Error updating host override", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, hostOverride)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSForwarderChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying host override", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSForwarderHostOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DNSForwarderHostOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSForwarderHostOverride(ctx, data.FQDN.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting host override", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSForwarderChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying host override", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSForwarderHostOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: host,domain. Got: %q", req.ID),
+		)
+		return
+	}
+
+	var ho pfsense.HostOverride
+	var err error
+
+	if idParts[0] != "" {
+		err = ho.SetHost(idParts[0])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Host cannot be parsed",
+				err.Error(),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), ho.Host)...)
+	}
+
+	err = ho.SetDomain(idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Domain cannot be parsed",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), ho.Domain)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fqdn"), ho.FQDN())...)
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccDNSForwarderHostOverrideResourceConfig(ipAddress string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_dnsforwarder_hostoverride" "test" {
+  host        = "tf-acc-forwarder"
+  domain      = "example.com"
+  ip_address  = %q
+  description = "tf acc test"
+  aliases = [
+    {
+      host   = "tf-acc-forwarder-alias"
+      domain = "example.com"
+    },
+    {
+      domain      = "tf-acc-forwarder.example.org"
+      description = "apex"
+    },
+  ]
+}
+`, ipAddress)
+}
+
+// TestAccDNSForwarderHostOverrideResource creates a forwarder host override with aliases, reads it back by FQDN
+// after an update and imports it by host and domain.
+func TestAccDNSForwarderHostOverrideResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSForwarderHostOverrideResourceConfig("192.0.2.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "fqdn", "tf-acc-forwarder.example.com"),
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "ip_address", "192.0.2.20"),
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "aliases.#", "2"),
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "aliases.0.host", "tf-acc-forwarder-alias"),
+					resource.TestCheckNoResourceAttr("pfsense_dnsforwarder_hostoverride.test", "aliases.1.host"),
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "aliases.1.description", "apex"),
+				),
+			},
+			{
+				Config: testAccDNSForwarderHostOverrideResourceConfig("2001:db8::20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "fqdn", "tf-acc-forwarder.example.com"),
+					resource.TestCheckResourceAttr("pfsense_dnsforwarder_hostoverride.test", "ip_address", "2001:db8::20"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_dnsforwarder_hostoverride.test",
+				ImportState:                          true,
+				ImportStateId:                        "tf-acc-forwarder,example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "fqdn",
+				ImportStateVerifyIgnore:              []string{"apply"},
+			},
+		},
+	})
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -25,8 +27,10 @@ type DNSResolverApplyResource struct {
 }
 
 type DNSResolverApplyResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID            types.String `tfsdk:"id"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+	WaitForStable types.Bool   `tfsdk:"wait_for_stable"`
+	Triggers      types.Map    `tfsdk:"triggers"`
 }
 
 func (r *DNSResolverApplyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -35,7 +39,8 @@ func (r *DNSResolverApplyResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *DNSResolverApplyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Apply DNS resolver configuration.",
+		Description:         "Apply DNS resolver configuration. Resources with 'apply' set to 'false' can depend on a single apply, restarting the DNS resolver once for many changes.",
+		MarkdownDescription: "Apply DNS resolver configuration. Resources with `apply` set to `false` can depend on a single apply, restarting the DNS resolver once for many changes.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "UUID for DNS resolver apply.",
@@ -51,6 +56,21 @@ func (r *DNSResolverApplyResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_stable": schema.BoolAttribute{
+				Description:         fmt.Sprintf("Wait (up to %s) for the DNS resolver to be running after applying, defaults to 'false'.", pfsense.DefaultDNSResolverWaitTimeout),
+				MarkdownDescription: fmt.Sprintf("Wait (up to `%s`) for the DNS resolver to be running after applying, defaults to `false`.", pfsense.DefaultDNSResolverWaitTimeout),
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Description: "Arbitrary map of values that, when changed, will apply the DNS resolver configuration again.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 		},
 	}
 }
@@ -77,6 +97,13 @@ func (r *DNSResolverApplyResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	if data.WaitForStable.ValueBool() {
+		err = r.client.WaitForDNSResolver(ctx)
+		if addError(&resp.Diagnostics, "Error waiting for DNS resolver", err) {
+			return
+		}
+	}
+
 	data.ID = types.StringValue(uuid.New().String())
 	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
@@ -87,6 +114,22 @@ func (r *DNSResolverApplyResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *DNSResolverApplyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSResolverApplyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// changes to triggers replace the resource, only wait as the DNS resolver may still be starting from a prior apply
+	if data.WaitForStable.ValueBool() {
+		err := r.client.WaitForDNSResolver(ctx)
+		if addError(&resp.Diagnostics, "Error waiting for DNS resolver", err) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DNSResolverApplyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccDNSResolverApplyConfig(lastIP string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_dnsresolver_hostoverride" "test" {
+  count        = 20
+  host         = "tf-acc-test-${count.index}"
+  domain       = "example.com"
+  ip_addresses = [count.index == 19 ? %q : "192.0.2.${count.index + 1}"]
+  apply        = false
+}
+
+resource "pfsense_dnsresolver_apply" "test" {
+  triggers = { for i, v in pfsense_dnsresolver_hostoverride.test : tostring(i) => jsonencode(v.ip_addresses) }
+}
+`, lastIP)
+}
+
+// testAccCheckDNSResolverApplyID records the ID of the apply resource, which changes every time changes are applied.
+func testAccCheckDNSResolverApplyID(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*id = s.RootModule().Resources["pfsense_dnsresolver_apply.test"].Primary.ID
+		return nil
+	}
+}
+
+// TestAccDNSResolverApplyResource creates 20 host overrides that share a single apply, unchanged overrides do not
+// apply again and changing one override applies once more.
+func TestAccDNSResolverApplyResource(t *testing.T) {
+	var firstID, secondID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSResolverApplyConfig("192.0.2.20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dnsresolver_apply.test", "triggers.%", "20"),
+					resource.TestCheckResourceAttr("pfsense_dnsresolver_hostoverride.test.19", "apply", "false"),
+					testAccCheckDNSResolverApplyID(&firstID),
+				),
+			},
+			{
+				Config:   testAccDNSResolverApplyConfig("192.0.2.20"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccDNSResolverApplyConfig("192.0.2.200"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_dnsresolver_hostoverride.test.19", "ip_addresses.0", "192.0.2.200"),
+					testAccCheckDNSResolverApplyID(&secondID),
+					func(*terraform.State) error {
+						if firstID == secondID {
+							return fmt.Errorf("expected changes to be applied again, apply ID unchanged '%s'", firstID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
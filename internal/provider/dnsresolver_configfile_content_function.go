@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &DNSResolverConfigFileContentFunction{}
+
+var unboundLocalZoneTypes = []string{
+	"deny", "refuse", "static", "transparent", "typetransparent", "redirect", "inform", "inform_deny",
+	"inform_redirect", "always_transparent", "block_a", "always_refuse", "always_nxdomain", "always_null",
+	"noview", "nodefault",
+}
+
+func NewDNSResolverConfigFileContentFunction() function.Function {
+	return &DNSResolverConfigFileContentFunction{}
+}
+
+type DNSResolverConfigFileContentFunction struct{}
+
+type DNSResolverConfigFileContentLocalZoneModel struct {
+	Name    string   `tfsdk:"name"`
+	Type    string   `tfsdk:"type"`
+	Records []string `tfsdk:"records"`
+}
+
+func (f DNSResolverConfigFileContentLocalZoneModel) GetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":    types.StringType,
+		"type":    types.StringType,
+		"records": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func quoteUnboundValue(value string) (string, error) {
+	// a line break (or other control character) would end the quoted value and allow arbitrary directives
+	switch {
+	case strings.ContainsFunc(value, unicode.IsControl):
+		return "", fmt.Errorf("value %q cannot contain control characters (e.g. line breaks)", value)
+	case !strings.Contains(value, `"`):
+		return fmt.Sprintf(`"%s"`, value), nil
+	case !strings.Contains(value, `'`):
+		return fmt.Sprintf(`'%s'`, value), nil
+	default:
+		return "", fmt.Errorf("value '%s' cannot contain both single and double quotes", value)
+	}
+}
+
+func (f *DNSResolverConfigFileContentFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dnsresolver_configfile_content"
+}
+
+func (f *DNSResolverConfigFileContentFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build DNS resolver config file content from local zones.",
+		Description:         "Builds DNS resolver (Unbound) config file content from a list of local zones and their records (local data), for use with the 'pfsense_dnsresolver_configfile' resource.",
+		MarkdownDescription: "Builds DNS resolver (Unbound) config file content from a list of [local zones](https://man.freebsd.org/cgi/man.cgi?unbound.conf) and their records (local data), for use with the `pfsense_dnsresolver_configfile` resource.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "local_zones",
+				Description:         fmt.Sprintf("Local zones, each with a name, type (one of '%s'), and list of records in resource record format.", strings.Join(unboundLocalZoneTypes, "', '")),
+				MarkdownDescription: fmt.Sprintf("Local zones, each with a `name`, `type` (one of `%s`), and list of `records` in resource record format.", strings.Join(unboundLocalZoneTypes, "`, `")),
+				ElementType:         types.ObjectType{AttrTypes: DNSResolverConfigFileContentLocalZoneModel{}.GetAttrTypes()},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DNSResolverConfigFileContentFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var localZones []DNSResolverConfigFileContentLocalZoneModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &localZones))
+	if resp.Error != nil {
+		return
+	}
+
+	lines := []string{"server:"}
+	for _, localZone := range localZones {
+		validType := false
+		for _, t := range unboundLocalZoneTypes {
+			if localZone.Type == t {
+				validType = true
+			}
+		}
+
+		if !validType {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Local zone '%s' type must be one of '%s'", localZone.Name, strings.Join(unboundLocalZoneTypes, "', '")))
+			return
+		}
+
+		name, err := quoteUnboundValue(localZone.Name)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Local zone name cannot be parsed, %s", err))
+			return
+		}
+
+		lines = append(lines, fmt.Sprintf("local-zone: %s %s", name, localZone.Type))
+
+		for _, record := range localZone.Records {
+			data, err := quoteUnboundValue(record)
+			if err != nil {
+				resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Local zone '%s' record cannot be parsed, %s", localZone.Name, err))
+				return
+			}
+
+			lines = append(lines, fmt.Sprintf("local-data: %s", data))
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, strings.Join(lines, "\n")+"\n"))
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestQuoteUnboundValue(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "example.com", want: `"example.com"`},
+		{value: `host TXT "v=spf1 -all"`, want: `'host TXT "v=spf1 -all"'`},
+		{value: "host TXT 'single'", want: `"host TXT 'single'"`},
+		{value: `both ' and "`, wantErr: true},
+		{value: "example.com\nlocal-zone: evil.com redirect", wantErr: true},
+		{value: "example.com\r", wantErr: true},
+		{value: "example.com\x00", wantErr: true},
+		{value: "tab\tseparated", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := quoteUnboundValue(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("quoteUnboundValue(%q) error = %v, wantErr %t", tt.value, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("quoteUnboundValue(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func runDNSResolverConfigFileContentFunction(t *testing.T, localZones ...DNSResolverConfigFileContentLocalZoneModel) (string, *function.FuncError) {
+	t.Helper()
+
+	elemType := types.ObjectType{AttrTypes: DNSResolverConfigFileContentLocalZoneModel{}.GetAttrTypes()}
+	elems := []attr.Value{}
+	for _, localZone := range localZones {
+		records := []attr.Value{}
+		for _, record := range localZone.Records {
+			records = append(records, types.StringValue(record))
+		}
+
+		elems = append(elems, types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(localZone.Name),
+			"type":    types.StringValue(localZone.Type),
+			"records": types.ListValueMust(types.StringType, records),
+		}))
+	}
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.ListValueMust(elemType, elems)})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewDNSResolverConfigFileContentFunction().Run(context.Background(), req, &resp)
+
+	result, _ := resp.Result.Value().(types.String)
+
+	return result.ValueString(), resp.Error
+}
+
+func TestDNSResolverConfigFileContentFunction(t *testing.T) {
+	got, funcErr := runDNSResolverConfigFileContentFunction(t,
+		DNSResolverConfigFileContentLocalZoneModel{
+			Name:    "example.com",
+			Type:    "static",
+			Records: []string{"www.example.com. A 10.0.0.1", `example.com. TXT "v=spf1 -all"`},
+		},
+		DNSResolverConfigFileContentLocalZoneModel{
+			Name:    "ads.example",
+			Type:    "always_nxdomain",
+			Records: []string{},
+		},
+	)
+	if funcErr != nil {
+		t.Fatalf("unexpected error, %s", funcErr)
+	}
+
+	want := strings.Join([]string{
+		"server:",
+		`local-zone: "example.com" static`,
+		`local-data: "www.example.com. A 10.0.0.1"`,
+		`local-data: 'example.com. TXT "v=spf1 -all"'`,
+		`local-zone: "ads.example" always_nxdomain`,
+	}, "\n") + "\n"
+
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDNSResolverConfigFileContentFunctionErrors(t *testing.T) {
+	tests := map[string]DNSResolverConfigFileContentLocalZoneModel{
+		"invalid type":       {Name: "example.com", Type: "invalid", Records: []string{}},
+		"newline in name":    {Name: "example.com\"\nserver:", Type: "static", Records: []string{}},
+		"newline in record":  {Name: "example.com", Type: "static", Records: []string{"www.example.com. A 10.0.0.1\ninclude: /etc/passwd"}},
+		"both quotes record": {Name: "example.com", Type: "static", Records: []string{`example.com. TXT "a'b"`}},
+	}
+
+	for name, localZone := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, funcErr := runDNSResolverConfigFileContentFunction(t, localZone)
+			if funcErr == nil {
+				t.Fatal("expected error")
+			}
+
+			if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+				t.Errorf("expected error for argument 0, got %v", funcErr.FunctionArgument)
+			}
+		})
+	}
+}
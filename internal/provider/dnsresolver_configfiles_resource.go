@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSResolverConfigFilesResource{}
+
+func NewDNSResolverConfigFilesResource() resource.Resource {
+	return &DNSResolverConfigFilesResource{}
+}
+
+type DNSResolverConfigFilesResource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverConfigFilesResourceModel struct {
+	Files    types.List `tfsdk:"files"`
+	Validate types.Bool `tfsdk:"validate"`
+	Apply    types.Bool `tfsdk:"apply"`
+}
+
+type DNSResolverConfigFilesFileResourceModel struct {
+	Name    types.String `tfsdk:"name"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (r DNSResolverConfigFilesFileResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":    types.StringType,
+		"content": types.StringType,
+	}}
+}
+
+func (r *DNSResolverConfigFilesResourceModel) SetFromValue(ctx context.Context, configFiles *pfsense.ConfigFiles) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prevFileModels []DNSResolverConfigFilesFileResourceModel
+	if !r.Files.IsNull() && !r.Files.IsUnknown() {
+		diags = r.Files.ElementsAs(ctx, &prevFileModels, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// only files written by this resource are tracked, other files in the config file directory are left unmanaged
+	fileModels := []DNSResolverConfigFilesFileResourceModel{}
+	for _, prevFileModel := range prevFileModels {
+		configFile, err := configFiles.GetByName(prevFileModel.Name.ValueString())
+		if err != nil {
+			continue
+		}
+
+		fileModels = append(fileModels, DNSResolverConfigFilesFileResourceModel{
+			Name:    types.StringValue(configFile.Name),
+			Content: types.StringValue(configFile.Content),
+		})
+	}
+
+	r.Files, diags = types.ListValueFrom(ctx, DNSResolverConfigFilesFileResourceModel{}.GetAttrType(), fileModels)
+
+	return diags
+}
+
+func (r DNSResolverConfigFilesResourceModel) Names(ctx context.Context) ([]string, diag.Diagnostics) {
+	var fileModels []DNSResolverConfigFilesFileResourceModel
+	diags := r.Files.ElementsAs(ctx, &fileModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	names := []string{}
+	for _, fileModel := range fileModels {
+		names = append(names, fileModel.Name.ValueString())
+	}
+
+	return names, diags
+}
+
+func (r DNSResolverConfigFilesResourceModel) Value(ctx context.Context) (*pfsense.ConfigFiles, diag.Diagnostics) {
+	var configFiles pfsense.ConfigFiles
+	var err error
+	var diags diag.Diagnostics
+
+	var fileModels []*DNSResolverConfigFilesFileResourceModel
+	diags = r.Files.ElementsAs(ctx, &fileModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, fileModel := range fileModels {
+		var configFile pfsense.ConfigFile
+
+		err = configFile.SetName(fileModel.Name.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("files").AtListIndex(i).AtName("name"),
+				"Name cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if _, err := configFiles.GetByName(configFile.Name); err == nil {
+			diags.AddAttributeError(
+				path.Root("files").AtListIndex(i).AtName("name"),
+				"Name cannot be parsed",
+				fmt.Sprintf("Duplicate config file name '%s'.", configFile.Name),
+			)
+		}
+
+		err = configFile.SetContent(fileModel.Content.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("files").AtListIndex(i).AtName("content"),
+				"Content cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		configFiles = append(configFiles, configFile)
+	}
+
+	return &configFiles, diags
+}
+
+func (r *DNSResolverConfigFilesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_configfiles", req.ProviderTypeName)
+}
+
+func (r *DNSResolverConfigFilesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Set of DNS resolver (Unbound) config files, files previously written by this resource that are no longer listed are removed. Other files in the config file directory are left unchanged. Prerequisite: Must add the directive 'include-toplevel: /var/unbound/conf.d/*' to the DNS resolver custom options input (see 'include_config_files' of 'pfsense_dnsresolver_settings'). Use with caution, content is not checked/validated unless 'validate' is enabled. File names must not overlap with 'pfsense_dnsresolver_configfile' resources.",
+		MarkdownDescription: "Set of DNS resolver (Unbound) [config files](https://man.freebsd.org/cgi/man.cgi?unbound.conf), files previously written by this resource that are no longer listed are removed. Other files in the config file directory are left unchanged. **Prerequisite**: Must add the directive `include-toplevel: /var/unbound/conf.d/*` to the DNS resolver custom options input (see `include_config_files` of `pfsense_dnsresolver_settings`). **Use with caution**, content is not checked/validated unless `validate` is enabled. File names must not overlap with `pfsense_dnsresolver_configfile` resources.",
+		Attributes: map[string]schema.Attribute{
+			"files": schema.ListNestedAttribute{
+				Description: "Config files.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of config file.",
+							Required:    true,
+						},
+						"content": schema.StringAttribute{
+							Description:         "Contents of file. Must specify Unbound clause(s). Comments start with '#' and last to the end of line.",
+							MarkdownDescription: "Contents of file. Must specify Unbound clause(s). Comments start with `#` and last to the end of line.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"validate": schema.BoolAttribute{
+				Description:         "Write all config files in a single step and validate the combined resolver config with 'unbound-checkconf', the affected config files are restored when validation fails, defaults to 'false'.",
+				MarkdownDescription: "Write all config files in a single step and validate the combined resolver config with `unbound-checkconf`, the affected config files are restored when validation fails, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverConfigFilesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverConfigFilesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSResolverConfigFilesResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configFilesReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configFiles *pfsense.ConfigFiles
+	var err error
+	if data.Validate.ValueBool() {
+		configFiles, err = r.client.ReplaceDNSResolverConfigFilesValidated(ctx, *configFilesReq, nil)
+	} else {
+		configFiles, err = r.client.ReplaceDNSResolverConfigFiles(ctx, *configFilesReq, nil)
+	}
+	if addError(&resp.Diagnostics, "Error creating config files", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, configFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying config files", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverConfigFilesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSResolverConfigFilesResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configFiles, err := r.client.GetDNSResolverConfigFiles(ctx)
+	if addError(&resp.Diagnostics, "Error reading config files", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, configFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverConfigFilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSResolverConfigFilesResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configFilesReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *DNSResolverConfigFilesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prevNames, d := state.Names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var configFiles *pfsense.ConfigFiles
+	var err error
+	if data.Validate.ValueBool() {
+		configFiles, err = r.client.ReplaceDNSResolverConfigFilesValidated(ctx, *configFilesReq, prevNames)
+	} else {
+		configFiles, err = r.client.ReplaceDNSResolverConfigFiles(ctx, *configFilesReq, prevNames)
+	}
+	if addError(&resp.Diagnostics, "Error updating config files", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, configFiles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying config files", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverConfigFilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DNSResolverConfigFilesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, d := data.Names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverConfigFiles(ctx, names)
+	if addError(&resp.Diagnostics, "Error deleting config files", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying config files", err) {
+			return
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+func TestDNSResolverConfigFilesResourceModelSetFromValue(t *testing.T) {
+	ctx := context.Background()
+
+	files, diags := types.ListValueFrom(ctx, DNSResolverConfigFilesFileResourceModel{}.GetAttrType(), []DNSResolverConfigFilesFileResourceModel{
+		{Name: types.StringValue("second"), Content: types.StringValue("server:\n")},
+		{Name: types.StringValue("first"), Content: types.StringValue("server:\n")},
+		{Name: types.StringValue("deleted"), Content: types.StringValue("server:\n")},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	model := DNSResolverConfigFilesResourceModel{Files: files}
+
+	// files written by other resources are not tracked, files removed outside of terraform are dropped
+	diags = model.SetFromValue(ctx, &pfsense.ConfigFiles{
+		{Name: "first", Content: "server:\n  verbosity: 2\n"},
+		{Name: "unmanaged", Content: "server:\n"},
+		{Name: "second", Content: "server:\n"},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	names, diags := model.Names(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	if want := []string{"second", "first"}; !slices.Equal(names, want) {
+		t.Errorf("Names() = %v, want %v", names, want)
+	}
+
+	var fileModels []DNSResolverConfigFilesFileResourceModel
+	if diags := model.Files.ElementsAs(ctx, &fileModels, false); diags.HasError() || len(fileModels) != 2 {
+		t.Fatalf("expected 2 files, got %v, %v", fileModels, diags)
+	}
+
+	if got := fileModels[1].Content.ValueString(); got != "server:\n  verbosity: 2\n" {
+		t.Errorf("expected content drift to be read, got %q", got)
+	}
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &DNSResolverDomainOverrideDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSResolverDomainOverrideDataSource{}
+)
+
+func NewDNSResolverDomainOverrideDataSource() datasource.DataSource {
+	return &DNSResolverDomainOverrideDataSource{}
+}
+
+type DNSResolverDomainOverrideDataSource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverDomainOverrideLookupDataSourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	Index       types.Int64  `tfsdk:"index"`
+	IPAddress   types.String `tfsdk:"ip_address"`
+	TLSQueries  types.Bool   `tfsdk:"tls_queries"`
+	TLSHostname types.String `tfsdk:"tls_hostname"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *DNSResolverDomainOverrideLookupDataSourceModel) SetFromValue(ctx context.Context, domainOverride *pfsense.DomainOverride) diag.Diagnostics {
+	d.Domain = types.StringValue(domainOverride.Domain)
+	d.IPAddress = types.StringValue(domainOverride.IPAddress.String())
+	d.TLSQueries = types.BoolValue(domainOverride.TLSQueries)
+
+	if domainOverride.TLSHostname != "" {
+		d.TLSHostname = types.StringValue(domainOverride.TLSHostname)
+	}
+
+	if domainOverride.Description != "" {
+		d.Description = types.StringValue(domainOverride.Description)
+	}
+
+	return nil
+}
+
+func (d *DNSResolverDomainOverrideDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_domainoverride", req.ProviderTypeName)
+}
+
+func (d *DNSResolverDomainOverrideDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves a single DNS resolver domain override. Domain for which the resolver's standard DNS lookup process should be overridden and a different (non-standard) lookup server should be queried instead.",
+		MarkdownDescription: "Retrieves a single DNS resolver [domain override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-domain-overrides.html). Domain for which the resolver's standard DNS lookup process should be overridden and a different (non-standard) lookup server should be queried instead.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Description: "Domain whose lookups will be directed to a user-specified DNS lookup server.",
+				Required:    true,
+			},
+			"index": schema.Int64Attribute{
+				Description:         "Index of the override among overrides sharing the same domain (multiple upstream servers), defaults to '0'.",
+				MarkdownDescription: "Index of the override among overrides sharing the same domain (multiple upstream servers), defaults to `0`.",
+				Optional:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "IPv4 or IPv6 address (including port) of the authoritative DNS server for this domain.",
+				Computed:    true,
+			},
+			"tls_queries": schema.BoolAttribute{
+				Description: "Queries to all DNS servers for this domain will be sent using SSL/TLS.",
+				Computed:    true,
+			},
+			"tls_hostname": schema.StringAttribute{
+				Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *DNSResolverDomainOverrideDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSResolverDomainOverrideDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSResolverDomainOverrideLookupDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainOverrides, err := d.client.GetDNSResolverDomainOverrideGroup(ctx, data.Domain.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get domain override", err) {
+		return
+	}
+
+	index := data.Index.ValueInt64()
+	if index < 0 || index >= int64(len(*domainOverrides)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("index"),
+			"Domain override index out of range",
+			fmt.Sprintf("Domain '%s' has %d override(s), got index %d.", data.Domain.ValueString(), len(*domainOverrides), index),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromValue(ctx, &(*domainOverrides)[index])...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,351 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSResolverDomainOverrideGroupResource{}
+var _ resource.ResourceWithImportState = &DNSResolverDomainOverrideGroupResource{}
+
+func NewDNSResolverDomainOverrideGroupResource() resource.Resource {
+	return &DNSResolverDomainOverrideGroupResource{}
+}
+
+type DNSResolverDomainOverrideGroupResource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverDomainOverrideGroupResourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	Upstreams   types.List   `tfsdk:"upstreams"`
+	Description types.String `tfsdk:"description"`
+	TLSQueries  types.Bool   `tfsdk:"tls_queries"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+type DNSResolverDomainOverrideGroupUpstreamResourceModel struct {
+	IPAddress   types.String `tfsdk:"ip_address"`
+	TLSHostname types.String `tfsdk:"tls_hostname"`
+}
+
+func (r DNSResolverDomainOverrideGroupUpstreamResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"ip_address":   types.StringType,
+		"tls_hostname": types.StringType,
+	}}
+}
+
+func (r *DNSResolverDomainOverrideGroupResourceModel) SetFromValue(ctx context.Context, domainOverrides *pfsense.DomainOverrides) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	first := (*domainOverrides)[0]
+
+	r.Domain = types.StringValue(first.Domain)
+	r.TLSQueries = types.BoolValue(first.TLSQueries)
+
+	if first.Description != "" {
+		r.Description = types.StringValue(first.Description)
+	}
+
+	var prevUpstreamModels []DNSResolverDomainOverrideGroupUpstreamResourceModel
+	if !r.Upstreams.IsNull() && !r.Upstreams.IsUnknown() {
+		diags = r.Upstreams.ElementsAs(ctx, &prevUpstreamModels, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	upstreams := []DNSResolverDomainOverrideGroupUpstreamResourceModel{}
+	for i, domainOverride := range *domainOverrides {
+		var upstreamModel DNSResolverDomainOverrideGroupUpstreamResourceModel
+
+		upstreamModel.IPAddress = types.StringValue(domainOverride.IPAddress.String())
+		if i < len(prevUpstreamModels) {
+			upstreamModel.IPAddress = domainOverrideIPAddressValue(prevUpstreamModels[i].IPAddress, &domainOverride)
+		}
+
+		if domainOverride.TLSHostname != "" {
+			upstreamModel.TLSHostname = types.StringValue(domainOverride.TLSHostname)
+		}
+
+		upstreams = append(upstreams, upstreamModel)
+	}
+
+	r.Upstreams, diags = types.ListValueFrom(ctx, DNSResolverDomainOverrideGroupUpstreamResourceModel{}.GetAttrType(), upstreams)
+
+	return diags
+}
+
+func (r DNSResolverDomainOverrideGroupResourceModel) Value(ctx context.Context) (*pfsense.DomainOverrides, diag.Diagnostics) {
+	var domainOverrides pfsense.DomainOverrides
+	var err error
+	var diags diag.Diagnostics
+
+	var upstreamModels []*DNSResolverDomainOverrideGroupUpstreamResourceModel
+	diags = r.Upstreams.ElementsAs(ctx, &upstreamModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(upstreamModels) == 0 {
+		diags.AddAttributeError(
+			path.Root("upstreams"),
+			"Upstreams cannot be parsed",
+			"At least one upstream is required.",
+		)
+	}
+
+	for i, upstreamModel := range upstreamModels {
+		var domainOverride pfsense.DomainOverride
+
+		err = domainOverride.SetDomain(r.Domain.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("domain"),
+				"Domain cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = domainOverride.SetTLSQueries(r.TLSQueries.ValueBool())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tls_queries"),
+				"TLS Queries cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = domainOverride.SetIPAddress(upstreamModel.IPAddress.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("upstreams").AtListIndex(i).AtName("ip_address"),
+				"Upstream IP address cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if !upstreamModel.TLSHostname.IsNull() {
+			err = domainOverride.SetTLSHostname(upstreamModel.TLSHostname.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("upstreams").AtListIndex(i).AtName("tls_hostname"),
+					"Upstream TLS Hostname cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		if !r.Description.IsNull() {
+			err = domainOverride.SetDescription(r.Description.ValueString())
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("description"),
+					"Description cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		domainOverrides = append(domainOverrides, domainOverride)
+	}
+
+	return &domainOverrides, diags
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_domainoverride_group", req.ProviderTypeName)
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DNS resolver domain override group. Manages all domain overrides sharing a domain as one unit, allowing the resolver to query multiple (redundant) lookup servers for the domain. Should not be combined with the 'pfsense_dnsresolver_domainoverride' resource for the same domain.",
+		MarkdownDescription: "DNS resolver [domain override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-domain-overrides.html) group. Manages all domain overrides sharing a domain as one unit, allowing the resolver to query multiple (redundant) lookup servers for the domain. Should not be combined with the `pfsense_dnsresolver_domainoverride` resource for the same domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Description: "Domain whose lookups will be directed to the user-specified DNS lookup servers.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"upstreams": schema.ListNestedAttribute{
+				Description: "Authoritative DNS servers for this domain.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{
+							Description: "IPv4 or IPv6 address (optionally including port) of the authoritative DNS server, the port defaults to 53 (853 for TLS queries).",
+							Required:    true,
+						},
+						"tls_hostname": schema.StringAttribute{
+							Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries, must be a fully qualified domain name.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"tls_queries": schema.BoolAttribute{
+				Description:         "Queries to all DNS servers for this domain will be sent using SSL/TLS, defaults to 'false'.",
+				MarkdownDescription: "Queries to all DNS servers for this domain will be sent using SSL/TLS, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'. Set to 'false' to apply many changes at once with 'pfsense_dnsresolver_apply'.",
+				MarkdownDescription: "Apply change, defaults to `true`. Set to `false` to apply many changes at once with `pfsense_dnsresolver_apply`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSResolverDomainOverrideGroupResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainOverridesReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainOverrides, err := r.client.CreateDNSResolverDomainOverrideGroup(ctx, *domainOverridesReq)
+	if addError(&resp.Diagnostics, "Error creating domain override group", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, domainOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying domain override group", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSResolverDomainOverrideGroupResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainOverrides, err := r.client.GetDNSResolverDomainOverrideGroup(ctx, data.Domain.ValueString())
+	if addError(&resp.Diagnostics, "Error reading domain override group", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, domainOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSResolverDomainOverrideGroupResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainOverridesReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainOverrides, err := r.client.UpdateDNSResolverDomainOverrideGroup(ctx, *domainOverridesReq)
+	if addError(&resp.Diagnostics, "Error updating domain override group", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, domainOverrides)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying domain override group", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *DNSResolverDomainOverrideGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDNSResolverDomainOverrideGroup(ctx, data.Domain.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting domain override group", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying domain override group", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverDomainOverrideGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
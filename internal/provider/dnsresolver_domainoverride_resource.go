@@ -35,9 +35,22 @@ type DNSResolverDomainOverrideResourceModel struct {
 	Apply       types.Bool   `tfsdk:"apply"`
 }
 
+// domainOverrideIPAddressValue keeps the configured address when equivalent to the read address (e.g. '8.8.8.8' vs
+// '8.8.8.8:53').
+func domainOverrideIPAddressValue(configured types.String, domainOverride *pfsense.DomainOverride) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() {
+		prevDomainOverride := pfsense.DomainOverride{TLSQueries: domainOverride.TLSQueries}
+		if prevDomainOverride.SetIPAddress(configured.ValueString()) == nil && prevDomainOverride.IPAddress == domainOverride.IPAddress {
+			return configured
+		}
+	}
+
+	return types.StringValue(domainOverride.IPAddress.String())
+}
+
 func (r *DNSResolverDomainOverrideResourceModel) SetFromValue(ctx context.Context, domainOverride *pfsense.DomainOverride) diag.Diagnostics {
 	r.Domain = types.StringValue(domainOverride.Domain)
-	r.IPAddress = types.StringValue(domainOverride.IPAddress.String())
+	r.IPAddress = domainOverrideIPAddressValue(r.IPAddress, domainOverride)
 	r.TLSQueries = types.BoolValue(domainOverride.TLSQueries)
 
 	if domainOverride.TLSHostname != "" {
@@ -66,22 +79,22 @@ func (r DNSResolverDomainOverrideResourceModel) Value(ctx context.Context) (*pfs
 		)
 	}
 
-	err = domainOverride.SetIPAddress(r.IPAddress.ValueString())
+	err = domainOverride.SetTLSQueries(r.TLSQueries.ValueBool())
 
 	if err != nil {
 		diags.AddAttributeError(
-			path.Root("ip_address"),
-			"IP address cannot be parsed",
+			path.Root("tls_queries"),
+			"TLS Queries cannot be parsed",
 			err.Error(),
 		)
 	}
 
-	err = domainOverride.SetTLSQueries(r.TLSQueries.ValueBool())
+	err = domainOverride.SetIPAddress(r.IPAddress.ValueString())
 
 	if err != nil {
 		diags.AddAttributeError(
-			path.Root("tls_queries"),
-			"TLS Queries cannot be parsed",
+			path.Root("ip_address"),
+			"IP address cannot be parsed",
 			err.Error(),
 		)
 	}
@@ -131,7 +144,7 @@ func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req reso
 				},
 			},
 			"ip_address": schema.StringAttribute{
-				Description: "IPv4 or IPv6 address (including port) of the authoritative DNS server for this domain.",
+				Description: "IPv4 or IPv6 address (optionally including port) of the authoritative DNS server for this domain, the port defaults to 53 (853 for TLS queries).",
 				Required:    true,
 			},
 			"tls_queries": schema.BoolAttribute{
@@ -142,7 +155,7 @@ func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req reso
 				Default:             booldefault.StaticBool(false),
 			},
 			"tls_hostname": schema.StringAttribute{
-				Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries.",
+				Description: "An optional TLS hostname used to verify the server certificate when performing TLS Queries, must be a fully qualified domain name.",
 				Optional:    true,
 			},
 			"description": schema.StringAttribute{
@@ -150,8 +163,8 @@ func (r *DNSResolverDomainOverrideResource) Schema(ctx context.Context, req reso
 				Optional:    true,
 			},
 			"apply": schema.BoolAttribute{
-				Description:         "Apply change, defaults to 'true'.",
-				MarkdownDescription: "Apply change, defaults to `true`.",
+				Description:         "Apply change, defaults to 'true'. Set to 'false' to apply many changes at once with 'pfsense_dnsresolver_apply'.",
+				MarkdownDescription: "Apply change, defaults to `true`. Set to `false` to apply many changes at once with `pfsense_dnsresolver_apply`.",
 				Computed:            true,
 				Optional:            true,
 				Default:             booldefault.StaticBool(true),
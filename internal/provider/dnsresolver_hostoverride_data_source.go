@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &DNSResolverHostOverrideDataSource{}
+	_ datasource.DataSourceWithConfigure = &DNSResolverHostOverrideDataSource{}
+)
+
+func NewDNSResolverHostOverrideDataSource() datasource.DataSource {
+	return &DNSResolverHostOverrideDataSource{}
+}
+
+type DNSResolverHostOverrideDataSource struct {
+	client *pfsense.Client
+}
+
+func (d *DNSResolverHostOverrideDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_hostoverride", req.ProviderTypeName)
+}
+
+func (d *DNSResolverHostOverrideDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves a single DNS resolver host override by FQDN. Host for which the resolver's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the resolver.",
+		MarkdownDescription: "Retrieves a single DNS resolver [host override](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-host-overrides.html) by FQDN. Host for which the resolver's standard DNS lookup process should be overridden and a specific IPv4 or IPv6 address should automatically be returned by the resolver.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Name of the host, without the domain part.",
+				Computed:    true,
+			},
+			"domain": schema.StringAttribute{
+				Description: "Parent domain of the host.",
+				Computed:    true,
+			},
+			"ip_addresses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "IPv4 or IPv6 addresses to be returned for the host.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Computed:    true,
+			},
+			"fqdn": schema.StringAttribute{
+				Description: "Fully qualified domain name of host.",
+				Required:    true,
+			},
+			"aliases": schema.ListNestedAttribute{
+				Description:         "List of additional names for this host, defaults to '[]'.",
+				MarkdownDescription: "List of additional names for this host, defaults to `[]`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "Name of the host, without the domain part.",
+							Computed:    true,
+						},
+						"domain": schema.StringAttribute{
+							Description: "Parent domain of the host.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSResolverHostOverrideDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DNSResolverHostOverrideDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSResolverHostOverrideDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostOverride, err := d.client.GetDNSResolverHostOverride(ctx, data.FQDN.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get host override", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromValue(ctx, hostOverride)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccDNSResolverHostOverrideDataSource reads a host override with aliases by FQDN, an unknown FQDN is an error.
+func TestAccDNSResolverHostOverrideDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_dnsresolver_hostoverride" "test" {
+  host         = "tf-acc-test"
+  domain       = "example.com"
+  ip_addresses = ["192.0.2.10", "2001:db8::10"]
+  description  = "tf acc test"
+  aliases = [
+    {
+      host   = "tf-acc-test-alias"
+      domain = "example.com"
+    },
+  ]
+}
+
+data "pfsense_dnsresolver_hostoverride" "test" {
+  fqdn = pfsense_dnsresolver_hostoverride.test.fqdn
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "host", "tf-acc-test"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "description", "tf acc test"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "ip_addresses.0", "192.0.2.10"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "ip_addresses.1", "2001:db8::10"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "aliases.#", "1"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "aliases.0.host", "tf-acc-test-alias"),
+					resource.TestCheckResourceAttr("data.pfsense_dnsresolver_hostoverride.test", "aliases.0.domain", "example.com"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+data "pfsense_dnsresolver_hostoverride" "test" {
+  fqdn = "tf-acc-test-unknown.example.com"
+}
+`,
+				ExpectError: regexp.MustCompile(`not found`),
+			},
+		},
+	})
+}
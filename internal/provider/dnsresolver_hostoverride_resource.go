@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -30,13 +32,14 @@ type DNSResolverHostOverrideResource struct {
 }
 
 type DNSResolverHostOverrideResourceModel struct {
-	Host        types.String   `tfsdk:"host"`
-	Domain      types.String   `tfsdk:"domain"`
-	IPAddresses []types.String `tfsdk:"ip_addresses"`
-	Description types.String   `tfsdk:"description"`
-	Apply       types.Bool     `tfsdk:"apply"`
-	FQDN        types.String   `tfsdk:"fqdn"`
-	Aliases     types.List     `tfsdk:"aliases"`
+	Host                  types.String   `tfsdk:"host"`
+	Domain                types.String   `tfsdk:"domain"`
+	IPAddresses           []types.String `tfsdk:"ip_addresses"`
+	Description           types.String   `tfsdk:"description"`
+	Apply                 types.Bool     `tfsdk:"apply"`
+	WarnForwarderConflict types.Bool     `tfsdk:"warn_forwarder_conflict"`
+	FQDN                  types.String   `tfsdk:"fqdn"`
+	Aliases               types.List     `tfsdk:"aliases"`
 }
 
 type DNSResolverHostOverrideAliasResourceModel struct {
@@ -53,6 +56,27 @@ func (r DNSResolverHostOverrideAliasResourceModel) GetAttrType() attr.Type {
 	}}
 }
 
+func equivalentIPAddresses(configured []types.String, ipAddresses []netip.Addr) bool {
+	if len(configured) != len(ipAddresses) {
+		return false
+	}
+
+	counts := map[netip.Addr]int{}
+	for _, ipAddress := range ipAddresses {
+		counts[ipAddress]++
+	}
+
+	for _, c := range configured {
+		ipAddress, err := netip.ParseAddr(c.ValueString())
+		if err != nil || counts[ipAddress] == 0 {
+			return false
+		}
+		counts[ipAddress]--
+	}
+
+	return true
+}
+
 func (r *DNSResolverHostOverrideResourceModel) SetFromValue(ctx context.Context, hostOverride *pfsense.HostOverride) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -62,11 +86,14 @@ func (r *DNSResolverHostOverrideResourceModel) SetFromValue(ctx context.Context,
 
 	r.Domain = types.StringValue(hostOverride.Domain)
 
-	var ipAddresses []types.String
-	for _, ipAddress := range hostOverride.IPAddresses {
-		ipAddresses = append(ipAddresses, types.StringValue(ipAddress.String()))
+	// keep configured addresses when equivalent, ignoring order and formatting (e.g. IPv6 compression)
+	if !equivalentIPAddresses(r.IPAddresses, hostOverride.IPAddresses) {
+		var ipAddresses []types.String
+		for _, ipAddress := range hostOverride.IPAddresses {
+			ipAddresses = append(ipAddresses, types.StringValue(ipAddress.String()))
+		}
+		r.IPAddresses = ipAddresses
 	}
-	r.IPAddresses = ipAddresses
 
 	if hostOverride.Description != "" {
 		r.Description = types.StringValue(hostOverride.Description)
@@ -226,12 +253,19 @@ func (r *DNSResolverHostOverrideResource) Schema(ctx context.Context, req resour
 				Optional:    true,
 			},
 			"apply": schema.BoolAttribute{
-				Description:         "Apply change, defaults to 'true'.",
-				MarkdownDescription: "Apply change, defaults to `true`.",
+				Description:         "Apply change, defaults to 'true'. Set to 'false' to apply many changes at once with 'pfsense_dnsresolver_apply'.",
+				MarkdownDescription: "Apply change, defaults to `true`. Set to `false` to apply many changes at once with `pfsense_dnsresolver_apply`.",
 				Computed:            true,
 				Optional:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"warn_forwarder_conflict": schema.BoolAttribute{
+				Description:         "Warn when the host (or one of its aliases) is also overridden by the DNS forwarder, defaults to 'false'.",
+				MarkdownDescription: "Warn when the host (or one of its aliases) is also overridden by the DNS forwarder, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"fqdn": schema.StringAttribute{
 				Description: "Fully qualified domain name of host.",
 				Computed:    true,
@@ -275,6 +309,33 @@ func (r *DNSResolverHostOverrideResource) Configure(ctx context.Context, req res
 	r.client = client
 }
 
+func (r *DNSResolverHostOverrideResource) warnForwarderConflicts(ctx context.Context, hostOverride *pfsense.HostOverride, diags *diag.Diagnostics) {
+	forwarderFQDNs, err := r.client.GetDNSForwarderHostFQDNs(ctx)
+	if addError(diags, "Unable to check DNS forwarder host overrides", err) {
+		return
+	}
+
+	for _, fqdn := range forwarderConflicts(*hostOverride, forwarderFQDNs) {
+		diags.AddWarning(
+			"Host also overridden by DNS forwarder",
+			fmt.Sprintf("'%s' is overridden by both the DNS resolver and the DNS forwarder, which one answers is undefined.", fqdn),
+		)
+	}
+}
+
+// forwarderConflicts returns the FQDNs of the host override (and its aliases) also overridden by the DNS forwarder,
+// names are compared case-insensitively.
+func forwarderConflicts(hostOverride pfsense.HostOverride, forwarderFQDNs []string) []string {
+	var fqdns []string
+	for _, fqdn := range hostOverride.FQDNs() {
+		if slices.ContainsFunc(forwarderFQDNs, func(forwarderFQDN string) bool { return strings.EqualFold(forwarderFQDN, fqdn) }) {
+			fqdns = append(fqdns, fqdn)
+		}
+	}
+
+	return fqdns
+}
+
 func (r *DNSResolverHostOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *DNSResolverHostOverrideResourceModel
 	var diags diag.Diagnostics
@@ -290,6 +351,13 @@ func (r *DNSResolverHostOverrideResource) Create(ctx context.Context, req resour
 		return
 	}
 
+	if data.WarnForwarderConflict.ValueBool() {
+		r.warnForwarderConflicts(ctx, hostOverrideReq, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	hostOverride, err := r.client.CreateDNSResolverHostOverride(ctx, *hostOverrideReq)
 	if addError(&resp.Diagnostics, "Error creating host override", err) {
 		return
@@ -353,6 +421,13 @@ func (r *DNSResolverHostOverrideResource) Update(ctx context.Context, req resour
 		return
 	}
 
+	if data.WarnForwarderConflict.ValueBool() {
+		r.warnForwarderConflicts(ctx, hostOverrideReq, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	hostOverride, err := r.client.UpdateDNSResolverHostOverride(ctx, *hostOverrideReq)
 	if addError(&resp.Diagnostics, "Error updating host override", err) {
 		return
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+func TestForwarderConflicts(t *testing.T) {
+	hostOverride := pfsense.HostOverride{
+		Host:   "nas",
+		Domain: "lan",
+		Aliases: []pfsense.HostOverrideAlias{
+			{Host: "files", Domain: "lan"},
+			{Host: "", Domain: "example.com"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		forwarderFQDNs []string
+		want           []string
+	}{
+		{"none", nil, nil},
+		{"unrelated", []string{"router.lan", "nas.example.com"}, nil},
+		{"host", []string{"router.lan", "nas.lan"}, []string{"nas.lan"}},
+		{"alias", []string{"files.lan"}, []string{"files.lan"}},
+		{"apex alias", []string{"example.com"}, []string{"example.com"}},
+		{"case", []string{"NAS.lan", "Files.LAN"}, []string{"nas.lan", "files.lan"}},
+		{"all", []string{"example.com", "files.lan", "nas.lan"}, []string{"nas.lan", "files.lan", "example.com"}},
+	}
+
+	for _, tt := range tests {
+		if got := forwarderConflicts(hostOverride, tt.forwarderFQDNs); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: forwarderConflicts() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
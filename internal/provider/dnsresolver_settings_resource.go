@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &DNSResolverSettingsResource{}
+
+func NewDNSResolverSettingsResource() resource.Resource {
+	return &DNSResolverSettingsResource{}
+}
+
+type DNSResolverSettingsResource struct {
+	client *pfsense.Client
+}
+
+type DNSResolverSettingsResourceModel struct {
+	Enable             types.Bool     `tfsdk:"enable"`
+	ListenInterfaces   []types.String `tfsdk:"listen_interfaces"`
+	OutgoingInterfaces []types.String `tfsdk:"outgoing_interfaces"`
+	DNSSEC             types.Bool     `tfsdk:"dnssec"`
+	CustomOptions      types.String   `tfsdk:"custom_options"`
+	IncludeConfigFiles types.Bool     `tfsdk:"include_config_files"`
+	Apply              types.Bool     `tfsdk:"apply"`
+}
+
+func (r *DNSResolverSettingsResourceModel) SetFromValue(ctx context.Context, settings *pfsense.DNSResolverSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Enable = types.BoolValue(settings.Enable)
+
+	r.ListenInterfaces = []types.String{}
+	for _, iface := range settings.ListenInterfaces {
+		r.ListenInterfaces = append(r.ListenInterfaces, types.StringValue(iface))
+	}
+
+	r.OutgoingInterfaces = []types.String{}
+	for _, iface := range settings.OutgoingInterfaces {
+		r.OutgoingInterfaces = append(r.OutgoingInterfaces, types.StringValue(iface))
+	}
+
+	r.DNSSEC = types.BoolValue(settings.DNSSEC)
+
+	// the include directive is managed by its own attribute
+	r.IncludeConfigFiles = types.BoolValue(settings.HasIncludeTopLevel())
+	settings.RemoveIncludeTopLevel()
+
+	r.CustomOptions = types.StringNull()
+	if settings.CustomOptions != "" {
+		r.CustomOptions = types.StringValue(settings.CustomOptions)
+	}
+
+	return diags
+}
+
+func (r DNSResolverSettingsResourceModel) Value(ctx context.Context) (*pfsense.DNSResolverSettings, diag.Diagnostics) {
+	var settings pfsense.DNSResolverSettings
+	var err error
+	var diags diag.Diagnostics
+
+	err = settings.SetEnable(r.Enable.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enable"),
+			"Enable cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	listenInterfaces := []string{}
+	for _, iface := range r.ListenInterfaces {
+		listenInterfaces = append(listenInterfaces, iface.ValueString())
+	}
+
+	err = settings.SetListenInterfaces(listenInterfaces)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("listen_interfaces"),
+			"Listen interfaces cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	outgoingInterfaces := []string{}
+	for _, iface := range r.OutgoingInterfaces {
+		outgoingInterfaces = append(outgoingInterfaces, iface.ValueString())
+	}
+
+	err = settings.SetOutgoingInterfaces(outgoingInterfaces)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("outgoing_interfaces"),
+			"Outgoing interfaces cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = settings.SetDNSSEC(r.DNSSEC.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("dnssec"),
+			"DNSSEC cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.CustomOptions.IsNull() {
+		err = settings.SetCustomOptions(r.CustomOptions.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("custom_options"),
+				"Custom options cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if r.IncludeConfigFiles.ValueBool() {
+		settings.EnsureIncludeTopLevel()
+	} else {
+		settings.RemoveIncludeTopLevel()
+	}
+
+	return &settings, diags
+}
+
+func (r *DNSResolverSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_dnsresolver_settings", req.ProviderTypeName)
+}
+
+func (r *DNSResolverSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "DNS resolver (Unbound) general settings. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "DNS resolver (Unbound) [general settings](https://docs.netgate.com/pfsense/en/latest/services/dns/resolver-config.html). Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				Description:         "Enable DNS resolver, defaults to 'true'.",
+				MarkdownDescription: "Enable DNS resolver, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"listen_interfaces": schema.ListAttribute{
+				Description: "Interfaces used for responding to queries from clients, defaults to all interfaces.",
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"outgoing_interfaces": schema.ListAttribute{
+				Description: "Interfaces used to send queries to authoritative servers and receive their replies, defaults to all interfaces.",
+				Computed:    true,
+				Optional:    true,
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"dnssec": schema.BoolAttribute{
+				Description:         "Enable DNSSEC support, defaults to 'true'.",
+				MarkdownDescription: "Enable DNSSEC support, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"custom_options": schema.StringAttribute{
+				Description:         "Additional configuration parameters for Unbound, excluding the config file directive managed by 'include_config_files'.",
+				MarkdownDescription: "Additional configuration parameters for Unbound, excluding the config file directive managed by `include_config_files`.",
+				Optional:            true,
+			},
+			"include_config_files": schema.BoolAttribute{
+				Description:         "Add the 'include-toplevel: /var/unbound/conf.d/*' directive to the custom options, required by 'pfsense_dnsresolver_configfile', defaults to 'false'.",
+				MarkdownDescription: "Add the `include-toplevel: /var/unbound/conf.d/*` directive to the custom options, required by `pfsense_dnsresolver_configfile`, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *DNSResolverSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DNSResolverSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DNSResolverSettingsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateDNSResolverSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error creating DNS resolver settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying DNS resolver settings", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *DNSResolverSettingsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetDNSResolverSettings(ctx)
+	if addError(&resp.Diagnostics, "Error reading DNS resolver settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSResolverSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DNSResolverSettingsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateDNSResolverSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error updating DNS resolver settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyDNSResolverChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying DNS resolver settings", err) {
+			return
+		}
+	}
+}
+
+func (r *DNSResolverSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
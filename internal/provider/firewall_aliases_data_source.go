@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -26,7 +28,44 @@ type FirewallAliasesDataSource struct {
 }
 
 type FirewallAliasesDataSourceModel struct {
-	IP types.List `tfsdk:"ip"`
+	PreserveOrder types.Bool  `tfsdk:"preserve_order"`
+	IP            types.List  `tfsdk:"ip"`
+	IPCount       types.Int64 `tfsdk:"ip_count"`
+	PortCount     types.Int64 `tfsdk:"port_count"`
+	EntryCount    types.Int64 `tfsdk:"entry_count"`
+}
+
+func (d *FirewallAliasesDataSourceModel) SetFromValue(ctx context.Context, ipAliases pfsense.FirewallIPAliases) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.PreserveOrder.ValueBool() {
+		ipAliases = slices.Clone(ipAliases)
+		sort.SliceStable(ipAliases, func(i, j int) bool {
+			return ipAliases[i].Name < ipAliases[j].Name
+		})
+	}
+
+	ipAliasModels := []FirewallIPAliasDataSourceModel{}
+	entryCount := 0
+	for _, ipAlias := range ipAliases {
+		var ipAliasModel FirewallIPAliasDataSourceModel
+		diags.Append(ipAliasModel.SetFromValue(ctx, &ipAlias)...)
+		ipAliasModels = append(ipAliasModels, ipAliasModel)
+		entryCount += len(ipAlias.Entries)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.IP, diags = types.ListValueFrom(ctx, FirewallIPAliasDataSourceModel{}.GetAttrType(), ipAliasModels)
+
+	// port aliases are not read yet
+	d.IPCount = types.Int64Value(int64(len(ipAliasModels)))
+	d.PortCount = types.Int64Value(0)
+	d.EntryCount = types.Int64Value(int64(entryCount))
+
+	return diags
 }
 
 type FirewallIPAliasDataSourceModel struct {
@@ -34,6 +73,7 @@ type FirewallIPAliasDataSourceModel struct {
 	Description types.String `tfsdk:"description"`
 	Type        types.String `tfsdk:"type"`
 	Entries     types.List   `tfsdk:"entries"`
+	Networks    types.List   `tfsdk:"networks"`
 }
 
 func (d FirewallIPAliasDataSourceModel) GetAttrType() attr.Type {
@@ -42,6 +82,7 @@ func (d FirewallIPAliasDataSourceModel) GetAttrType() attr.Type {
 		"description": types.StringType,
 		"type":        types.StringType,
 		"entries":     types.ListType{ElemType: FirewallIPAliasEntryDataSourceModel{}.GetAttrType()},
+		"networks":    types.ListType{ElemType: types.StringType},
 	}}
 }
 
@@ -82,6 +123,16 @@ func (d *FirewallIPAliasDataSourceModel) SetFromValue(ctx context.Context, ipAli
 	}
 
 	d.Entries, diags = types.ListValueFrom(ctx, FirewallIPAliasEntryDataSourceModel{}.GetAttrType(), entries)
+	if diags.HasError() {
+		return diags
+	}
+
+	networks := []string{}
+	for _, network := range ipAlias.Networks() {
+		networks = append(networks, network.String())
+	}
+
+	d.Networks, diags = types.ListValueFrom(ctx, types.StringType, networks)
 
 	return diags
 }
@@ -95,9 +146,15 @@ func (d *FirewallAliasesDataSource) Schema(_ context.Context, _ datasource.Schem
 		Description:         "Retrieves all firewall aliases. Aliases can be referenced by firewall rules, port forwards, outbound NAT rules, and other places in the firewall.",
 		MarkdownDescription: "Retrieves all firewall [aliases](https://docs.netgate.com/pfsense/en/latest/firewall/aliases.html). Aliases can be referenced by firewall rules, port forwards, outbound NAT rules, and other places in the firewall.",
 		Attributes: map[string]schema.Attribute{
+			"preserve_order": schema.BoolAttribute{
+				Description:         "Return aliases in pfSense's configured order instead of sorted by name, defaults to 'false'.",
+				MarkdownDescription: "Return aliases in pfSense's configured order instead of sorted by name, defaults to `false`.",
+				Optional:            true,
+			},
 			"ip": schema.ListNestedAttribute{
-				Description: "IP aliases (hosts and networks)",
-				Computed:    true,
+				Description:         "IP aliases (hosts and networks), sorted by name unless 'preserve_order' is set.",
+				MarkdownDescription: "IP aliases (hosts and networks), sorted by name unless `preserve_order` is set.",
+				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -128,9 +185,27 @@ func (d *FirewallAliasesDataSource) Schema(_ context.Context, _ datasource.Schem
 								},
 							},
 						},
+						"networks": schema.ListAttribute{
+							Description: "Networks of a network alias in normalized CIDR form (host bits cleared), entries that are not an IP address or CIDR are omitted.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
 					},
 				},
 			},
+			"ip_count": schema.Int64Attribute{
+				Description: "Number of IP aliases.",
+				Computed:    true,
+			},
+			"port_count": schema.Int64Attribute{
+				Description:         "Number of port aliases, always '0' as port aliases are not retrieved yet.",
+				MarkdownDescription: "Number of port aliases, always `0` as port aliases are not retrieved yet.",
+				Computed:            true,
+			},
+			"entry_count": schema.Int64Attribute{
+				Description: "Total number of entries across all aliases.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -147,25 +222,18 @@ func (d *FirewallAliasesDataSource) Configure(ctx context.Context, req datasourc
 func (d *FirewallAliasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data FirewallAliasesDataSourceModel
 	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	ipAliases, err := d.client.GetFirewallIPAliases(ctx)
-	if addError(&resp.Diagnostics, "Unable to get IP aliases", err) {
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	ipAliasModels := []FirewallIPAliasDataSourceModel{}
-	for _, ipAlias := range *ipAliases {
-		var ipAliasModel FirewallIPAliasDataSourceModel
-		diags = ipAliasModel.SetFromValue(ctx, &ipAlias)
-		resp.Diagnostics.Append(diags...)
-		ipAliasModels = append(ipAliasModels, ipAliasModel)
-	}
-
-	if resp.Diagnostics.HasError() {
+	ipAliases, err := d.client.GetFirewallIPAliases(ctx)
+	if addError(&resp.Diagnostics, "Unable to get IP aliases", err) {
 		return
 	}
 
-	data.IP, diags = types.ListValueFrom(ctx, FirewallIPAliasDataSourceModel{}.GetAttrType(), ipAliasModels)
+	diags = data.SetFromValue(ctx, *ipAliases)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
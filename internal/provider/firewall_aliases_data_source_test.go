@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+func TestFirewallAliasesDataSourceModelCounts(t *testing.T) {
+	ipAliases := pfsense.FirewallIPAliases{
+		{Name: "web", Type: "host", Entries: []pfsense.FirewallIPAliasEntry{{Address: "10.0.0.1"}, {Address: "10.0.0.2"}}},
+		{Name: "empty", Type: "host"},
+		{Name: "networks", Type: "network", Entries: []pfsense.FirewallIPAliasEntry{{Address: "10.0.0.0/24"}, {Address: "fd00::/64"}, {Address: "nested"}}},
+	}
+
+	tests := []struct {
+		name          string
+		preserveOrder bool
+		names         []string
+	}{
+		{"sorted", false, []string{"empty", "networks", "web"}},
+		{"preserve order", true, []string{"web", "empty", "networks"}},
+	}
+
+	for _, tt := range tests {
+		data := FirewallAliasesDataSourceModel{PreserveOrder: types.BoolValue(tt.preserveOrder)}
+		if diags := data.SetFromValue(context.Background(), ipAliases); diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		if data.IPCount.ValueInt64() != 3 {
+			t.Errorf("%s: ip_count = %d, want 3", tt.name, data.IPCount.ValueInt64())
+		}
+
+		if data.PortCount.ValueInt64() != 0 || data.PortCount.IsNull() {
+			t.Errorf("%s: port_count = %s, want 0", tt.name, data.PortCount)
+		}
+
+		if data.EntryCount.ValueInt64() != 5 {
+			t.Errorf("%s: entry_count = %d, want 5", tt.name, data.EntryCount.ValueInt64())
+		}
+
+		var ipAliasModels []FirewallIPAliasDataSourceModel
+		if diags := data.IP.ElementsAs(context.Background(), &ipAliasModels, false); diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		for i, ipAliasModel := range ipAliasModels {
+			if ipAliasModel.Name.ValueString() != tt.names[i] {
+				t.Errorf("%s: alias %d = '%s', want '%s'", tt.name, i, ipAliasModel.Name.ValueString(), tt.names[i])
+			}
+		}
+	}
+
+	// the order of the client response is left unchanged
+	if ipAliases[0].Name != "web" {
+		t.Errorf("expected aliases not to be sorted in place, got '%s' first", ipAliases[0].Name)
+	}
+
+	data := FirewallAliasesDataSourceModel{}
+	if diags := data.SetFromValue(context.Background(), nil); diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	if data.IPCount.ValueInt64() != 0 || data.EntryCount.ValueInt64() != 0 || data.IP.IsNull() {
+		t.Errorf("expected empty counts and list, got %d, %d, %s", data.IPCount.ValueInt64(), data.EntryCount.ValueInt64(), data.IP)
+	}
+}
@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +31,8 @@ type FirewallFilterReloadResource struct {
 type FirewallFilterReloadResourceModel struct {
 	ID          types.String `tfsdk:"id"`
 	LastUpdated types.String `tfsdk:"last_updated"`
+	RuleCount   types.Int64  `tfsdk:"rule_count"`
+	Errors      types.List   `tfsdk:"errors"`
 }
 
 func (r *FirewallFilterReloadResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,6 +57,21 @@ func (r *FirewallFilterReloadResource) Schema(ctx context.Context, req resource.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rule_count": schema.Int64Attribute{
+				Description: "Number of rules loaded after the reload.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"errors": schema.ListAttribute{
+				Description: "Errors found in the generated ruleset, empty when the ruleset is valid.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -72,15 +93,30 @@ func (r *FirewallFilterReloadResource) Create(ctx context.Context, req resource.
 		return
 	}
 
-	err := r.client.ReloadFirewallFilter(ctx)
+	status, err := r.client.ReloadFirewallFilterWithStatus(ctx)
 	if addError(&resp.Diagnostics, "Error reloading firewall filter", err) {
 		return
 	}
 
 	data.ID = types.StringValue(uuid.New().String())
-	data.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+	data.LastUpdated = types.StringValue(status.Timestamp.Format(time.RFC3339))
+	data.RuleCount = types.Int64Value(int64(status.RuleCount))
+
+	var diags diag.Diagnostics
+	data.Errors, diags = types.ListValueFrom(ctx, types.StringType, status.Errors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if len(status.Errors) != 0 {
+		resp.Diagnostics.AddError(
+			"Firewall filter reloaded with errors",
+			strings.Join(status.Errors, "\n"),
+		)
+	}
 }
 
 func (r *FirewallFilterReloadResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
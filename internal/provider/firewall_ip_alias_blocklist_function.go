@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &FirewallIPAliasBlocklistFunction{}
+
+func NewFirewallIPAliasBlocklistFunction() function.Function {
+	return &FirewallIPAliasBlocklistFunction{}
+}
+
+type FirewallIPAliasBlocklistFunction struct{}
+
+// expandFirewallIPAlias returns the addresses of an alias, entries naming another alias are replaced by its addresses.
+func expandFirewallIPAlias(name string, aliases map[string][]string, visiting map[string]bool) ([]string, error) {
+	if visiting[name] {
+		return nil, fmt.Errorf("alias '%s' references itself", name)
+	}
+
+	visiting[name] = true
+	defer delete(visiting, name)
+
+	addresses := []string{}
+	for _, entry := range aliases[name] {
+		if _, ok := aliases[entry]; !ok {
+			addresses = append(addresses, entry)
+			continue
+		}
+
+		nested, err := expandFirewallIPAlias(entry, aliases, visiting)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, nested...)
+	}
+
+	return addresses, nil
+}
+
+func (f *FirewallIPAliasBlocklistFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "firewall_ip_alias_blocklist"
+}
+
+func (f *FirewallIPAliasBlocklistFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Render a firewall IP alias as a blocklist.",
+		Description:         "Renders the entries of a firewall IP alias as a newline-delimited list (blocklist format), entries referencing another alias are expanded. Duplicate entries are removed.",
+		MarkdownDescription: "Renders the entries of a firewall IP alias as a newline-delimited list (blocklist format), entries referencing another alias are expanded. Duplicate entries are removed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name of alias to render.",
+			},
+			function.MapParameter{
+				Name:                "aliases",
+				Description:         "Map of alias name to entry addresses, must include the alias to render and any nested aliases (e.g. built from the 'pfsense_firewall_aliases' data source).",
+				MarkdownDescription: "Map of alias name to entry addresses, must include the alias to render and any nested aliases (e.g. built from the `pfsense_firewall_aliases` data source).",
+				ElementType:         types.ListType{ElemType: types.StringType},
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FirewallIPAliasBlocklistFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	var aliases map[string][]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &aliases))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, ok := aliases[name]; !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Alias '%s' not found in aliases", name))
+		return
+	}
+
+	addresses, err := expandFirewallIPAlias(name, aliases, map[string]bool{})
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Alias '%s' cannot be expanded, %s", name, err))
+		return
+	}
+
+	lines := []string{}
+	seen := map[string]bool{}
+	for _, address := range addresses {
+		if seen[address] {
+			continue
+		}
+
+		seen[address] = true
+		lines = append(lines, address)
+	}
+
+	result := ""
+	if len(lines) > 0 {
+		result = strings.Join(lines, "\n") + "\n"
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
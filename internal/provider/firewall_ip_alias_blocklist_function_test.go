@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFirewallIPAliasBlocklistFunction(t *testing.T, name string, aliases map[string][]string) (string, *function.FuncError) {
+	t.Helper()
+
+	elems := map[string]attr.Value{}
+	for aliasName, entries := range aliases {
+		values := []attr.Value{}
+		for _, entry := range entries {
+			values = append(values, types.StringValue(entry))
+		}
+		elems[aliasName] = types.ListValueMust(types.StringType, values)
+	}
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+		types.StringValue(name),
+		types.MapValueMust(types.ListType{ElemType: types.StringType}, elems),
+	})}
+	resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewFirewallIPAliasBlocklistFunction().Run(context.Background(), req, &resp)
+
+	result, _ := resp.Result.Value().(types.String)
+
+	return result.ValueString(), resp.Error
+}
+
+func TestExpandFirewallIPAlias(t *testing.T) {
+	aliases := map[string][]string{
+		"all":     {"10.0.0.1", "servers", "10.0.0.9"},
+		"servers": {"10.0.1.0/24", "web"},
+		"web":     {"10.0.2.1", "10.0.2.2"},
+	}
+
+	got, err := expandFirewallIPAlias("all", aliases, map[string]bool{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	want := []string{"10.0.0.1", "10.0.1.0/24", "10.0.2.1", "10.0.2.2", "10.0.0.9"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expandFirewallIPAlias() = %v, want %v", got, want)
+	}
+}
+
+func TestExpandFirewallIPAliasCycle(t *testing.T) {
+	aliases := map[string][]string{
+		"a": {"10.0.0.1", "b"},
+		"b": {"c"},
+		"c": {"a"},
+	}
+
+	if _, err := expandFirewallIPAlias("a", aliases, map[string]bool{}); err == nil {
+		t.Error("expected error for alias cycle")
+	}
+}
+
+func TestFirewallIPAliasBlocklistFunction(t *testing.T) {
+	aliases := map[string][]string{
+		"blocklist": {"192.0.2.1", "bad", "192.0.2.1", "198.51.100.0/24"},
+		"bad":       {"203.0.113.5", "198.51.100.0/24"},
+		"empty":     {},
+	}
+
+	got, funcErr := runFirewallIPAliasBlocklistFunction(t, "blocklist", aliases)
+	if funcErr != nil {
+		t.Fatalf("unexpected error, %s", funcErr)
+	}
+
+	want := "192.0.2.1\n203.0.113.5\n198.51.100.0/24\n"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	got, funcErr = runFirewallIPAliasBlocklistFunction(t, "empty", aliases)
+	if funcErr != nil {
+		t.Fatalf("unexpected error, %s", funcErr)
+	}
+
+	if got != "" {
+		t.Errorf("expected empty blocklist, got %q", got)
+	}
+}
+
+func TestFirewallIPAliasBlocklistFunctionErrors(t *testing.T) {
+	_, funcErr := runFirewallIPAliasBlocklistFunction(t, "missing", map[string][]string{"other": {"192.0.2.1"}})
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+		t.Errorf("expected error for argument 0, got %v", funcErr)
+	}
+
+	_, funcErr = runFirewallIPAliasBlocklistFunction(t, "loop", map[string][]string{"loop": {"loop"}})
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 1 {
+		t.Errorf("expected error for argument 1, got %v", funcErr)
+	}
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallIPAliasDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallIPAliasDataSource{}
+)
+
+func NewFirewallIPAliasDataSource() datasource.DataSource {
+	return &FirewallIPAliasDataSource{}
+}
+
+type FirewallIPAliasDataSource struct {
+	client *pfsense.Client
+}
+
+func (d *FirewallIPAliasDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_ip_alias", req.ProviderTypeName)
+}
+
+func (d *FirewallIPAliasDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves a single firewall IP alias by name. Aliases can be referenced by firewall rules, port forwards, outbound NAT rules, and other places in the firewall.",
+		MarkdownDescription: "Retrieves a single firewall IP [alias](https://docs.netgate.com/pfsense/en/latest/firewall/aliases.html) by name. Aliases can be referenced by firewall rules, port forwards, outbound NAT rules, and other places in the firewall.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of alias.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of alias.",
+				Computed:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "Host(s) or network(s).",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "Hosts must be specified by their IP address or fully qualified domain name (FQDN). Networks are specified in CIDR format.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"networks": schema.ListAttribute{
+				Description: "Networks of a network alias in normalized CIDR form (host bits cleared), entries that are not an IP address or CIDR are omitted.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *FirewallIPAliasDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallIPAliasDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallIPAliasDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipAlias, err := d.client.GetFirewallIPAlias(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get firewall IP alias", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromValue(ctx, ipAlias)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+func TestFirewallIPAliasDataSourceModelEntryOrder(t *testing.T) {
+	ipAlias := &pfsense.FirewallIPAlias{Name: "unsorted", Type: "network", Entries: []pfsense.FirewallIPAliasEntry{
+		{Address: "10.0.2.0/24", Description: "third"},
+		{Address: "10.0.0.0/24", Description: "first"},
+		{Address: "10.0.1.0/24"},
+	}}
+
+	var data FirewallIPAliasDataSourceModel
+	if diags := data.SetFromValue(context.Background(), ipAlias); diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	var entries []FirewallIPAliasEntryDataSourceModel
+	if diags := data.Entries.ElementsAs(context.Background(), &entries, false); diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	var got []string
+	for _, entry := range entries {
+		got = append(got, entry.Address.ValueString())
+	}
+
+	if want := []string{"10.0.2.0/24", "10.0.0.0/24", "10.0.1.0/24"}; !slices.Equal(got, want) {
+		t.Errorf("entry addresses = %v, want %v", got, want)
+	}
+}
+
+// TestAccFirewallIPAliasDataSource reads an alias by name with its entries in the configured order, a missing alias
+// is an error.
+func TestAccFirewallIPAliasDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_firewall_ip_alias" "test" {
+  name        = "tf_acc_test_data_source"
+  description = "tf acc test"
+  type        = "host"
+  entries = [
+    { address = "192.0.2.3", description = "third" },
+    { address = "192.0.2.1", description = "first" },
+    { address = "192.0.2.2", description = "second" },
+  ]
+}
+
+data "pfsense_firewall_ip_alias" "test" {
+  name = pfsense_firewall_ip_alias.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "type", "host"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "description", "tf acc test"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "entries.#", "3"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "entries.0.address", "192.0.2.3"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "entries.1.address", "192.0.2.1"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "entries.2.address", "192.0.2.2"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias.test", "entries.2.description", "second"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+data "pfsense_firewall_ip_alias" "test" {
+  name = "tf_acc_test_missing"
+}
+`,
+				ExpectError: regexp.MustCompile(`not found`),
+			},
+		},
+	})
+}
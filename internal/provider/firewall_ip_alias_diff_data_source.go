@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &FirewallIPAliasDiffDataSource{}
+	_ datasource.DataSourceWithConfigure = &FirewallIPAliasDiffDataSource{}
+)
+
+func NewFirewallIPAliasDiffDataSource() datasource.DataSource {
+	return &FirewallIPAliasDiffDataSource{}
+}
+
+type FirewallIPAliasDiffDataSource struct {
+	client *pfsense.Client
+}
+
+type FirewallIPAliasDiffDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Entries     types.List   `tfsdk:"entries"`
+	Exists      types.Bool   `tfsdk:"exists"`
+	Matches     types.Bool   `tfsdk:"matches"`
+	Difference  types.String `tfsdk:"difference"`
+}
+
+func (d FirewallIPAliasDiffDataSourceModel) Value(ctx context.Context) (*pfsense.FirewallIPAlias, diag.Diagnostics) {
+	var ipAlias pfsense.FirewallIPAlias
+	var err error
+	var diags diag.Diagnostics
+
+	var entryModels []*FirewallIPAliasEntryDataSourceModel
+	if !d.Entries.IsNull() {
+		diags = d.Entries.ElementsAs(ctx, &entryModels, false)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	err = ipAlias.SetName(d.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !d.Description.IsNull() {
+		err = ipAlias.SetDescription(d.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = ipAlias.SetType(d.Type.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Type cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	for i, entryModel := range entryModels {
+		var entry pfsense.FirewallIPAliasEntry
+
+		err = entry.SetAddress(entryModel.Address.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("address"),
+				"Entry address cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if !entryModel.Description.IsNull() {
+			err = entry.SetDescription(entryModel.Description.ValueString())
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("entries").AtListIndex(i).AtName("description"),
+					"Entry description cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		ipAlias.Entries = append(ipAlias.Entries, entry)
+	}
+
+	return &ipAlias, diags
+}
+
+func (d *FirewallIPAliasDiffDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_ip_alias_diff", req.ProviderTypeName)
+}
+
+func (d *FirewallIPAliasDiffDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Compares a desired firewall IP alias with the existing alias of the same name, without managing it. Useful for plan-time assertions (e.g. preconditions or checks).",
+		MarkdownDescription: "Compares a desired firewall IP [alias](https://docs.netgate.com/pfsense/en/latest/firewall/aliases.html) with the existing alias of the same name, without managing it. Useful for plan-time assertions (e.g. preconditions or checks).",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of alias.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Expected description of alias, defaults to empty.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Expected type of alias.",
+				Required:    true,
+			},
+			"entries": schema.ListNestedAttribute{
+				Description: "Expected host(s) or network(s), compared in order, defaults to none.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "Expected address of entry.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Expected description of entry, defaults to empty.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"exists": schema.BoolAttribute{
+				Description: "Alias exists.",
+				Computed:    true,
+			},
+			"matches": schema.BoolAttribute{
+				Description: "Alias exists and matches the expected definition.",
+				Computed:    true,
+			},
+			"difference": schema.StringAttribute{
+				Description: "Description of the differences, empty when the alias matches.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *FirewallIPAliasDiffDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FirewallIPAliasDiffDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FirewallIPAliasDiffDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipAliasReq, diags := data.Value(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ipAlias, err := d.client.GetFirewallIPAlias(ctx, ipAliasReq.Name)
+	if errors.Is(err, pfsense.ErrNotFound) {
+		data.Exists = types.BoolValue(false)
+		data.Matches = types.BoolValue(false)
+		data.Difference = types.StringValue("alias does not exist")
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if addError(&resp.Diagnostics, "Unable to get IP alias", err) {
+		return
+	}
+
+	differences := ipAliasReq.Differences(*ipAlias)
+
+	data.Exists = types.BoolValue(true)
+	data.Matches = types.BoolValue(len(differences) == 0)
+	data.Difference = types.StringValue(strings.Join(differences, ", "))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFirewallIPAliasDiffDataSource compares a created alias with a matching, a differing and a missing definition.
+func TestAccFirewallIPAliasDiffDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_firewall_ip_alias" "test" {
+  name = "tf_acc_diff"
+  type = "host"
+  entries = [
+    { address = "10.0.0.1", description = "web1" },
+    { address = "10.0.0.2" },
+  ]
+}
+
+data "pfsense_firewall_ip_alias_diff" "matches" {
+  name = pfsense_firewall_ip_alias.test.name
+  type = "host"
+  entries = [
+    { address = "10.0.0.1", description = "web1" },
+    { address = "10.0.0.2" },
+  ]
+}
+
+data "pfsense_firewall_ip_alias_diff" "differs" {
+  name = pfsense_firewall_ip_alias.test.name
+  type = "host"
+  entries = [
+    { address = "10.0.0.1", description = "web1" },
+  ]
+}
+
+data "pfsense_firewall_ip_alias_diff" "missing" {
+  name       = "tf_acc_diff_missing"
+  type       = "host"
+  depends_on = [pfsense_firewall_ip_alias.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.matches", "exists", "true"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.matches", "matches", "true"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.matches", "difference", ""),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.differs", "exists", "true"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.differs", "matches", "false"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.differs", "difference", "has 2 entries, expected 1"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.missing", "exists", "false"),
+					resource.TestCheckResourceAttr("data.pfsense_firewall_ip_alias_diff.missing", "matches", "false"),
+				),
+			},
+		},
+	})
+}
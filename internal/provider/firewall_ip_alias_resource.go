@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -10,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +23,7 @@ import (
 
 var _ resource.Resource = &FirewallIPAliasResource{}
 var _ resource.ResourceWithImportState = &FirewallIPAliasResource{}
+var _ resource.ResourceWithValidateConfig = &FirewallIPAliasResource{}
 
 func NewFirewallIPAliasResource() resource.Resource {
 	return &FirewallIPAliasResource{}
@@ -29,11 +34,16 @@ type FirewallIPAliasResource struct {
 }
 
 type FirewallIPAliasResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Type        types.String `tfsdk:"type"`
-	Apply       types.Bool   `tfsdk:"apply"`
-	Entries     types.List   `tfsdk:"entries"`
+	Name                    types.String `tfsdk:"name"`
+	Description             types.String `tfsdk:"description"`
+	Type                    types.String `tfsdk:"type"`
+	Apply                   types.Bool   `tfsdk:"apply"`
+	Entries                 types.List   `tfsdk:"entries"`
+	Addresses               types.Set    `tfsdk:"addresses"`
+	DefaultEntryDescription types.String `tfsdk:"default_entry_description"`
+	WarnIPv4PrefixLength    types.Int64  `tfsdk:"warn_ipv4_prefix_length"`
+	WarnIPv6PrefixLength    types.Int64  `tfsdk:"warn_ipv6_prefix_length"`
+	WarnBogons              types.Bool   `tfsdk:"warn_bogons"`
 }
 
 type FirewallIPAliasEntryResourceModel struct {
@@ -48,6 +58,18 @@ func (r FirewallIPAliasEntryResourceModel) GetAttrType() attr.Type {
 	}}
 }
 
+func newFirewallIPAliasEntryModel(entry pfsense.FirewallIPAliasEntry) FirewallIPAliasEntryResourceModel {
+	var entryModel FirewallIPAliasEntryResourceModel
+
+	entryModel.Address = types.StringValue(entry.Address)
+
+	if entry.Description != "" {
+		entryModel.Description = types.StringValue(entry.Description)
+	}
+
+	return entryModel
+}
+
 func (r *FirewallIPAliasResourceModel) SetFromValue(ctx context.Context, ipAlias *pfsense.FirewallIPAlias) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -59,30 +81,154 @@ func (r *FirewallIPAliasResourceModel) SetFromValue(ctx context.Context, ipAlias
 
 	r.Type = types.StringValue(ipAlias.Type)
 
+	if r.usesAddresses() {
+		return r.setAddressesFromValue(ctx, ipAlias)
+	}
+
+	var prevEntryModels []FirewallIPAliasEntryResourceModel
+	if !r.Entries.IsNull() && !r.Entries.IsUnknown() {
+		diags = r.Entries.ElementsAs(ctx, &prevEntryModels, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// match stored entries to configured entries by value rather than position, entries keep the configured order and
+	// the configured address when equivalent to the normalized address (e.g. '10.0.0.1/32' vs '10.0.0.1' or 'Example.COM'
+	// vs 'example.com'), unmatched stored entries are appended
+	matched := make([]bool, len(ipAlias.Entries))
 	entries := []FirewallIPAliasEntryResourceModel{}
-	for _, entry := range ipAlias.Entries {
-		var entryModel FirewallIPAliasEntryResourceModel
+	for _, prevEntryModel := range prevEntryModels {
+		var prevEntry pfsense.FirewallIPAliasEntry
+		if prevEntry.SetAddress(prevEntryModel.Address.ValueString()) != nil {
+			continue
+		}
+
+		index := -1
+		for i, entry := range ipAlias.Entries {
+			if matched[i] || entry.Address != prevEntry.Address {
+				continue
+			}
+
+			if index == -1 || entry.Description == prevEntryModel.Description.ValueString() {
+				index = i
+			}
 
-		entryModel.Address = types.StringValue(entry.Address)
+			if entry.Description == prevEntryModel.Description.ValueString() {
+				break
+			}
+		}
 
-		if entry.Description != "" {
-			entryModel.Description = types.StringValue(entry.Description)
+		if index == -1 {
+			continue
 		}
 
+		matched[index] = true
+		entryModel := newFirewallIPAliasEntryModel(ipAlias.Entries[index])
+		entryModel.Address = prevEntryModel.Address
 		entries = append(entries, entryModel)
 	}
 
+	for i, entry := range ipAlias.Entries {
+		if !matched[i] {
+			entries = append(entries, newFirewallIPAliasEntryModel(entry))
+		}
+	}
+
 	r.Entries, diags = types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), entries)
 	return diags
 }
 
+// usesAddresses reports whether entries are configured as a set of addresses instead of a list of entries.
+func (r FirewallIPAliasResourceModel) usesAddresses() bool {
+	return !r.Addresses.IsNull()
+}
+
+// sortedAddresses returns the configured addresses in the order they are submitted, sets are unordered.
+func (r FirewallIPAliasResourceModel) sortedAddresses(ctx context.Context) ([]string, diag.Diagnostics) {
+	var addresses []string
+	diags := r.Addresses.ElementsAs(ctx, &addresses, false)
+	sort.Strings(addresses)
+
+	return addresses, diags
+}
+
+// entryAddressPath returns the path of the address of an entry, which depends on how entries are configured.
+func (r FirewallIPAliasResourceModel) entryAddressPath(ctx context.Context, i int) path.Path {
+	if !r.usesAddresses() {
+		return path.Root("entries").AtListIndex(i).AtName("address")
+	}
+
+	addresses, _ := r.sortedAddresses(ctx)
+	if i >= len(addresses) {
+		return path.Root("addresses")
+	}
+
+	return path.Root("addresses").AtSetValue(types.StringValue(addresses[i]))
+}
+
+func (r *FirewallIPAliasResourceModel) setAddressesFromValue(ctx context.Context, ipAlias *pfsense.FirewallIPAlias) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var prevAddresses []string
+	if !r.Addresses.IsUnknown() {
+		diags = r.Addresses.ElementsAs(ctx, &prevAddresses, false)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// keep the configured address when equivalent to the normalized address (e.g. '10.0.0.1/32' vs '10.0.0.1')
+	configured := map[string]string{}
+	for _, prevAddress := range prevAddresses {
+		var prevEntry pfsense.FirewallIPAliasEntry
+		if prevEntry.SetAddress(prevAddress) == nil {
+			configured[prevEntry.Address] = prevAddress
+		}
+	}
+
+	// sets cannot hold duplicates, an address entered more than once in the web interface is reported once
+	addresses := []string{}
+	for _, entry := range ipAlias.Entries {
+		address, ok := configured[entry.Address]
+		if !ok {
+			address = entry.Address
+		}
+
+		if !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	r.Addresses, diags = types.SetValueFrom(ctx, types.StringType, addresses)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.Entries, diags = types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), []FirewallIPAliasEntryResourceModel{})
+
+	return diags
+}
+
 func (r FirewallIPAliasResourceModel) Value(ctx context.Context) (*pfsense.FirewallIPAlias, diag.Diagnostics) {
 	var ipAlias pfsense.FirewallIPAlias
 	var err error
 	var diags diag.Diagnostics
 
 	var entryModels []*FirewallIPAliasEntryResourceModel
-	diags = r.Entries.ElementsAs(ctx, &entryModels, false)
+	if r.usesAddresses() {
+		var addresses []string
+		addresses, diags = r.sortedAddresses(ctx)
+		for _, address := range addresses {
+			entryModels = append(entryModels, &FirewallIPAliasEntryResourceModel{
+				Address:     types.StringValue(address),
+				Description: types.StringNull(),
+			})
+		}
+	} else {
+		diags = r.Entries.ElementsAs(ctx, &entryModels, false)
+	}
+
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -126,14 +272,19 @@ func (r FirewallIPAliasResourceModel) Value(ctx context.Context) (*pfsense.Firew
 
 		if err != nil {
 			diags.AddAttributeError(
-				path.Root("entries").AtListIndex(i).AtName("address"),
+				r.entryAddressPath(ctx, i),
 				"Entry address cannot be parsed",
 				err.Error(),
 			)
 		}
 
-		if !entryModel.Description.IsNull() {
-			err = entry.SetDescription(entryModel.Description.ValueString())
+		description := entryModel.Description
+		if (description.IsNull() || description.IsUnknown()) && !r.DefaultEntryDescription.IsNull() {
+			description = r.DefaultEntryDescription
+		}
+
+		if !description.IsNull() {
+			err = entry.SetDescription(description.ValueString())
 
 			if err != nil {
 				diags.AddAttributeError(
@@ -147,9 +298,45 @@ func (r FirewallIPAliasResourceModel) Value(ctx context.Context) (*pfsense.Firew
 		ipAlias.Entries = append(ipAlias.Entries, entry)
 	}
 
+	checks := pfsense.FirewallIPAliasEntryChecks{
+		IPv4PrefixLength: int(r.WarnIPv4PrefixLength.ValueInt64()),
+		IPv6PrefixLength: int(r.WarnIPv6PrefixLength.ValueInt64()),
+		Bogons:           r.WarnBogons.ValueBool(),
+	}
+
+	for i, warning := range ipAlias.EntryWarnings(checks) {
+		diags.AddAttributeWarning(
+			r.entryAddressPath(ctx, i),
+			"Entry address may be a mistake",
+			warning,
+		)
+	}
+
 	return &ipAlias, diags
 }
 
+func addFirewallIPAliasError(ctx context.Context, diags *diag.Diagnostics, summary string, data *FirewallIPAliasResourceModel, ipAlias *pfsense.FirewallIPAlias, err error) bool {
+	var validationErr *pfsense.ServerValidationError
+	if !errors.As(err, &validationErr) {
+		return addError(diags, summary, err)
+	}
+
+	for _, message := range validationErr.Messages {
+		if i, ok := ipAlias.EntryIndex(message); ok {
+			diags.AddAttributeError(
+				data.entryAddressPath(ctx, i),
+				summary,
+				message,
+			)
+			continue
+		}
+
+		diags.AddError(summary, message)
+	}
+
+	return true
+}
+
 func (r *FirewallIPAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = fmt.Sprintf("%s_firewall_ip_alias", req.ProviderTypeName)
 }
@@ -177,6 +364,31 @@ func (r *FirewallIPAliasResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"default_entry_description": schema.StringAttribute{
+				Description: "Description applied to entries without a description, useful to identify managed entries in the web interface.",
+				Optional:    true,
+			},
+			"warn_ipv4_prefix_length": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Warn about IPv4 network entries with a prefix length shorter than this (e.g. '0.0.0.0/0'), '0' to disable, defaults to '%d'.", pfsense.DefaultIPv4EntryWarningPrefix),
+				MarkdownDescription: fmt.Sprintf("Warn about IPv4 network entries with a prefix length shorter than this (e.g. `0.0.0.0/0`), `0` to disable, defaults to `%d`.", pfsense.DefaultIPv4EntryWarningPrefix),
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(pfsense.DefaultIPv4EntryWarningPrefix),
+			},
+			"warn_ipv6_prefix_length": schema.Int64Attribute{
+				Description:         fmt.Sprintf("Warn about IPv6 network entries with a prefix length shorter than this (e.g. '::/0'), '0' to disable, defaults to '%d'.", pfsense.DefaultIPv6EntryWarningPrefix),
+				MarkdownDescription: fmt.Sprintf("Warn about IPv6 network entries with a prefix length shorter than this (e.g. `::/0`), `0` to disable, defaults to `%d`.", pfsense.DefaultIPv6EntryWarningPrefix),
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(pfsense.DefaultIPv6EntryWarningPrefix),
+			},
+			"warn_bogons": schema.BoolAttribute{
+				Description:         "Warn about private and reserved (bogon) entries, useful when an alias should only contain public addresses, defaults to 'false'.",
+				MarkdownDescription: "Warn about private and reserved (bogon) entries, useful when an alias should only contain public addresses, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"apply": schema.BoolAttribute{
 				Description:         "Apply change, defaults to 'true'.",
 				MarkdownDescription: "Apply change, defaults to `true`.",
@@ -184,11 +396,18 @@ func (r *FirewallIPAliasResource) Schema(ctx context.Context, req resource.Schem
 				Optional:            true,
 				Default:             booldefault.StaticBool(true),
 			},
+			"addresses": schema.SetAttribute{
+				Description:         "Host(s) or network(s) without descriptions, an unordered alternative to 'entries' that does not produce ordering differences. Conflicts with 'entries'.",
+				MarkdownDescription: "Host(s) or network(s) without descriptions, an unordered alternative to `entries` that does not produce ordering differences. Conflicts with `entries`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 			"entries": schema.ListNestedAttribute{
-				Description: "Host(s) or network(s).",
-				Computed:    true,
-				Optional:    true,
-				Default:     listdefault.StaticValue(types.ListValueMust(FirewallIPAliasEntryResourceModel{}.GetAttrType(), []attr.Value{})),
+				Description:         "Host(s) or network(s). Conflicts with 'addresses'.",
+				MarkdownDescription: "Host(s) or network(s). Conflicts with `addresses`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(FirewallIPAliasEntryResourceModel{}.GetAttrType(), []attr.Value{})),
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"address": schema.StringAttribute{
@@ -210,6 +429,74 @@ func (r *FirewallIPAliasResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+func (r *FirewallIPAliasResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *FirewallIPAliasResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Addresses.IsNull() && !data.Entries.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("addresses"),
+			"Conflicting entries",
+			"Only one of 'addresses' and 'entries' can be set.",
+		)
+		return
+	}
+
+	if data.Type.IsUnknown() {
+		return
+	}
+
+	ipAlias := pfsense.FirewallIPAlias{Type: data.Type.ValueString()}
+
+	if !data.Addresses.IsNull() && !data.Addresses.IsUnknown() {
+		var addresses []types.String
+		resp.Diagnostics.Append(data.Addresses.ElementsAs(ctx, &addresses, false)...)
+		for _, address := range addresses {
+			if address.IsUnknown() {
+				continue
+			}
+
+			err := ipAlias.ValidateEntryAddress(address.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("addresses").AtSetValue(address),
+					"Entry address does not match alias type",
+					err.Error(),
+				)
+			}
+		}
+	}
+
+	if data.Entries.IsNull() || data.Entries.IsUnknown() {
+		return
+	}
+
+	var entryModels []*FirewallIPAliasEntryResourceModel
+	resp.Diagnostics.Append(data.Entries.ElementsAs(ctx, &entryModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, entryModel := range entryModels {
+		if entryModel == nil || entryModel.Address.IsUnknown() {
+			continue
+		}
+
+		err := ipAlias.ValidateEntryAddress(entryModel.Address.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entries").AtListIndex(i).AtName("address"),
+				"Entry address does not match alias type",
+				err.Error(),
+			)
+		}
+	}
+}
+
 func (r *FirewallIPAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	client, ok := configureResourceClient(req, resp)
 	if !ok {
@@ -235,7 +522,7 @@ func (r *FirewallIPAliasResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	ipAlias, err := r.client.CreateFirewallIPAlias(ctx, *ipAliasReq)
-	if addError(&resp.Diagnostics, "Error creating IP alias", err) {
+	if addFirewallIPAliasError(ctx, &resp.Diagnostics, "Error creating IP alias", data, ipAliasReq, err) {
 		return
 	}
 
@@ -298,7 +585,7 @@ func (r *FirewallIPAliasResource) Update(ctx context.Context, req resource.Updat
 	}
 
 	ipAlias, err := r.client.UpdateFirewallIPAlias(ctx, *ipAliasReq)
-	if addError(&resp.Diagnostics, "Error updating IP alias", err) {
+	if addFirewallIPAliasError(ctx, &resp.Diagnostics, "Error updating IP alias", data, ipAliasReq, err) {
 		return
 	}
 
@@ -342,5 +629,19 @@ func (r *FirewallIPAliasResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *FirewallIPAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// only host and network aliases are returned, importing a missing alias or one of another type fails here instead of on read
+	_, err := r.client.GetFirewallIPAlias(ctx, req.ID)
+	if errors.Is(err, pfsense.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Unable to import IP alias",
+			fmt.Sprintf("IP alias '%s' does not exist or is not a host or network alias.", req.ID),
+		)
+		return
+	}
+
+	if addError(&resp.Diagnostics, "Unable to import IP alias", err) {
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
 }
@@ -0,0 +1,500 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	helperresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+// testFirewallIPAliasResourceConfig returns a configuration with the given attributes, the remaining attributes are null.
+func testFirewallIPAliasResourceConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	var schemaResp resource.SchemaResponse
+	NewFirewallIPAliasResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected schema to be an object type")
+	}
+
+	attrs := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(attrType, nil)
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		}
+	}
+
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attrs)}
+}
+
+func testFirewallIPAliasAddresses(addresses ...any) tftypes.Value {
+	var values []tftypes.Value
+	for _, address := range addresses {
+		values = append(values, tftypes.NewValue(tftypes.String, address))
+	}
+
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+}
+
+func testFirewallIPAliasEntries(addresses ...any) tftypes.Value {
+	entryType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"address":     tftypes.String,
+		"description": tftypes.String,
+	}}
+
+	var values []tftypes.Value
+	for _, address := range addresses {
+		values = append(values, tftypes.NewValue(entryType, map[string]tftypes.Value{
+			"address":     tftypes.NewValue(tftypes.String, address),
+			"description": tftypes.NewValue(tftypes.String, nil),
+		}))
+	}
+
+	return tftypes.NewValue(tftypes.List{ElementType: entryType}, values)
+}
+
+func TestFirewallIPAliasResourceValidateConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		aliasType tftypes.Value
+		addresses tftypes.Value
+		entries   tftypes.Value
+		want      []path.Path
+	}{
+		{
+			name:      "host entries",
+			aliasType: tftypes.NewValue(tftypes.String, "host"),
+			entries:   testFirewallIPAliasEntries("10.0.0.1", "10.0.0.2/32", "host.example.com"),
+		},
+		{
+			name:      "host entry network",
+			aliasType: tftypes.NewValue(tftypes.String, "host"),
+			entries:   testFirewallIPAliasEntries("10.0.0.1", "10.0.0.0/24"),
+			want:      []path.Path{path.Root("entries").AtListIndex(1).AtName("address")},
+		},
+		{
+			name:      "network entry host",
+			aliasType: tftypes.NewValue(tftypes.String, "network"),
+			entries:   testFirewallIPAliasEntries("fd00::1", "10.0.0.0/24", "10.0.0.1"),
+			want:      []path.Path{path.Root("entries").AtListIndex(0).AtName("address"), path.Root("entries").AtListIndex(2).AtName("address")},
+		},
+		{
+			name:      "network addresses",
+			aliasType: tftypes.NewValue(tftypes.String, "network"),
+			addresses: testFirewallIPAliasAddresses("10.0.0.0/24", "10.0.0.1/32"),
+		},
+		{
+			name:      "host address network",
+			aliasType: tftypes.NewValue(tftypes.String, "host"),
+			addresses: testFirewallIPAliasAddresses("10.0.0.1", "10.0.0.0/24"),
+			want:      []path.Path{path.Root("addresses").AtSetValue(types.StringValue("10.0.0.0/24"))},
+		},
+		{
+			name:      "addresses and entries",
+			aliasType: tftypes.NewValue(tftypes.String, "host"),
+			addresses: testFirewallIPAliasAddresses("10.0.0.1"),
+			entries:   testFirewallIPAliasEntries("10.0.0.2"),
+			want:      []path.Path{path.Root("addresses")},
+		},
+		{
+			name:      "unknown type",
+			aliasType: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			entries:   testFirewallIPAliasEntries("10.0.0.0/24", "10.0.0.1"),
+		},
+		{
+			name:      "unknown address",
+			aliasType: tftypes.NewValue(tftypes.String, "host"),
+			entries:   testFirewallIPAliasEntries(tftypes.UnknownValue, "10.0.0.0/24"),
+			want:      []path.Path{path.Root("entries").AtListIndex(1).AtName("address")},
+		},
+	}
+
+	for _, tt := range tests {
+		values := map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "test"),
+			"type": tt.aliasType,
+		}
+
+		if !tt.addresses.Equal(tftypes.Value{}) {
+			values["addresses"] = tt.addresses
+		}
+
+		if !tt.entries.Equal(tftypes.Value{}) {
+			values["entries"] = tt.entries
+		}
+
+		req := resource.ValidateConfigRequest{Config: testFirewallIPAliasResourceConfig(t, values)}
+		var resp resource.ValidateConfigResponse
+		(&FirewallIPAliasResource{}).ValidateConfig(context.Background(), req, &resp)
+
+		var got []path.Path
+		for _, d := range resp.Diagnostics.Errors() {
+			if d, ok := d.(diag.DiagnosticWithPath); ok {
+				got = append(got, d.Path())
+			}
+		}
+
+		if len(got) != len(tt.want) || resp.Diagnostics.ErrorsCount() != len(tt.want) {
+			t.Errorf("%s: expected errors for %v, got %v", tt.name, tt.want, resp.Diagnostics)
+			continue
+		}
+
+		for i := range got {
+			if !got[i].Equal(tt.want[i]) {
+				t.Errorf("%s: expected error for '%s', got '%s'", tt.name, tt.want[i], got[i])
+			}
+		}
+	}
+}
+
+func TestFirewallIPAliasResourceModelDefaultEntryDescription(t *testing.T) {
+	ctx := context.Background()
+
+	entries, diags := types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), []FirewallIPAliasEntryResourceModel{
+		{Address: types.StringValue("10.0.0.1"), Description: types.StringNull()},
+		{Address: types.StringValue("10.0.0.2"), Description: types.StringValue("web server")},
+		{Address: types.StringValue("10.0.0.3"), Description: types.StringUnknown()},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	tests := []struct {
+		name                    string
+		defaultEntryDescription types.String
+		want                    []string
+	}{
+		{"unset", types.StringNull(), []string{"", "web server", ""}},
+		{"set", types.StringValue("managed by terraform"), []string{"managed by terraform", "web server", "managed by terraform"}},
+	}
+
+	for _, tt := range tests {
+		model := FirewallIPAliasResourceModel{
+			Name:                    types.StringValue("test"),
+			Type:                    types.StringValue("host"),
+			Entries:                 entries,
+			Addresses:               types.SetNull(types.StringType),
+			DefaultEntryDescription: tt.defaultEntryDescription,
+		}
+
+		ipAlias, diags := model.Value(ctx)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		var got []string
+		for _, entry := range ipAlias.Entries {
+			got = append(got, entry.Description)
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: entry descriptions = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+
+		// the alias read back after submit keeps the defaulted descriptions in state
+		diags = model.SetFromValue(ctx, ipAlias)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		var entryModels []FirewallIPAliasEntryResourceModel
+		if diags := model.Entries.ElementsAs(ctx, &entryModels, false); diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		for i, entryModel := range entryModels {
+			if got := entryModel.Description.ValueString(); got != tt.want[i] {
+				t.Errorf("%s: entry %d state description = %q, want %q", tt.name, i, got, tt.want[i])
+			}
+		}
+	}
+}
+
+func TestFirewallIPAliasResourceModelEntryWarnings(t *testing.T) {
+	ctx := context.Background()
+
+	entries, diags := types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), []FirewallIPAliasEntryResourceModel{
+		{Address: types.StringValue("10.0.0.1"), Description: types.StringNull()},
+		{Address: types.StringValue("0.0.0.0/0"), Description: types.StringNull()},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	// a default route in a host alias is reported with the default thresholds, bogons only when enabled
+	tests := []struct {
+		warnBogons bool
+		want       []path.Path
+	}{
+		{false, []path.Path{path.Root("entries").AtListIndex(1).AtName("address")}},
+		{true, []path.Path{path.Root("entries").AtListIndex(0).AtName("address"), path.Root("entries").AtListIndex(1).AtName("address")}},
+	}
+
+	for _, tt := range tests {
+		model := FirewallIPAliasResourceModel{
+			Name:                 types.StringValue("test"),
+			Type:                 types.StringValue("host"),
+			Entries:              entries,
+			Addresses:            types.SetNull(types.StringType),
+			WarnIPv4PrefixLength: types.Int64Value(pfsense.DefaultIPv4EntryWarningPrefix),
+			WarnIPv6PrefixLength: types.Int64Value(pfsense.DefaultIPv6EntryWarningPrefix),
+			WarnBogons:           types.BoolValue(tt.warnBogons),
+		}
+
+		_, diags := model.Value(ctx)
+		if diags.HasError() {
+			t.Fatalf("unexpected error, %v", diags)
+		}
+
+		var got []path.Path
+		for _, d := range diags.Warnings() {
+			if d, ok := d.(diag.DiagnosticWithPath); ok {
+				got = append(got, d.Path())
+			}
+		}
+
+		slices.SortFunc(got, func(a, b path.Path) int { return strings.Compare(a.String(), b.String()) })
+
+		if len(got) != len(tt.want) {
+			t.Errorf("warn bogons %t: expected warnings for %v, got %v", tt.warnBogons, tt.want, diags)
+			continue
+		}
+
+		for i := range got {
+			if !got[i].Equal(tt.want[i]) {
+				t.Errorf("warn bogons %t: expected warning for '%s', got '%s'", tt.warnBogons, tt.want[i], got[i])
+			}
+		}
+	}
+}
+
+func TestFirewallIPAliasResourceModelMixedCaseFQDN(t *testing.T) {
+	ctx := context.Background()
+
+	// pfSense reports the lowercased FQDN, state keeps the configured spelling
+	stored := &pfsense.FirewallIPAlias{Name: "test", Type: "host", Entries: []pfsense.FirewallIPAliasEntry{
+		{Address: "host.example.com"},
+		{Address: "Web_Servers"},
+	}}
+
+	entries, diags := types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), []FirewallIPAliasEntryResourceModel{
+		{Address: types.StringValue("Host.Example.COM"), Description: types.StringNull()},
+		{Address: types.StringValue("Web_Servers"), Description: types.StringNull()},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	model := FirewallIPAliasResourceModel{Entries: entries, Addresses: types.SetNull(types.StringType)}
+
+	ipAlias, diags := model.Value(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	if got := ipAlias.Entries[0].Address; got != "host.example.com" {
+		t.Errorf("submitted address = %q, want %q", got, "host.example.com")
+	}
+
+	if diags := model.SetFromValue(ctx, stored); diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	var entryModels []FirewallIPAliasEntryResourceModel
+	if diags := model.Entries.ElementsAs(ctx, &entryModels, false); diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	var got []string
+	for _, entryModel := range entryModels {
+		got = append(got, entryModel.Address.ValueString())
+	}
+
+	if want := []string{"Host.Example.COM", "Web_Servers"}; !slices.Equal(got, want) {
+		t.Errorf("entry addresses = %v, want %v", got, want)
+	}
+
+	addresses, diags := types.SetValueFrom(ctx, types.StringType, []string{"Host.Example.COM", "Web_Servers"})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	model = FirewallIPAliasResourceModel{Entries: types.ListNull(FirewallIPAliasEntryResourceModel{}.GetAttrType()), Addresses: addresses}
+	if diags := model.SetFromValue(ctx, stored); diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	if want := addresses; !model.Addresses.Equal(want) {
+		t.Errorf("addresses = %s, want %s", model.Addresses, want)
+	}
+}
+
+func TestFirewallIPAliasResourceModelShuffledEntries(t *testing.T) {
+	ctx := context.Background()
+
+	configured := []FirewallIPAliasEntryResourceModel{
+		{Address: types.StringValue("10.0.0.1"), Description: types.StringValue("first")},
+		{Address: types.StringValue("10.0.0.2/32"), Description: types.StringValue("web")},
+		{Address: types.StringValue("host.example.com"), Description: types.StringNull()},
+		{Address: types.StringValue("10.0.0.1"), Description: types.StringValue("second")},
+	}
+
+	// entries stored in any order, including duplicate addresses told apart by description, map to the configured order
+	tests := []struct {
+		name   string
+		stored []pfsense.FirewallIPAliasEntry
+		want   []string
+	}{
+		{
+			"in order",
+			[]pfsense.FirewallIPAliasEntry{{Address: "10.0.0.1", Description: "first"}, {Address: "10.0.0.2", Description: "web"}, {Address: "host.example.com"}, {Address: "10.0.0.1", Description: "second"}},
+			[]string{"10.0.0.1 first", "10.0.0.2/32 web", "host.example.com ", "10.0.0.1 second"},
+		},
+		{
+			"shuffled",
+			[]pfsense.FirewallIPAliasEntry{{Address: "host.example.com"}, {Address: "10.0.0.1", Description: "second"}, {Address: "10.0.0.2", Description: "web"}, {Address: "10.0.0.1", Description: "first"}},
+			[]string{"10.0.0.1 first", "10.0.0.2/32 web", "host.example.com ", "10.0.0.1 second"},
+		},
+		{
+			"sorted with an entry added outside of terraform",
+			[]pfsense.FirewallIPAliasEntry{{Address: "10.0.0.1", Description: "first"}, {Address: "10.0.0.1", Description: "second"}, {Address: "10.0.0.2", Description: "web"}, {Address: "10.0.0.3"}, {Address: "host.example.com"}},
+			[]string{"10.0.0.1 first", "10.0.0.2/32 web", "host.example.com ", "10.0.0.1 second", "10.0.0.3 "},
+		},
+		{
+			"shuffled with an entry removed outside of terraform",
+			[]pfsense.FirewallIPAliasEntry{{Address: "10.0.0.1", Description: "second"}, {Address: "host.example.com"}, {Address: "10.0.0.1", Description: "first"}},
+			[]string{"10.0.0.1 first", "host.example.com ", "10.0.0.1 second"},
+		},
+	}
+
+	for _, tt := range tests {
+		entries, diags := types.ListValueFrom(ctx, FirewallIPAliasEntryResourceModel{}.GetAttrType(), configured)
+		if diags.HasError() {
+			t.Fatalf("unexpected error, %v", diags)
+		}
+
+		model := FirewallIPAliasResourceModel{Entries: entries, Addresses: types.SetNull(types.StringType)}
+		if diags := model.SetFromValue(ctx, &pfsense.FirewallIPAlias{Name: "test", Type: "host", Entries: tt.stored}); diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		var entryModels []FirewallIPAliasEntryResourceModel
+		if diags := model.Entries.ElementsAs(ctx, &entryModels, false); diags.HasError() {
+			t.Fatalf("%s: unexpected error, %v", tt.name, diags)
+		}
+
+		var got []string
+		for _, entryModel := range entryModels {
+			got = append(got, entryModel.Address.ValueString()+" "+entryModel.Description.ValueString())
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: entries = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFirewallIPAliasResourceModelAddressesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	addresses, diags := types.SetValueFrom(ctx, types.StringType, []string{"host.example.com", "10.0.0.2/32", "10.0.0.1", "192.168.0.0/24"})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	model := FirewallIPAliasResourceModel{
+		Name:      types.StringValue("test"),
+		Type:      types.StringValue("host"),
+		Addresses: addresses,
+		Entries:   types.ListNull(FirewallIPAliasEntryResourceModel{}.GetAttrType()),
+	}
+
+	// addresses are submitted in a stable order regardless of the order configured
+	ipAlias, diags := model.Value(ctx)
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	var submitted []string
+	for _, entry := range ipAlias.Entries {
+		submitted = append(submitted, entry.Address)
+	}
+
+	if want := []string{"10.0.0.1", "10.0.0.2", "192.168.0.0/24", "host.example.com"}; !slices.Equal(submitted, want) {
+		t.Errorf("submitted addresses = %v, want %v", submitted, want)
+	}
+
+	// stored entries in any order, including an address entered twice, read back as the configured set
+	for _, stored := range [][]pfsense.FirewallIPAliasEntry{
+		ipAlias.Entries,
+		{{Address: "host.example.com"}, {Address: "192.168.0.0/24"}, {Address: "10.0.0.2"}, {Address: "10.0.0.1"}},
+		{{Address: "10.0.0.2"}, {Address: "10.0.0.1"}, {Address: "host.example.com"}, {Address: "10.0.0.1"}, {Address: "192.168.0.0/24"}},
+	} {
+		model.Addresses = addresses
+		if diags := model.SetFromValue(ctx, &pfsense.FirewallIPAlias{Name: "test", Type: "host", Entries: stored}); diags.HasError() {
+			t.Fatalf("unexpected error, %v", diags)
+		}
+
+		if !model.Addresses.Equal(addresses) {
+			t.Errorf("addresses = %s, want %s", model.Addresses, addresses)
+		}
+
+		if model.Entries.IsNull() || len(model.Entries.Elements()) != 0 {
+			t.Errorf("expected empty entries when addresses are configured, got %s", model.Entries)
+		}
+	}
+}
+
+// TestAccFirewallIPAliasResourceAddresses configures addresses as a set, reordering the configuration is not a change.
+func TestAccFirewallIPAliasResourceAddresses(t *testing.T) {
+	config := func(addresses string) string {
+		return testAccProviderConfig() + `
+resource "pfsense_firewall_ip_alias" "test" {
+  name      = "tf_acc_test_addresses"
+  type      = "host"
+  addresses = ` + addresses + `
+}
+`
+	}
+
+	helperresource.Test(t, helperresource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []helperresource.TestStep{
+			{
+				Config: config(`["192.0.2.30", "192.0.2.10/32", "192.0.2.20"]`),
+				Check: helperresource.ComposeAggregateTestCheckFunc(
+					helperresource.TestCheckResourceAttr("pfsense_firewall_ip_alias.test", "addresses.#", "3"),
+					helperresource.TestCheckTypeSetElemAttr("pfsense_firewall_ip_alias.test", "addresses.*", "192.0.2.10/32"),
+					helperresource.TestCheckTypeSetElemAttr("pfsense_firewall_ip_alias.test", "addresses.*", "192.0.2.30"),
+					helperresource.TestCheckResourceAttr("pfsense_firewall_ip_alias.test", "entries.#", "0"),
+				),
+			},
+			{
+				Config:   config(`["192.0.2.20", "192.0.2.30", "192.0.2.10/32"]`),
+				PlanOnly: true,
+			},
+			{
+				Config: config(`["192.0.2.40", "192.0.2.20"]`),
+				Check: helperresource.ComposeAggregateTestCheckFunc(
+					helperresource.TestCheckResourceAttr("pfsense_firewall_ip_alias.test", "addresses.#", "2"),
+					helperresource.TestCheckTypeSetElemAttr("pfsense_firewall_ip_alias.test", "addresses.*", "192.0.2.40"),
+				),
+			},
+		},
+	})
+}
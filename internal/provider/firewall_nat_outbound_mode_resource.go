@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallNATOutboundModeResource{}
+
+func NewFirewallNATOutboundModeResource() resource.Resource {
+	return &FirewallNATOutboundModeResource{}
+}
+
+type FirewallNATOutboundModeResource struct {
+	client *pfsense.Client
+}
+
+type FirewallNATOutboundModeResourceModel struct {
+	Mode  types.String `tfsdk:"mode"`
+	Apply types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallNATOutboundModeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_nat_outbound_mode", req.ProviderTypeName)
+}
+
+func (r *FirewallNATOutboundModeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall outbound NAT mode, controls whether outbound NAT rules are generated automatically, configured manually, or both. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "Firewall [outbound NAT](https://docs.netgate.com/pfsense/en/latest/nat/outbound.html) mode, controls whether outbound NAT rules are generated automatically, configured manually, or both. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("Outbound NAT mode, options: '%s'. Rules managed by 'pfsense_firewall_nat_outbound' require 'hybrid' or 'manual'.", strings.Join(pfsense.OutboundNATModes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Outbound NAT mode, options: `%s`. Rules managed by `pfsense_firewall_nat_outbound` require `hybrid` or `manual`.", strings.Join(pfsense.OutboundNATModes(), "`, `")),
+				Required:            true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallNATOutboundModeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallNATOutboundModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallNATOutboundModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := r.client.UpdateOutboundNATMode(ctx, data.Mode.ValueString())
+	if addError(&resp.Diagnostics, "Error creating outbound NAT mode", err) {
+		return
+	}
+
+	data.Mode = types.StringValue(mode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT mode", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallNATOutboundModeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := r.client.GetOutboundNATMode(ctx)
+	if addError(&resp.Diagnostics, "Error reading outbound NAT mode", err) {
+		return
+	}
+
+	data.Mode = types.StringValue(mode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallNATOutboundModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallNATOutboundModeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := r.client.UpdateOutboundNATMode(ctx, data.Mode.ValueString())
+	if addError(&resp.Diagnostics, "Error updating outbound NAT mode", err) {
+		return
+	}
+
+	data.Mode = types.StringValue(mode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT mode", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundModeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
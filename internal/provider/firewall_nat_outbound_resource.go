@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallNATOutboundResource{}
+var _ resource.ResourceWithImportState = &FirewallNATOutboundResource{}
+
+func NewFirewallNATOutboundResource() resource.Resource {
+	return &FirewallNATOutboundResource{}
+}
+
+type FirewallNATOutboundResource struct {
+	client *pfsense.Client
+}
+
+type FirewallNATOutboundResourceModel struct {
+	Description        types.String `tfsdk:"description"`
+	Interface          types.String `tfsdk:"interface"`
+	Protocol           types.String `tfsdk:"protocol"`
+	Source             types.String `tfsdk:"source"`
+	SourcePort         types.String `tfsdk:"source_port"`
+	Destination        types.String `tfsdk:"destination"`
+	DestinationPort    types.String `tfsdk:"destination_port"`
+	DestinationInvert  types.Bool   `tfsdk:"destination_invert"`
+	TranslationAddress types.String `tfsdk:"translation_address"`
+	TranslationPort    types.String `tfsdk:"translation_port"`
+	StaticPort         types.Bool   `tfsdk:"static_port"`
+	Disabled           types.Bool   `tfsdk:"disabled"`
+	Apply              types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallNATOutboundResourceModel) SetFromValue(ctx context.Context, rule *pfsense.OutboundNATRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Description = types.StringValue(rule.Description)
+	r.Interface = types.StringValue(rule.Interface)
+	r.Protocol = types.StringValue(rule.Protocol)
+	r.Source = types.StringValue(rule.Source)
+
+	r.SourcePort = types.StringNull()
+	if rule.SourcePort != "" {
+		r.SourcePort = types.StringValue(rule.SourcePort)
+	}
+
+	r.Destination = types.StringValue(rule.Destination)
+
+	r.DestinationPort = types.StringNull()
+	if rule.DestinationPort != "" {
+		r.DestinationPort = types.StringValue(rule.DestinationPort)
+	}
+
+	r.DestinationInvert = types.BoolValue(rule.DestinationInvert)
+
+	r.TranslationAddress = types.StringNull()
+	if rule.TranslationAddress != "" {
+		r.TranslationAddress = types.StringValue(rule.TranslationAddress)
+	}
+
+	r.TranslationPort = types.StringNull()
+	if rule.TranslationPort != "" {
+		r.TranslationPort = types.StringValue(rule.TranslationPort)
+	}
+
+	r.StaticPort = types.BoolValue(rule.StaticPort)
+	r.Disabled = types.BoolValue(rule.Disabled)
+
+	return diags
+}
+
+func (r FirewallNATOutboundResourceModel) Value(ctx context.Context) (*pfsense.OutboundNATRule, diag.Diagnostics) {
+	var rule pfsense.OutboundNATRule
+	var err error
+	var diags diag.Diagnostics
+
+	err = rule.SetDescription(r.Description.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("description"),
+			"Description cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetProtocol(r.Protocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("protocol"),
+			"Protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetSource(r.Source.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Source cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.SourcePort.IsNull() {
+		err = rule.SetSourcePort(r.SourcePort.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("source_port"),
+				"Source port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = rule.SetDestination(r.Destination.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination"),
+			"Destination cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.DestinationPort.IsNull() {
+		err = rule.SetDestinationPort(r.DestinationPort.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("destination_port"),
+				"Destination port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = rule.SetDestinationInvert(r.DestinationInvert.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("destination_invert"),
+			"Destination invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.TranslationAddress.IsNull() {
+		err = rule.SetTranslationAddress(r.TranslationAddress.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("translation_address"),
+				"Translation address cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.TranslationPort.IsNull() {
+		err = rule.SetTranslationPort(r.TranslationPort.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("translation_port"),
+				"Translation port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = rule.SetStaticPort(r.StaticPort.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("static_port"),
+			"Static port cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetDisabled(r.Disabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if diags.HasError() {
+		return &rule, diags
+	}
+
+	err = rule.Validate()
+
+	if err != nil {
+		diags.AddError(
+			"Outbound NAT rule is not valid",
+			err.Error(),
+		)
+	}
+
+	return &rule, diags
+}
+
+func (r *FirewallNATOutboundResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_nat_outbound", req.ProviderTypeName)
+}
+
+func (r *FirewallNATOutboundResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall outbound NAT rule (mapping), translates the source address of traffic leaving an interface. Only used when the outbound NAT mode is 'hybrid' or 'manual'.",
+		MarkdownDescription: "Firewall [outbound NAT](https://docs.netgate.com/pfsense/en/latest/nat/outbound.html) rule (mapping), translates the source address of traffic leaving an interface. Only used when the outbound NAT mode is `hybrid` or `manual`, see `pfsense_firewall_nat_outbound_mode`.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "Description of outbound NAT rule, must be unique as it identifies the rule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface the traffic leaves through, defaults to 'wan'.",
+				MarkdownDescription: "Interface the traffic leaves through, defaults to `wan`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("wan"),
+			},
+			"protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("Protocol to match, options: '%s', defaults to 'any'.", strings.Join(pfsense.OutboundNATProtocols(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Protocol to match, options: `%s`, defaults to `any`.", strings.Join(pfsense.OutboundNATProtocols(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"source": schema.StringAttribute{
+				Description:         "Source of matching traffic, 'any', a network in CIDR notation, or an alias.",
+				MarkdownDescription: "Source of matching traffic, `any`, a network in CIDR notation, or an alias.",
+				Required:            true,
+			},
+			"source_port": schema.StringAttribute{
+				Description:         "Source port, port range (e.g. '1000-2000'), or port alias of matching traffic.",
+				MarkdownDescription: "Source port, port range (e.g. `1000-2000`), or port alias of matching traffic.",
+				Optional:            true,
+			},
+			"destination": schema.StringAttribute{
+				Description:         "Destination of matching traffic, 'any', a network in CIDR notation, or an alias, defaults to 'any'.",
+				MarkdownDescription: "Destination of matching traffic, `any`, a network in CIDR notation, or an alias, defaults to `any`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"destination_port": schema.StringAttribute{
+				Description:         "Destination port, port range (e.g. '1000-2000'), or port alias of matching traffic.",
+				MarkdownDescription: "Destination port, port range (e.g. `1000-2000`), or port alias of matching traffic.",
+				Optional:            true,
+			},
+			"destination_invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the destination match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the destination match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"translation_address": schema.StringAttribute{
+				Description: "Address, network in CIDR notation, or alias the source is translated to, the interface address when unset.",
+				Optional:    true,
+			},
+			"translation_port": schema.StringAttribute{
+				Description: "Port or port range the source port is translated to, randomized when unset.",
+				Optional:    true,
+			},
+			"static_port": schema.BoolAttribute{
+				Description:         "Keep the source port unchanged, mutually exclusive with translation port, defaults to 'false'.",
+				MarkdownDescription: "Keep the source port unchanged, mutually exclusive with `translation_port`, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable this outbound NAT rule without removing it, defaults to 'false'.",
+				MarkdownDescription: "Disable this outbound NAT rule without removing it, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallNATOutboundResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallNATOutboundResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallNATOutboundResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateOutboundNATRule(ctx, *ruleReq)
+	if addError(&resp.Diagnostics, "Error creating outbound NAT rule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, rule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT rule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallNATOutboundResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetOutboundNATRule(ctx, data.Description.ValueString())
+	if addError(&resp.Diagnostics, "Error reading outbound NAT rule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, rule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallNATOutboundResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallNATOutboundResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.UpdateOutboundNATRule(ctx, *ruleReq)
+	if addError(&resp.Diagnostics, "Error updating outbound NAT rule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, rule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT rule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallNATOutboundResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOutboundNATRule(ctx, data.Description.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting outbound NAT rule", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying outbound NAT rule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallNATOutboundResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("description"), req, resp)
+}
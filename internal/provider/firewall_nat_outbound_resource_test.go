@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFirewallNATOutboundResourceConfig(translationAddress string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_firewall_nat_outbound_mode" "test" {
+  mode = "hybrid"
+}
+
+resource "pfsense_firewall_nat_outbound" "test" {
+  description         = "tf acc outbound"
+  interface           = "wan"
+  protocol            = "udp"
+  source              = "192.0.2.0/24"
+  destination_port    = "500"
+  translation_address = %q
+  static_port         = true
+
+  depends_on = [pfsense_firewall_nat_outbound_mode.test]
+}
+`, translationAddress)
+}
+
+// TestAccFirewallNATOutboundResource switches to hybrid mode and creates a manual mapping, the last step restores
+// automatic mode as destroying the mode resource leaves the mode unchanged.
+func TestAccFirewallNATOutboundResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallNATOutboundResourceConfig("198.51.100.10"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound_mode.test", "mode", "hybrid"),
+					resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound.test", "source", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound.test", "destination", "any"),
+					resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound.test", "translation_address", "198.51.100.10"),
+					resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound.test", "static_port", "true"),
+				),
+			},
+			{
+				Config: testAccFirewallNATOutboundResourceConfig("198.51.100.11"),
+				Check:  resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound.test", "translation_address", "198.51.100.11"),
+			},
+			{
+				ResourceName:                         "pfsense_firewall_nat_outbound.test",
+				ImportState:                          true,
+				ImportStateId:                        "tf acc outbound",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "description",
+				ImportStateVerifyIgnore:              []string{"apply"},
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_firewall_nat_outbound_mode" "test" {
+  mode = "automatic"
+}
+`,
+				Check: resource.TestCheckResourceAttr("pfsense_firewall_nat_outbound_mode.test", "mode", "automatic"),
+			},
+		},
+	})
+}
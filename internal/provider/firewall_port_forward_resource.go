@@ -0,0 +1,424 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+const firewallPortForwardControlIDPrefix = "id:"
+
+var _ resource.Resource = &FirewallPortForwardResource{}
+var _ resource.ResourceWithImportState = &FirewallPortForwardResource{}
+
+func NewFirewallPortForwardResource() resource.Resource {
+	return &FirewallPortForwardResource{}
+}
+
+type FirewallPortForwardResource struct {
+	client *pfsense.Client
+}
+
+type FirewallPortForwardResourceModel struct {
+	Description   types.String `tfsdk:"description"`
+	Interface     types.String `tfsdk:"interface"`
+	IPProtocol    types.String `tfsdk:"ip_protocol"`
+	Protocol      types.String `tfsdk:"protocol"`
+	Source        types.Object `tfsdk:"source"`
+	Destination   types.Object `tfsdk:"destination"`
+	Target        types.String `tfsdk:"target"`
+	TargetPort    types.String `tfsdk:"target_port"`
+	NATReflection types.String `tfsdk:"nat_reflection"`
+	FilterRule    types.String `tfsdk:"filter_rule"`
+	Disabled      types.Bool   `tfsdk:"disabled"`
+	Apply         types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallPortForwardResourceModel) SetFromValue(ctx context.Context, pfwd *pfsense.PortForward) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Description = types.StringValue(pfwd.Description)
+	r.Interface = types.StringValue(pfwd.Interface)
+	r.IPProtocol = types.StringValue(pfwd.IPProtocol)
+	r.Protocol = types.StringValue(pfwd.Protocol)
+
+	var sourceModel FirewallRuleEndpointResourceModel
+	sourceModel.SetFromValue(ctx, &pfwd.Source)
+
+	r.Source, diags = types.ObjectValueFrom(ctx, FirewallRuleEndpointResourceModel{}.GetAttrTypes(), sourceModel)
+	if diags.HasError() {
+		return diags
+	}
+
+	var destinationModel FirewallRuleEndpointResourceModel
+	destinationModel.SetFromValue(ctx, &pfwd.Destination)
+
+	r.Destination, diags = types.ObjectValueFrom(ctx, FirewallRuleEndpointResourceModel{}.GetAttrTypes(), destinationModel)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.Target = types.StringValue(pfwd.Target)
+	r.TargetPort = types.StringValue(pfwd.TargetPort)
+	r.NATReflection = types.StringValue(pfwd.NATReflection)
+	r.FilterRule = types.StringValue(pfwd.FilterRule)
+	r.Disabled = types.BoolValue(pfwd.Disabled)
+
+	return diags
+}
+
+func (r FirewallPortForwardResourceModel) Value(ctx context.Context) (*pfsense.PortForward, diag.Diagnostics) {
+	var pfwd pfsense.PortForward
+	var err error
+	var diags diag.Diagnostics
+
+	err = pfwd.SetDescription(r.Description.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("description"),
+			"Description cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetIPProtocol(r.IPProtocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ip_protocol"),
+			"IP protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetProtocol(r.Protocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("protocol"),
+			"Protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	var sourceModel FirewallRuleEndpointResourceModel
+	diags.Append(r.Source.As(ctx, &sourceModel, basetypes.ObjectAsOptions{})...)
+
+	source, d := sourceModel.Value(ctx, path.Root("source"))
+	diags.Append(d...)
+	pfwd.Source = *source
+
+	var destinationModel FirewallRuleEndpointResourceModel
+	diags.Append(r.Destination.As(ctx, &destinationModel, basetypes.ObjectAsOptions{})...)
+
+	destination, d := destinationModel.Value(ctx, path.Root("destination"))
+	diags.Append(d...)
+	pfwd.Destination = *destination
+
+	err = pfwd.SetTarget(r.Target.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("target"),
+			"Target cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetTargetPort(r.TargetPort.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("target_port"),
+			"Target port cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetNATReflection(r.NATReflection.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("nat_reflection"),
+			"NAT reflection cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetFilterRule(r.FilterRule.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("filter_rule"),
+			"Filter rule cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = pfwd.SetDisabled(r.Disabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &pfwd, diags
+}
+
+func (r *FirewallPortForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_port_forward", req.ProviderTypeName)
+}
+
+func (r *FirewallPortForwardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall NAT port forward, redirects traffic destined to an address and port to an internal host.",
+		MarkdownDescription: "Firewall NAT [port forward](https://docs.netgate.com/pfsense/en/latest/nat/port-forwards.html), redirects traffic destined to an address and port to an internal host.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "Description of port forward, must be unique as it identifies the port forward.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface the port forward applies to, defaults to 'wan'.",
+				MarkdownDescription: "Interface the port forward applies to, defaults to `wan`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("wan"),
+			},
+			"ip_protocol": schema.StringAttribute{
+				Description:         "Internet Protocol version, options: 'inet', 'inet6', defaults to 'inet'.",
+				MarkdownDescription: "Internet Protocol version, options: `inet`, `inet6`, defaults to `inet`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("inet"),
+			},
+			"protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("Protocol to match, options: '%s', defaults to 'tcp'.", strings.Join(pfsense.PortForwardProtocols(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Protocol to match, options: `%s`, defaults to `tcp`.", strings.Join(pfsense.PortForwardProtocols(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("tcp"),
+			},
+			"source":      firewallRuleEndpointSchema("Source of matching traffic"),
+			"destination": firewallRuleEndpointSchema("Destination of matching traffic, typically the interface address (e.g. network 'wanip') and external port"),
+			"target": schema.StringAttribute{
+				Description: "Internal IP address or alias to redirect traffic to.",
+				Required:    true,
+			},
+			"target_port": schema.StringAttribute{
+				Description: "Internal port or port alias to redirect traffic to, the start of the range when the destination is a port range.",
+				Required:    true,
+			},
+			"nat_reflection": schema.StringAttribute{
+				Description:         fmt.Sprintf("NAT reflection mode, options: '%s', defaults to 'default' (system setting).", strings.Join(pfsense.PortForwardNATReflections(), "', '")),
+				MarkdownDescription: fmt.Sprintf("NAT reflection mode, options: `%s`, defaults to `default` (system setting).", strings.Join(pfsense.PortForwardNATReflections(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("default"),
+			},
+			"filter_rule": schema.StringAttribute{
+				Description:         fmt.Sprintf("Filter rule association, options: '%s', defaults to 'associated' (a linked filter rule is created).", strings.Join(pfsense.PortForwardFilterRules(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Filter rule association, options: `%s`, defaults to `associated` (a linked filter rule is created).", strings.Join(pfsense.PortForwardFilterRules(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("associated"),
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable this port forward without removing it, defaults to 'false'.",
+				MarkdownDescription: "Disable this port forward without removing it, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallPortForwardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallPortForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallPortForwardResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pfwdReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pfwd, err := r.client.CreatePortForward(ctx, *pfwdReq)
+	if addError(&resp.Diagnostics, "Error creating port forward", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, pfwd)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying port forward", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallPortForwardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallPortForwardResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pfwd, err := r.client.GetPortForward(ctx, data.Description.ValueString())
+	if addError(&resp.Diagnostics, "Error reading port forward", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, pfwd)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallPortForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallPortForwardResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pfwdReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pfwd, err := r.client.UpdatePortForward(ctx, *pfwdReq)
+	if addError(&resp.Diagnostics, "Error updating port forward", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, pfwd)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying port forward", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallPortForwardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallPortForwardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePortForward(ctx, data.Description.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting port forward", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying port forward", err) {
+			return
+		}
+	}
+}
+
+// ImportState imports by description, or by control ID (position on the port forward page) prefixed with 'id:'.
+func (r *FirewallPortForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, ok := strings.CutPrefix(req.ID, firewallPortForwardControlIDPrefix)
+	if !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("description"), req, resp)
+		return
+	}
+
+	controlID, err := strconv.Atoi(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Control ID '%s' must be numeric (e.g. '%s3').", id, firewallPortForwardControlIDPrefix),
+		)
+		return
+	}
+
+	pfwd, err := r.client.GetPortForwardByControlID(ctx, controlID)
+	if addError(&resp.Diagnostics, "Error importing port forward", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("description"), pfwd.Description)...)
+}
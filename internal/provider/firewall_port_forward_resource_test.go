@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFirewallPortForwardResourceConfig(target string, targetPort string) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_firewall_port_forward" "test" {
+  description = "tf acc port forward"
+  interface   = "wan"
+  protocol    = "tcp"
+  destination = { network = "wanip", port = "8443" }
+  target      = %q
+  target_port = %q
+  apply       = false
+}
+`, target, targetPort)
+}
+
+func TestAccFirewallPortForwardResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPortForwardResourceConfig("192.0.2.10", "443"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_port_forward.test", "protocol", "tcp"),
+					resource.TestCheckResourceAttr("pfsense_firewall_port_forward.test", "target", "192.0.2.10"),
+					resource.TestCheckResourceAttr("pfsense_firewall_port_forward.test", "target_port", "443"),
+					resource.TestCheckResourceAttr("pfsense_firewall_port_forward.test", "destination.port", "8443"),
+				),
+			},
+			{
+				Config: testAccFirewallPortForwardResourceConfig("192.0.2.11", "8080"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_port_forward.test", "target", "192.0.2.11"),
+					resource.TestCheckResourceAttr("pfsense_firewall_port_forward.test", "target_port", "8080"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_firewall_port_forward.test",
+				ImportState:                          true,
+				ImportStateId:                        "tf acc port forward",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "description",
+				ImportStateVerifyIgnore:              []string{"apply"},
+			},
+		},
+	})
+}
@@ -0,0 +1,540 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallRuleResource{}
+var _ resource.ResourceWithImportState = &FirewallRuleResource{}
+
+func NewFirewallRuleResource() resource.Resource {
+	return &FirewallRuleResource{}
+}
+
+type FirewallRuleResource struct {
+	client *pfsense.Client
+}
+
+type FirewallRuleResourceModel struct {
+	Tracker     types.String `tfsdk:"tracker"`
+	Interface   types.String `tfsdk:"interface"`
+	Action      types.String `tfsdk:"action"`
+	IPProtocol  types.String `tfsdk:"ip_protocol"`
+	Protocol    types.String `tfsdk:"protocol"`
+	Source      types.Object `tfsdk:"source"`
+	Destination types.Object `tfsdk:"destination"`
+	Direction   types.String `tfsdk:"direction"`
+	Log         types.Bool   `tfsdk:"log"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Description types.String `tfsdk:"description"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+type FirewallRuleEndpointResourceModel struct {
+	Address types.String `tfsdk:"address"`
+	Network types.String `tfsdk:"network"`
+	Port    types.String `tfsdk:"port"`
+	Invert  types.Bool   `tfsdk:"invert"`
+}
+
+func (r FirewallRuleEndpointResourceModel) GetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"address": types.StringType,
+		"network": types.StringType,
+		"port":    types.StringType,
+		"invert":  types.BoolType,
+	}
+}
+
+func (r *FirewallRuleEndpointResourceModel) SetFromValue(ctx context.Context, endpoint *pfsense.FirewallRuleEndpoint) {
+	if endpoint.Address != "" {
+		r.Address = types.StringValue(endpoint.Address)
+	}
+
+	if endpoint.Network != "" {
+		r.Network = types.StringValue(endpoint.Network)
+	}
+
+	if endpoint.Port != "" {
+		r.Port = types.StringValue(endpoint.Port)
+	}
+
+	r.Invert = types.BoolValue(endpoint.Invert)
+}
+
+func (r FirewallRuleEndpointResourceModel) Value(ctx context.Context, attrPath path.Path) (*pfsense.FirewallRuleEndpoint, diag.Diagnostics) {
+	var endpoint pfsense.FirewallRuleEndpoint
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.Address.IsNull() {
+		err = endpoint.SetAddress(r.Address.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				attrPath.AtName("address"),
+				"Address cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Network.IsNull() {
+		err = endpoint.SetNetwork(r.Network.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				attrPath.AtName("network"),
+				"Network cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Port.IsNull() {
+		err = endpoint.SetPort(r.Port.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				attrPath.AtName("port"),
+				"Port cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = endpoint.SetInvert(r.Invert.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			attrPath.AtName("invert"),
+			"Invert cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &endpoint, diags
+}
+
+func (r *FirewallRuleResourceModel) SetFromValue(ctx context.Context, rule *pfsense.FirewallRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Tracker = types.StringValue(rule.Tracker)
+	r.Interface = types.StringValue(rule.Interface)
+	r.Action = types.StringValue(rule.Action)
+	r.IPProtocol = types.StringValue(rule.IPProtocol)
+	r.Protocol = types.StringValue(rule.Protocol)
+
+	var sourceModel FirewallRuleEndpointResourceModel
+	sourceModel.SetFromValue(ctx, &rule.Source)
+
+	r.Source, diags = types.ObjectValueFrom(ctx, FirewallRuleEndpointResourceModel{}.GetAttrTypes(), sourceModel)
+	if diags.HasError() {
+		return diags
+	}
+
+	var destinationModel FirewallRuleEndpointResourceModel
+	destinationModel.SetFromValue(ctx, &rule.Destination)
+
+	r.Destination, diags = types.ObjectValueFrom(ctx, FirewallRuleEndpointResourceModel{}.GetAttrTypes(), destinationModel)
+	if diags.HasError() {
+		return diags
+	}
+
+	r.Direction = types.StringNull()
+	if rule.Direction != "" {
+		r.Direction = types.StringValue(rule.Direction)
+	}
+
+	r.Log = types.BoolValue(rule.Log)
+	r.Disabled = types.BoolValue(rule.Disabled)
+
+	if rule.Description != "" {
+		r.Description = types.StringValue(rule.Description)
+	}
+
+	return diags
+}
+
+func (r FirewallRuleResourceModel) Value(ctx context.Context) (*pfsense.FirewallRule, diag.Diagnostics) {
+	var rule pfsense.FirewallRule
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.Tracker.IsNull() && !r.Tracker.IsUnknown() {
+		err = rule.SetTracker(r.Tracker.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tracker"),
+				"Tracker cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = rule.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetAction(r.Action.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("action"),
+			"Action cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetIPProtocol(r.IPProtocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ip_protocol"),
+			"IP protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetProtocol(r.Protocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("protocol"),
+			"Protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	var sourceModel FirewallRuleEndpointResourceModel
+	diags.Append(r.Source.As(ctx, &sourceModel, basetypes.ObjectAsOptions{})...)
+
+	source, d := sourceModel.Value(ctx, path.Root("source"))
+	diags.Append(d...)
+	rule.Source = *source
+
+	var destinationModel FirewallRuleEndpointResourceModel
+	diags.Append(r.Destination.As(ctx, &destinationModel, basetypes.ObjectAsOptions{})...)
+
+	destination, d := destinationModel.Value(ctx, path.Root("destination"))
+	diags.Append(d...)
+	rule.Destination = *destination
+
+	if !r.Direction.IsNull() {
+		err = rule.SetDirection(r.Direction.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("direction"),
+				"Direction cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = rule.SetLog(r.Log.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("log"),
+			"Log cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = rule.SetDisabled(r.Disabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = rule.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &rule, diags
+}
+
+func firewallRuleEndpointSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("%s, defaults to any.", description),
+		Computed:    true,
+		Optional:    true,
+		Default: objectdefault.StaticValue(types.ObjectValueMust(FirewallRuleEndpointResourceModel{}.GetAttrTypes(), map[string]attr.Value{
+			"address": types.StringNull(),
+			"network": types.StringNull(),
+			"port":    types.StringNull(),
+			"invert":  types.BoolValue(false),
+		})),
+		Attributes: map[string]schema.Attribute{
+			"address": schema.StringAttribute{
+				Description: "IP address, network (CIDR), or alias. Mutually exclusive with network, leave both unset to match any.",
+				Optional:    true,
+			},
+			"network": schema.StringAttribute{
+				Description:         "Interface network or address macro (e.g. 'lan', 'lanip', '(self)'). Mutually exclusive with address, leave both unset to match any.",
+				MarkdownDescription: "Interface network or address macro (e.g. `lan`, `lanip`, `(self)`). Mutually exclusive with address, leave both unset to match any.",
+				Optional:            true,
+			},
+			"port": schema.StringAttribute{
+				Description:         "Port, port range (e.g. '1000-2000'), or port alias. Only applies to TCP and UDP rules.",
+				MarkdownDescription: "Port, port range (e.g. `1000-2000`), or port alias. Only applies to TCP and UDP rules.",
+				Optional:            true,
+			},
+			"invert": schema.BoolAttribute{
+				Description:         "Invert the sense of the match, defaults to 'false'.",
+				MarkdownDescription: "Invert the sense of the match, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *FirewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_rule", req.ProviderTypeName)
+}
+
+func (r *FirewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall rule, controls traffic passing through the firewall. New rules are added to the bottom of the interface's rule list.",
+		MarkdownDescription: "Firewall [rule](https://docs.netgate.com/pfsense/en/latest/firewall/index.html), controls traffic passing through the firewall. New rules are added to the bottom of the interface's rule list.",
+		Attributes: map[string]schema.Attribute{
+			"tracker": schema.StringAttribute{
+				Description: "Tracker ID, stable identifier of the rule assigned by pfSense.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface the rule applies to (e.g. 'lan', 'wan', 'opt1').",
+				MarkdownDescription: "Interface the rule applies to (e.g. `lan`, `wan`, `opt1`).",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				Description:         fmt.Sprintf("Action taken on matching traffic, options: '%s', defaults to 'pass'.", strings.Join(pfsense.FirewallRuleActions(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Action taken on matching traffic, options: `%s`, defaults to `pass`.", strings.Join(pfsense.FirewallRuleActions(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("pass"),
+			},
+			"ip_protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("Internet Protocol version, options: '%s', defaults to 'inet'.", strings.Join(pfsense.FirewallRuleIPProtocols(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Internet Protocol version, options: `%s`, defaults to `inet`.", strings.Join(pfsense.FirewallRuleIPProtocols(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("inet"),
+			},
+			"protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("Protocol to match, options: '%s', defaults to 'any'.", strings.Join(pfsense.FirewallRuleProtocols(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Protocol to match, options: `%s`, defaults to `any`.", strings.Join(pfsense.FirewallRuleProtocols(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("any"),
+			},
+			"source":      firewallRuleEndpointSchema("Source of matching traffic"),
+			"destination": firewallRuleEndpointSchema("Destination of matching traffic"),
+			"direction": schema.StringAttribute{
+				Description:         fmt.Sprintf("Direction of matching traffic, setting a direction makes the rule a floating rule, options: '%s'.", strings.Join(pfsense.FirewallRuleDirections(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Direction of matching traffic, setting a direction makes the rule a [floating rule](https://docs.netgate.com/pfsense/en/latest/firewall/floating-rules.html), options: `%s`.", strings.Join(pfsense.FirewallRuleDirections(), "`, `")),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"log": schema.BoolAttribute{
+				Description:         "Log packets that are handled by this rule, defaults to 'false'.",
+				MarkdownDescription: "Log packets that are handled by this rule, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable this rule without removing it, defaults to 'false'.",
+				MarkdownDescription: "Disable this rule without removing it, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallRuleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.CreateFirewallRule(ctx, *ruleReq)
+	if addError(&resp.Diagnostics, "Error creating firewall rule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, rule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying firewall rule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallRuleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.GetFirewallRule(ctx, data.Tracker.ValueString())
+	if addError(&resp.Diagnostics, "Error reading firewall rule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, rule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallRuleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.UpdateFirewallRule(ctx, *ruleReq)
+	if addError(&resp.Diagnostics, "Error updating firewall rule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, rule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying firewall rule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallRule(ctx, data.Tracker.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting firewall rule", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ReloadFirewallFilter(ctx)
+		if addError(&resp.Diagnostics, "Error applying firewall rule", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("tracker"), req, resp)
+}
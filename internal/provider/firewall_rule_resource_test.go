@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+type testAccFirewallRule struct {
+	name        string
+	address     string
+	description string
+}
+
+func testAccFirewallRuleResourceConfig(rules ...testAccFirewallRule) string {
+	config := testAccProviderConfig()
+	for _, rule := range rules {
+		config += fmt.Sprintf(`
+resource "pfsense_firewall_rule" %q {
+  interface   = "lan"
+  action      = "pass"
+  protocol    = "tcp"
+  source      = { network = "lan" }
+  destination = { address = %q, port = "443" }
+  description = %q
+  apply       = false
+}
+`, rule.name, rule.address, rule.description)
+	}
+
+	return config
+}
+
+func testAccCheckFirewallRuleTrackerUnchanged(name string, tracker *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource '%s' not found", name)
+		}
+
+		if *tracker == "" {
+			*tracker = rs.Primary.Attributes["tracker"]
+			return nil
+		}
+
+		if rs.Primary.Attributes["tracker"] != *tracker {
+			return fmt.Errorf("tracker ID changed from '%s' to '%s'", *tracker, rs.Primary.Attributes["tracker"])
+		}
+
+		return nil
+	}
+}
+
+// TestAccFirewallRuleResource creates two rules, deletes the first (shifting the control ID of the second, as happens
+// when rules are reordered or removed), updates the second through its tracker ID, imports it, and deletes it.
+func TestAccFirewallRuleResource(t *testing.T) {
+	var tracker string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallRuleResourceConfig(
+					testAccFirewallRule{name: "first", address: "192.0.2.1", description: "tf acc first"},
+					testAccFirewallRule{name: "second", address: "192.0.2.2", description: "tf acc second"},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pfsense_firewall_rule.first", "tracker"),
+					resource.TestCheckResourceAttr("pfsense_firewall_rule.second", "destination.address", "192.0.2.2"),
+					resource.TestCheckResourceAttr("pfsense_firewall_rule.second", "destination.port", "443"),
+					testAccCheckFirewallRuleTrackerUnchanged("pfsense_firewall_rule.second", &tracker),
+				),
+			},
+			{
+				Config: testAccFirewallRuleResourceConfig(
+					testAccFirewallRule{name: "second", address: "192.0.2.3", description: "tf acc second updated"},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_rule.second", "destination.address", "192.0.2.3"),
+					resource.TestCheckResourceAttr("pfsense_firewall_rule.second", "description", "tf acc second updated"),
+					testAccCheckFirewallRuleTrackerUnchanged("pfsense_firewall_rule.second", &tracker),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_firewall_rule.second",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "tracker",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["pfsense_firewall_rule.second"].Primary.Attributes["tracker"], nil
+				},
+				ImportStateVerifyIgnore: []string{"apply"},
+			},
+		},
+	})
+}
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallScheduleResource{}
+var _ resource.ResourceWithImportState = &FirewallScheduleResource{}
+
+func NewFirewallScheduleResource() resource.Resource {
+	return &FirewallScheduleResource{}
+}
+
+type FirewallScheduleResource struct {
+	client *pfsense.Client
+}
+
+type FirewallScheduleResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	TimeRanges  types.List   `tfsdk:"time_ranges"`
+}
+
+type FirewallScheduleTimeRangeResourceModel struct {
+	DaysOfWeek  []types.Int64  `tfsdk:"days_of_week"`
+	Dates       []types.String `tfsdk:"dates"`
+	StartTime   types.String   `tfsdk:"start_time"`
+	StopTime    types.String   `tfsdk:"stop_time"`
+	Description types.String   `tfsdk:"description"`
+}
+
+func (r FirewallScheduleTimeRangeResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"days_of_week": types.ListType{ElemType: types.Int64Type},
+		"dates":        types.ListType{ElemType: types.StringType},
+		"start_time":   types.StringType,
+		"stop_time":    types.StringType,
+		"description":  types.StringType,
+	}}
+}
+
+func (r *FirewallScheduleResourceModel) SetFromValue(ctx context.Context, schedule *pfsense.Schedule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(schedule.Name)
+
+	if schedule.Description != "" {
+		r.Description = types.StringValue(schedule.Description)
+	}
+
+	timeRanges := []FirewallScheduleTimeRangeResourceModel{}
+	for _, timeRange := range schedule.TimeRanges {
+		var timeRangeModel FirewallScheduleTimeRangeResourceModel
+
+		timeRangeModel.DaysOfWeek = []types.Int64{}
+		for _, day := range timeRange.DaysOfWeek {
+			timeRangeModel.DaysOfWeek = append(timeRangeModel.DaysOfWeek, types.Int64Value(int64(day)))
+		}
+
+		timeRangeModel.Dates = []types.String{}
+		for _, date := range timeRange.Dates {
+			timeRangeModel.Dates = append(timeRangeModel.Dates, types.StringValue(date))
+		}
+
+		timeRangeModel.StartTime = types.StringValue(timeRange.StartTime)
+		timeRangeModel.StopTime = types.StringValue(timeRange.StopTime)
+
+		if timeRange.Description != "" {
+			timeRangeModel.Description = types.StringValue(timeRange.Description)
+		}
+
+		timeRanges = append(timeRanges, timeRangeModel)
+	}
+
+	r.TimeRanges, diags = types.ListValueFrom(ctx, FirewallScheduleTimeRangeResourceModel{}.GetAttrType(), timeRanges)
+
+	return diags
+}
+
+func (r FirewallScheduleResourceModel) Value(ctx context.Context) (*pfsense.Schedule, diag.Diagnostics) {
+	var schedule pfsense.Schedule
+	var err error
+	var diags diag.Diagnostics
+
+	var timeRangeModels []*FirewallScheduleTimeRangeResourceModel
+	diags = r.TimeRanges.ElementsAs(ctx, &timeRangeModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = schedule.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = schedule.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	var timeRanges []pfsense.ScheduleTimeRange
+	for i, timeRangeModel := range timeRangeModels {
+		var timeRange pfsense.ScheduleTimeRange
+
+		days := []int{}
+		for _, day := range timeRangeModel.DaysOfWeek {
+			days = append(days, int(day.ValueInt64()))
+		}
+
+		err = timeRange.SetDaysOfWeek(days)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("time_ranges").AtListIndex(i).AtName("days_of_week"),
+				"Time range days of week cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		dates := []string{}
+		for _, date := range timeRangeModel.Dates {
+			dates = append(dates, date.ValueString())
+		}
+
+		err = timeRange.SetDates(dates)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("time_ranges").AtListIndex(i).AtName("dates"),
+				"Time range dates cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = timeRange.SetStartTime(timeRangeModel.StartTime.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("time_ranges").AtListIndex(i).AtName("start_time"),
+				"Time range start time cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = timeRange.SetStopTime(timeRangeModel.StopTime.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("time_ranges").AtListIndex(i).AtName("stop_time"),
+				"Time range stop time cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		if !timeRangeModel.Description.IsNull() {
+			err = timeRange.SetDescription(timeRangeModel.Description.ValueString())
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("time_ranges").AtListIndex(i).AtName("description"),
+					"Time range description cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		if !diags.HasError() {
+			err = timeRange.Validate()
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("time_ranges").AtListIndex(i),
+					"Time range cannot be parsed",
+					err.Error(),
+				)
+			}
+		}
+
+		timeRanges = append(timeRanges, timeRange)
+	}
+
+	if !diags.HasError() {
+		err = schedule.SetTimeRanges(timeRanges)
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("time_ranges"),
+				"Time ranges cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &schedule, diags
+}
+
+func (r *FirewallScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_schedule", req.ProviderTypeName)
+}
+
+func (r *FirewallScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Firewall schedule, time ranges during which firewall rules referencing the schedule are active.",
+		MarkdownDescription: "Firewall [schedule](https://docs.netgate.com/pfsense/en/latest/firewall/time-based-rules.html), time ranges during which firewall rules referencing the schedule are active.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of schedule.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"time_ranges": schema.ListNestedAttribute{
+				Description: "Time ranges of the schedule, each selects either days of the week or dates.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"days_of_week": schema.ListAttribute{
+							ElementType:         types.Int64Type,
+							Description:         "Days of the week, every week, from 1 (Monday) to 7 (Sunday), defaults to '[]'.",
+							MarkdownDescription: "Days of the week, every week, from `1` (Monday) to `7` (Sunday), defaults to `[]`.",
+							Computed:            true,
+							Optional:            true,
+							Default:             listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{})),
+						},
+						"dates": schema.ListAttribute{
+							ElementType:         types.StringType,
+							Description:         "Dates in 'MM-DD' format, defaults to '[]'.",
+							MarkdownDescription: "Dates in `MM-DD` format, defaults to `[]`.",
+							Computed:            true,
+							Optional:            true,
+							Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+						},
+						"start_time": schema.StringAttribute{
+							Description:         "Start time in 'HH:MM' format.",
+							MarkdownDescription: "Start time in `HH:MM` format.",
+							Required:            true,
+						},
+						"stop_time": schema.StringAttribute{
+							Description:         "Stop time in 'HH:MM' format, must be after the start time.",
+							MarkdownDescription: "Stop time in `HH:MM` format, must be after the start time.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *FirewallScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallScheduleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.CreateFirewallSchedule(ctx, *scheduleReq)
+	if addError(&resp.Diagnostics, "Error creating schedule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallScheduleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.GetFirewallSchedule(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading schedule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallScheduleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scheduleReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.UpdateFirewallSchedule(ctx, *scheduleReq)
+	if addError(&resp.Diagnostics, "Error updating schedule", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, schedule)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteFirewallSchedule(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting schedule", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *FirewallScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
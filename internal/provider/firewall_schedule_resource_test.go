@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccFirewallScheduleResource creates a weekday business hours schedule with a lunch break, the time ranges are
+// read back in the configured order.
+func TestAccFirewallScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_firewall_schedule" "test" {
+  name        = "tf_acc_test"
+  description = "tf acc test"
+  time_ranges = [
+    {
+      days_of_week = [1, 2, 3, 4, 5]
+      start_time   = "13:00"
+      stop_time    = "17:00"
+      description  = "afternoon"
+    },
+    {
+      days_of_week = [1, 2, 3, 4, 5]
+      start_time   = "08:00"
+      stop_time    = "12:00"
+      description  = "morning"
+    },
+  ]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.#", "2"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.0.days_of_week.#", "5"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.0.days_of_week.0", "1"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.0.days_of_week.4", "5"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.0.start_time", "13:00"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.0.description", "afternoon"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.1.start_time", "08:00"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.1.stop_time", "12:00"),
+					resource.TestCheckResourceAttr("pfsense_firewall_schedule.test", "time_ranges.1.description", "morning"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_firewall_schedule.test",
+				ImportState:                          true,
+				ImportStateId:                        "tf_acc_test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
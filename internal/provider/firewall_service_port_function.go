@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &FirewallServicePortFunction{}
+
+// well-known service ports (IANA), limited to services commonly referenced in firewall rules and aliases.
+var firewallServicePorts = map[string]int64{
+	"ftp-data":    20,
+	"ftp":         21,
+	"ssh":         22,
+	"telnet":      23,
+	"smtp":        25,
+	"dns":         53,
+	"domain":      53,
+	"dhcp":        67,
+	"tftp":        69,
+	"http":        80,
+	"kerberos":    88,
+	"pop3":        110,
+	"ntp":         123,
+	"imap":        143,
+	"snmp":        161,
+	"snmptrap":    162,
+	"ldap":        389,
+	"https":       443,
+	"smb":         445,
+	"syslog":      514,
+	"submission":  587,
+	"ldaps":       636,
+	"dot":         853,
+	"imaps":       993,
+	"pop3s":       995,
+	"openvpn":     1194,
+	"mssql":       1433,
+	"radius":      1812,
+	"radius-acct": 1813,
+	"nfs":         2049,
+	"mysql":       3306,
+	"rdp":         3389,
+	"ipsec-nat-t": 4500,
+	"sip":         5060,
+	"postgresql":  5432,
+	"vnc":         5900,
+	"redis":       6379,
+	"http-alt":    8080,
+	"wireguard":   51820,
+}
+
+func firewallServiceNames() []string {
+	names := make([]string, 0, len(firewallServicePorts))
+	for name := range firewallServicePorts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func NewFirewallServicePortFunction() function.Function {
+	return &FirewallServicePortFunction{}
+}
+
+type FirewallServicePortFunction struct{}
+
+func (f *FirewallServicePortFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "firewall_service_port"
+}
+
+func (f *FirewallServicePortFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Look up the port of a well-known service.",
+		Description:         "Returns the port number of a well-known service name (e.g. 'https', 'ssh'), for building readable firewall rules and port aliases.",
+		MarkdownDescription: "Returns the port number of a well-known service name (e.g. `https`, `ssh`), for building readable firewall rules and port aliases.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				Description:         fmt.Sprintf("Service name (case-insensitive), one of '%s'.", strings.Join(firewallServiceNames(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Service name (case-insensitive), one of `%s`.", strings.Join(firewallServiceNames(), "`, `")),
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *FirewallServicePortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &service))
+	if resp.Error != nil {
+		return
+	}
+
+	port, ok := firewallServicePorts[strings.ToLower(service)]
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unknown service '%s'", service))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, port))
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFirewallServicePortFunction(service string) (int64, *function.FuncError) {
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(service)})}
+	resp := function.RunResponse{Result: function.NewResultData(types.Int64Unknown())}
+
+	NewFirewallServicePortFunction().Run(context.Background(), req, &resp)
+
+	result, _ := resp.Result.Value().(types.Int64)
+
+	return result.ValueInt64(), resp.Error
+}
+
+func TestFirewallServicePortFunction(t *testing.T) {
+	tests := map[string]int64{
+		"https":     443,
+		"SSH":       22,
+		"Dns":       53,
+		"wireguard": 51820,
+	}
+
+	for service, want := range tests {
+		got, funcErr := runFirewallServicePortFunction(service)
+		if funcErr != nil {
+			t.Errorf("service '%s', unexpected error, %s", service, funcErr)
+			continue
+		}
+
+		if got != want {
+			t.Errorf("service '%s' port = %d, want %d", service, got, want)
+		}
+	}
+}
+
+func TestFirewallServicePortFunctionUnknownService(t *testing.T) {
+	for _, service := range []string{"", "gopher", "https "} {
+		_, funcErr := runFirewallServicePortFunction(service)
+		if funcErr == nil {
+			t.Errorf("service '%s', expected error", service)
+			continue
+		}
+
+		if funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+			t.Errorf("service '%s', expected error for argument 0, got %v", service, funcErr.FunctionArgument)
+		}
+	}
+}
+
+func TestFirewallServiceNames(t *testing.T) {
+	names := firewallServiceNames()
+	if len(names) != len(firewallServicePorts) {
+		t.Fatalf("expected %d names, got %d", len(firewallServicePorts), len(names))
+	}
+
+	for i := 1; i < len(names); i++ {
+		if names[i-1] >= names[i] {
+			t.Errorf("names not sorted, '%s' before '%s'", names[i-1], names[i])
+		}
+	}
+
+	for name, port := range firewallServicePorts {
+		if port < 1 || port > 65535 {
+			t.Errorf("service '%s' port %d out of range", name, port)
+		}
+	}
+}
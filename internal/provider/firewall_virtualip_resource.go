@@ -0,0 +1,426 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &FirewallVirtualIPResource{}
+var _ resource.ResourceWithImportState = &FirewallVirtualIPResource{}
+
+func NewFirewallVirtualIPResource() resource.Resource {
+	return &FirewallVirtualIPResource{}
+}
+
+type FirewallVirtualIPResource struct {
+	client *pfsense.Client
+}
+
+type FirewallVirtualIPResourceModel struct {
+	Mode        types.String `tfsdk:"mode"`
+	Interface   types.String `tfsdk:"interface"`
+	Subnet      types.String `tfsdk:"subnet"`
+	VHID        types.Int64  `tfsdk:"vhid"`
+	AdvBase     types.Int64  `tfsdk:"advbase"`
+	AdvSkew     types.Int64  `tfsdk:"advskew"`
+	Password    types.String `tfsdk:"password"`
+	Description types.String `tfsdk:"description"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+func (r *FirewallVirtualIPResourceModel) SetFromValue(ctx context.Context, vip *pfsense.VirtualIP) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Mode = types.StringValue(vip.Mode)
+	r.Interface = types.StringValue(vip.Interface)
+	r.Subnet = types.StringValue(vip.Subnet.String())
+
+	if vip.VHID != 0 {
+		r.VHID = types.Int64Value(int64(vip.VHID))
+	}
+
+	r.AdvBase = types.Int64Value(int64(vip.AdvBase))
+	r.AdvSkew = types.Int64Value(int64(vip.AdvSkew))
+
+	if vip.Password != "" {
+		r.Password = types.StringValue(vip.Password)
+	}
+
+	if vip.Description != "" {
+		r.Description = types.StringValue(vip.Description)
+	}
+
+	return diags
+}
+
+func (r FirewallVirtualIPResourceModel) Value(ctx context.Context) (*pfsense.VirtualIP, diag.Diagnostics) {
+	var vip pfsense.VirtualIP
+	var err error
+	var diags diag.Diagnostics
+
+	err = vip.SetMode(r.Mode.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("mode"),
+			"Mode cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vip.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vip.SetSubnet(r.Subnet.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("subnet"),
+			"Subnet cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.VHID.IsNull() {
+		err = vip.SetVHID(int(r.VHID.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("vhid"),
+				"VHID cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = vip.SetAdvBase(int(r.AdvBase.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("advbase"),
+			"Advertising base cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vip.SetAdvSkew(int(r.AdvSkew.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("advskew"),
+			"Advertising skew cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Password.IsNull() {
+		err = vip.SetPassword(r.Password.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("password"),
+				"Password cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Description.IsNull() {
+		err = vip.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if diags.HasError() {
+		return &vip, diags
+	}
+
+	err = vip.Validate()
+
+	if err != nil {
+		diags.AddError(
+			"Virtual IP is not valid",
+			err.Error(),
+		)
+	}
+
+	return &vip, diags
+}
+
+func (r *FirewallVirtualIPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_firewall_virtualip", req.ProviderTypeName)
+}
+
+func (r *FirewallVirtualIPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Virtual IP, an additional address on an interface, e.g. a CARP address shared by a high availability pair.",
+		MarkdownDescription: "[Virtual IP](https://docs.netgate.com/pfsense/en/latest/firewall/virtual-ip-addresses.html), an additional address on an interface, e.g. a CARP address shared by a high availability pair.",
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("Type of virtual IP, options: '%s'.", strings.Join(pfsense.VirtualIPModes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Type of virtual IP, options: `%s`.", strings.Join(pfsense.VirtualIPModes(), "`, `")),
+				Required:            true,
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface the virtual IP is added to, e.g. 'lan' or 'wan'.",
+				MarkdownDescription: "Interface the virtual IP is added to, e.g. `lan` or `wan`.",
+				Required:            true,
+			},
+			"subnet": schema.StringAttribute{
+				Description:         "Address and prefix length of the virtual IP in CIDR notation (e.g. '192.168.1.2/24'), identifies the virtual IP together with the VHID.",
+				MarkdownDescription: "Address and prefix length of the virtual IP in CIDR notation (e.g. `192.168.1.2/24`), identifies the virtual IP together with the VHID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vhid": schema.Int64Attribute{
+				Description:         "Virtual host ID (1 to 255), required for 'carp' virtual IPs and must be unique on the interface.",
+				MarkdownDescription: "Virtual host ID (1 to 255), required for `carp` virtual IPs and must be unique on the interface.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"advbase": schema.Int64Attribute{
+				Description:         "CARP advertising frequency base in seconds, defaults to '1'.",
+				MarkdownDescription: "CARP advertising frequency base in seconds, defaults to `1`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"advskew": schema.Int64Attribute{
+				Description:         "CARP advertising frequency skew, the member with the lowest skew is primary, defaults to '0'.",
+				MarkdownDescription: "CARP advertising frequency skew, the member with the lowest skew is primary, defaults to `0`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"password": schema.StringAttribute{
+				Description:         "CARP password shared by all members, required for 'carp' virtual IPs.",
+				MarkdownDescription: "CARP password shared by all members, required for `carp` virtual IPs.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FirewallVirtualIPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FirewallVirtualIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *FirewallVirtualIPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vipReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vip, err := r.client.CreateFirewallVirtualIP(ctx, *vipReq)
+	if addError(&resp.Diagnostics, "Error creating virtual IP", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vip)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallVirtualIPChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying virtual IP", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallVirtualIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *FirewallVirtualIPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, err := netip.ParsePrefix(data.Subnet.ValueString())
+	if addError(&resp.Diagnostics, "Error reading virtual IP", err) {
+		return
+	}
+
+	vip, err := r.client.GetFirewallVirtualIP(ctx, subnet, int(data.VHID.ValueInt64()))
+	if addError(&resp.Diagnostics, "Error reading virtual IP", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vip)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FirewallVirtualIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *FirewallVirtualIPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vipReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vip, err := r.client.UpdateFirewallVirtualIP(ctx, *vipReq)
+	if addError(&resp.Diagnostics, "Error updating virtual IP", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vip)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallVirtualIPChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying virtual IP", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallVirtualIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *FirewallVirtualIPResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, err := netip.ParsePrefix(data.Subnet.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting virtual IP", err) {
+		return
+	}
+
+	err = r.client.DeleteFirewallVirtualIP(ctx, subnet, int(data.VHID.ValueInt64()))
+	if addError(&resp.Diagnostics, "Error deleting virtual IP", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyFirewallVirtualIPChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying virtual IP", err) {
+			return
+		}
+	}
+}
+
+func (r *FirewallVirtualIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var vip pfsense.VirtualIP
+	var err error
+
+	// CARP virtual IPs are imported with 'subnet,vhid', other modes with only the subnet
+	subnet, vhid, found := strings.Cut(req.ID, ",")
+
+	err = vip.SetSubnet(subnet)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Subnet cannot be parsed",
+			fmt.Sprintf("Expected import identifier with format 'subnet' or 'subnet,vhid', %s", err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("subnet"), vip.Subnet.String())...)
+
+	if !found {
+		return
+	}
+
+	id, err := strconv.Atoi(vhid)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"VHID cannot be parsed",
+			err.Error(),
+		)
+		return
+	}
+
+	err = vip.SetVHID(id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"VHID cannot be parsed",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vhid"), int64(vip.VHID))...)
+}
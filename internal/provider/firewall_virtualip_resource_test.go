@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func testAccFirewallVirtualIPResourceConfig(advSkew int) string {
+	return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_firewall_virtualip" "test" {
+  mode        = "carp"
+  interface   = "lan"
+  subnet      = "192.0.2.200/24"
+  vhid        = 200
+  advbase     = 2
+  advskew     = %d
+  password    = "tf-acc-carp"
+  description = "tf acc carp"
+}
+`, advSkew)
+}
+
+func TestAccFirewallVirtualIPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallVirtualIPResourceConfig(100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_virtualip.test", "vhid", "200"),
+					resource.TestCheckResourceAttr("pfsense_firewall_virtualip.test", "advbase", "2"),
+					resource.TestCheckResourceAttr("pfsense_firewall_virtualip.test", "advskew", "100"),
+				),
+			},
+			{
+				Config: testAccFirewallVirtualIPResourceConfig(200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_firewall_virtualip.test", "vhid", "200"),
+					resource.TestCheckResourceAttr("pfsense_firewall_virtualip.test", "advskew", "200"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_firewall_virtualip.test",
+				ImportState:                          true,
+				ImportStateId:                        "192.0.2.200/24,200",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "subnet",
+				ImportStateVerifyIgnore:              []string{"apply"},
+			},
+		},
+	})
+}
@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &InterfaceVLANResource{}
+var _ resource.ResourceWithImportState = &InterfaceVLANResource{}
+
+func NewInterfaceVLANResource() resource.Resource {
+	return &InterfaceVLANResource{}
+}
+
+type InterfaceVLANResource struct {
+	client *pfsense.Client
+}
+
+type InterfaceVLANResourceModel struct {
+	Parent      types.String `tfsdk:"parent"`
+	Tag         types.Int64  `tfsdk:"tag"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Description types.String `tfsdk:"description"`
+	Interface   types.String `tfsdk:"interface"`
+}
+
+func (r *InterfaceVLANResourceModel) SetFromValue(ctx context.Context, vlan *pfsense.VLAN) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Parent = types.StringValue(vlan.Parent)
+	r.Tag = types.Int64Value(int64(vlan.Tag))
+
+	if vlan.Priority != nil {
+		r.Priority = types.Int64Value(int64(*vlan.Priority))
+	}
+
+	if vlan.Description != "" {
+		r.Description = types.StringValue(vlan.Description)
+	}
+
+	r.Interface = types.StringValue(vlan.Interface)
+
+	return diags
+}
+
+func (r InterfaceVLANResourceModel) Value(ctx context.Context) (*pfsense.VLAN, diag.Diagnostics) {
+	var vlan pfsense.VLAN
+	var err error
+	var diags diag.Diagnostics
+
+	err = vlan.SetParent(r.Parent.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("parent"),
+			"Parent cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = vlan.SetTag(int(r.Tag.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("tag"),
+			"Tag cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Priority.IsNull() {
+		err = vlan.SetPriority(int(r.Priority.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("priority"),
+				"Priority cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.Description.IsNull() {
+		err = vlan.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &vlan, diags
+}
+
+func (r *InterfaceVLANResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_interface_vlan", req.ProviderTypeName)
+}
+
+func (r *InterfaceVLANResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "VLAN, allows a single physical interface to carry multiple isolated networks. The VLAN interface must still be assigned before it can be configured or used by services such as DHCP.",
+		MarkdownDescription: "[VLAN](https://docs.netgate.com/pfsense/en/latest/vlan/index.html), allows a single physical interface to carry multiple isolated networks. The VLAN interface must still be assigned before it can be configured or used by services such as DHCP.",
+		Attributes: map[string]schema.Attribute{
+			"parent": schema.StringAttribute{
+				Description:         "Parent interface the VLAN is attached to, must be a network interface or LAGG (e.g. 'igb0').",
+				MarkdownDescription: "Parent interface the VLAN is attached to, must be a network interface or LAGG (e.g. `igb0`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.Int64Attribute{
+				Description: "802.1Q VLAN tag (1-4094).",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Description: "802.1Q VLAN priority (PCP, 0-7).",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Name of the VLAN interface (e.g. 'igb0.10').",
+				MarkdownDescription: "Name of the VLAN interface (e.g. `igb0.10`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *InterfaceVLANResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *InterfaceVLANResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *InterfaceVLANResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlanReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := r.client.CreateVLAN(ctx, *vlanReq)
+	if addError(&resp.Diagnostics, "Error creating VLAN", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceVLANResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *InterfaceVLANResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := r.client.GetVLAN(ctx, data.Parent.ValueString(), int(data.Tag.ValueInt64()))
+	if addError(&resp.Diagnostics, "Error reading VLAN", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceVLANResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *InterfaceVLANResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlanReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vlan, err := r.client.UpdateVLAN(ctx, *vlanReq)
+	if addError(&resp.Diagnostics, "Error updating VLAN", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, vlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InterfaceVLANResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *InterfaceVLANResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteVLAN(ctx, data.Parent.ValueString(), int(data.Tag.ValueInt64()))
+	if addError(&resp.Diagnostics, "Error deleting VLAN", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *InterfaceVLANResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	i := strings.LastIndex(req.ID, ".")
+	if i <= 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format 'parent.tag', got: '%s'", req.ID),
+		)
+		return
+	}
+
+	var vlan pfsense.VLAN
+	var err error
+
+	err = vlan.SetParent(req.ID[:i])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Parent cannot be parsed",
+			err.Error(),
+		)
+		return
+	}
+
+	tag, err := strconv.Atoi(req.ID[i+1:])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Tag cannot be parsed",
+			err.Error(),
+		)
+		return
+	}
+
+	err = vlan.SetTag(tag)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Tag cannot be parsed",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent"), vlan.Parent)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag"), int64(vlan.Tag))...)
+}
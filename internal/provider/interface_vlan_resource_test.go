@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccInterfaceVLANResource creates a VLAN on the interface given by PFSENSE_VLAN_PARENT (e.g. 'vtnet1') and
+// confirms the VLAN interface it exposes, which interface assignments and DHCP configuration then target.
+func TestAccInterfaceVLANResource(t *testing.T) {
+	parent := os.Getenv("PFSENSE_VLAN_PARENT")
+	if parent == "" {
+		t.Skip("PFSENSE_VLAN_PARENT must be set for VLAN acceptance tests")
+	}
+
+	config := func(description string) string {
+		return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_interface_vlan" "test" {
+  parent      = %q
+  tag         = 4000
+  priority    = 3
+  description = %q
+}
+`, parent, description)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_interface_vlan.test", "interface", fmt.Sprintf("%s.4000", parent)),
+					resource.TestCheckResourceAttr("pfsense_interface_vlan.test", "priority", "3"),
+				),
+			},
+			{
+				Config: config("acceptance test updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_interface_vlan.test", "description", "acceptance test updated"),
+					resource.TestCheckResourceAttr("pfsense_interface_vlan.test", "interface", fmt.Sprintf("%s.4000", parent)),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_interface_vlan.test",
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s.4000", parent),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "interface",
+			},
+		},
+	})
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &InterfaceVLANsDataSource{}
+	_ datasource.DataSourceWithConfigure = &InterfaceVLANsDataSource{}
+)
+
+func NewInterfaceVLANsDataSource() datasource.DataSource {
+	return &InterfaceVLANsDataSource{}
+}
+
+type InterfaceVLANsDataSource struct {
+	client *pfsense.Client
+}
+
+type InterfaceVLANsDataSourceModel struct {
+	All types.List `tfsdk:"all"`
+}
+
+type InterfaceVLANDataSourceModel struct {
+	Parent      types.String `tfsdk:"parent"`
+	Tag         types.Int64  `tfsdk:"tag"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Description types.String `tfsdk:"description"`
+	Interface   types.String `tfsdk:"interface"`
+}
+
+func (d InterfaceVLANDataSourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"parent":      types.StringType,
+		"tag":         types.Int64Type,
+		"priority":    types.Int64Type,
+		"description": types.StringType,
+		"interface":   types.StringType,
+	}}
+}
+
+func (d *InterfaceVLANDataSourceModel) SetFromValue(ctx context.Context, vlan *pfsense.VLAN) diag.Diagnostics {
+	d.Parent = types.StringValue(vlan.Parent)
+	d.Tag = types.Int64Value(int64(vlan.Tag))
+
+	if vlan.Priority != nil {
+		d.Priority = types.Int64Value(int64(*vlan.Priority))
+	}
+
+	if vlan.Description != "" {
+		d.Description = types.StringValue(vlan.Description)
+	}
+
+	d.Interface = types.StringValue(vlan.Interface)
+
+	return nil
+}
+
+func (d *InterfaceVLANsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_interface_vlans", req.ProviderTypeName)
+}
+
+func (d *InterfaceVLANsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves all VLANs. VLANs allow a single physical interface to carry multiple isolated networks.",
+		MarkdownDescription: "Retrieves all [VLANs](https://docs.netgate.com/pfsense/en/latest/vlan/index.html). VLANs allow a single physical interface to carry multiple isolated networks.",
+		Attributes: map[string]schema.Attribute{
+			"all": schema.ListNestedAttribute{
+				Description: "All VLANs.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"parent": schema.StringAttribute{
+							Description: "Parent interface the VLAN is attached to.",
+							Computed:    true,
+						},
+						"tag": schema.Int64Attribute{
+							Description: "802.1Q VLAN tag.",
+							Computed:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "802.1Q VLAN priority (PCP).",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description:         "Name of the VLAN interface (e.g. 'igb0.10').",
+							MarkdownDescription: "Name of the VLAN interface (e.g. `igb0.10`).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InterfaceVLANsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InterfaceVLANsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InterfaceVLANsDataSourceModel
+	var diags diag.Diagnostics
+
+	vlans, err := d.client.GetVLANs(ctx)
+	if addError(&resp.Diagnostics, "Unable to get VLANs", err) {
+		return
+	}
+
+	vlanModels := []InterfaceVLANDataSourceModel{}
+	for _, vlan := range *vlans {
+		var vlanModel InterfaceVLANDataSourceModel
+		diags = vlanModel.SetFromValue(ctx, &vlan)
+		resp.Diagnostics.Append(diags...)
+		vlanModels = append(vlanModels, vlanModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, InterfaceVLANDataSourceModel{}.GetAttrType(), vlanModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &PfBlockerNGFeedResource{}
+var _ resource.ResourceWithImportState = &PfBlockerNGFeedResource{}
+
+func NewPfBlockerNGFeedResource() resource.Resource {
+	return &PfBlockerNGFeedResource{}
+}
+
+type PfBlockerNGFeedResource struct {
+	client *pfsense.Client
+}
+
+type PfBlockerNGFeedResourceModel struct {
+	Type        types.String `tfsdk:"type"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Action      types.String `tfsdk:"action"`
+	Sources     types.List   `tfsdk:"sources"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+type PfBlockerNGFeedSourceResourceModel struct {
+	URL     types.String `tfsdk:"url"`
+	Header  types.String `tfsdk:"header"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+}
+
+func (r PfBlockerNGFeedSourceResourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"url":     types.StringType,
+		"header":  types.StringType,
+		"enabled": types.BoolType,
+	}}
+}
+
+func (r *PfBlockerNGFeedResourceModel) SetFromValue(ctx context.Context, feed *pfsense.PfBlockerFeed) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Type = types.StringValue(feed.Type)
+	r.Name = types.StringValue(feed.Name)
+
+	if feed.Description != "" {
+		r.Description = types.StringValue(feed.Description)
+	}
+
+	r.Action = types.StringValue(feed.Action)
+
+	sources := []PfBlockerNGFeedSourceResourceModel{}
+	for _, source := range feed.Sources {
+		sources = append(sources, PfBlockerNGFeedSourceResourceModel{
+			URL:     types.StringValue(source.URL),
+			Header:  types.StringValue(source.Header),
+			Enabled: types.BoolValue(source.Enabled),
+		})
+	}
+
+	r.Sources, diags = types.ListValueFrom(ctx, PfBlockerNGFeedSourceResourceModel{}.GetAttrType(), sources)
+	return diags
+}
+
+func (r PfBlockerNGFeedResourceModel) Value(ctx context.Context) (*pfsense.PfBlockerFeed, diag.Diagnostics) {
+	var feed pfsense.PfBlockerFeed
+	var err error
+	var diags diag.Diagnostics
+
+	var sourceModels []*PfBlockerNGFeedSourceResourceModel
+	diags = r.Sources.ElementsAs(ctx, &sourceModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	err = feed.SetType(r.Type.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Type cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = feed.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = feed.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = feed.SetAction(r.Action.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("action"),
+			"Action cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	for i, sourceModel := range sourceModels {
+		var source pfsense.PfBlockerFeedSource
+
+		err = source.SetURL(sourceModel.URL.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("sources").AtListIndex(i).AtName("url"),
+				"Source URL cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = source.SetHeader(sourceModel.Header.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("sources").AtListIndex(i).AtName("header"),
+				"Source header cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = source.SetEnabled(sourceModel.Enabled.ValueBool())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("sources").AtListIndex(i).AtName("enabled"),
+				"Source enabled cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		feed.Sources = append(feed.Sources, source)
+	}
+
+	return &feed, diags
+}
+
+func (r *PfBlockerNGFeedResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_pfblockerng_feed", req.ProviderTypeName)
+}
+
+func (r *PfBlockerNGFeedResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "pfBlockerNG feed, an IPv4, IPv6, or DNSBL list entry built from one or more sources. Requires the pfBlockerNG package.",
+		MarkdownDescription: "[pfBlockerNG](https://docs.netgate.com/pfsense/en/latest/packages/pfblocker.html) feed, an IPv4, IPv6, or DNSBL list entry built from one or more sources. Requires the pfBlockerNG package.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description:         fmt.Sprintf("Type of feed, options: '%s'.", strings.Join(pfsense.PfBlockerNGFeedTypes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Type of feed, options: `%s`.", strings.Join(pfsense.PfBlockerNGFeedTypes(), "`, `")),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of feed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"action": schema.StringAttribute{
+				Description: fmt.Sprintf("Action taken on matches, IP feed options: '%s', DNSBL feed options: '%s'.",
+					strings.Join(pfsense.PfBlockerNGFeedActions("ipv4"), "', '"), strings.Join(pfsense.PfBlockerNGFeedActions("dnsbl"), "', '")),
+				MarkdownDescription: fmt.Sprintf("Action taken on matches, IP feed options: `%s`, DNSBL feed options: `%s`.",
+					strings.Join(pfsense.PfBlockerNGFeedActions("ipv4"), "`, `"), strings.Join(pfsense.PfBlockerNGFeedActions("dnsbl"), "`, `")),
+				Required: true,
+			},
+			"sources": schema.ListNestedAttribute{
+				Description: "Source list(s) of feed.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "URL or local file path of source.",
+							Required:    true,
+						},
+						"header": schema.StringAttribute{
+							Description: "Header (label) of source.",
+							Required:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description:         "Enable source, defaults to 'true'. Existing sources in another active state (e.g. 'Hold' or 'Flex') are considered enabled and keep their state.",
+							MarkdownDescription: "Enable source, defaults to `true`. Existing sources in another active state (e.g. `Hold` or `Flex`) are considered enabled and keep their state.",
+							Computed:            true,
+							Optional:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+					},
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change (run a pfBlockerNG update), defaults to 'true'.",
+				MarkdownDescription: "Apply change (run a pfBlockerNG update), defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *PfBlockerNGFeedResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PfBlockerNGFeedResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PfBlockerNGFeedResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feedReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feed, err := r.client.CreatePfBlockerNGFeed(ctx, *feedReq)
+	if addError(&resp.Diagnostics, "Error creating pfBlockerNG feed", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, feed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyPfBlockerNGChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying pfBlockerNG feed", err) {
+			return
+		}
+	}
+}
+
+func (r *PfBlockerNGFeedResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PfBlockerNGFeedResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feed, err := r.client.GetPfBlockerNGFeed(ctx, data.Type.ValueString(), data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading pfBlockerNG feed", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, feed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PfBlockerNGFeedResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PfBlockerNGFeedResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feedReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	feed, err := r.client.UpdatePfBlockerNGFeed(ctx, *feedReq)
+	if addError(&resp.Diagnostics, "Error updating pfBlockerNG feed", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, feed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyPfBlockerNGChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying pfBlockerNG feed", err) {
+			return
+		}
+	}
+}
+
+func (r *PfBlockerNGFeedResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PfBlockerNGFeedResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeletePfBlockerNGFeed(ctx, data.Type.ValueString(), data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting pfBlockerNG feed", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyPfBlockerNGChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying pfBlockerNG feed", err) {
+			return
+		}
+	}
+}
+
+func (r *PfBlockerNGFeedResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	t, name, found := strings.Cut(req.ID, "/")
+	if !found || t == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format 'type/name', got: '%s'", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), t)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
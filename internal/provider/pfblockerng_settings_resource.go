@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &PfBlockerNGSettingsResource{}
+
+func NewPfBlockerNGSettingsResource() resource.Resource {
+	return &PfBlockerNGSettingsResource{}
+}
+
+type PfBlockerNGSettingsResource struct {
+	client *pfsense.Client
+}
+
+type PfBlockerNGSettingsResourceModel struct {
+	Enable types.Bool `tfsdk:"enable"`
+	Apply  types.Bool `tfsdk:"apply"`
+}
+
+func (r *PfBlockerNGSettingsResourceModel) SetFromValue(ctx context.Context, settings *pfsense.PfBlockerNGSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Enable = types.BoolValue(settings.Enable)
+
+	return diags
+}
+
+func (r PfBlockerNGSettingsResourceModel) Value(ctx context.Context) (*pfsense.PfBlockerNGSettings, diag.Diagnostics) {
+	var settings pfsense.PfBlockerNGSettings
+	var err error
+	var diags diag.Diagnostics
+
+	err = settings.SetEnable(r.Enable.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enable"),
+			"Enable cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &settings, diags
+}
+
+func (r *PfBlockerNGSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_pfblockerng_settings", req.ProviderTypeName)
+}
+
+func (r *PfBlockerNGSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "pfBlockerNG general settings. Requires the pfBlockerNG package. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[pfBlockerNG](https://docs.netgate.com/pfsense/en/latest/packages/pfblocker.html) general settings. Requires the pfBlockerNG package. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				Description: "Enable pfBlockerNG.",
+				Required:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change (run a pfBlockerNG update), defaults to 'true'.",
+				MarkdownDescription: "Apply change (run a pfBlockerNG update), defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *PfBlockerNGSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PfBlockerNGSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PfBlockerNGSettingsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdatePfBlockerNGSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error creating pfBlockerNG settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyPfBlockerNGChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying pfBlockerNG settings", err) {
+			return
+		}
+	}
+}
+
+func (r *PfBlockerNGSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PfBlockerNGSettingsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetPfBlockerNGSettings(ctx)
+	if addError(&resp.Diagnostics, "Error reading pfBlockerNG settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PfBlockerNGSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PfBlockerNGSettingsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdatePfBlockerNGSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error updating pfBlockerNG settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyPfBlockerNGChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying pfBlockerNG settings", err) {
+			return
+		}
+	}
+}
+
+func (r *PfBlockerNGSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -2,11 +2,15 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -17,7 +21,8 @@ import (
 )
 
 var (
-	_ provider.Provider = &pfSenseProvider{}
+	_ provider.Provider              = &pfSenseProvider{}
+	_ provider.ProviderWithFunctions = &pfSenseProvider{}
 )
 
 func unknownProviderValue(value string) (string, string) {
@@ -85,8 +90,15 @@ type pfSenseProviderModel struct {
 	URL           types.String `tfsdk:"url"`
 	Username      types.String `tfsdk:"username"`
 	Password      types.String `tfsdk:"password"`
+	APIKey        types.String `tfsdk:"api_key"`
+	APISecret     types.String `tfsdk:"api_secret"`
 	TLSSkipVerify types.Bool   `tfsdk:"tls_skip_verify"`
+	CACertificate types.String `tfsdk:"ca_certificate"`
 	MaxAttempts   types.Int64  `tfsdk:"max_attempts"`
+	HTTPTimeout   types.String `tfsdk:"http_timeout"`
+	RetryMinWait  types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait  types.String `tfsdk:"retry_max_wait"`
+	ProxyURL      types.String `tfsdk:"proxy_url"`
 }
 
 func (p *pfSenseProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -110,20 +122,57 @@ func (p *pfSenseProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				Description: "pfSense administration password.",
-				Required:    true,
+				Description: "pfSense administration password. Required unless an API key is set.",
+				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_key": schema.StringAttribute{
+				Description:         "pfSense API key (client ID), sent with 'api_secret' in the 'Authorization' header of every request, for environments where password login is not possible. Takes precedence over username and password.",
+				MarkdownDescription: "pfSense API key (client ID), sent with `api_secret` in the `Authorization` header of every request, for environments where password login is not possible. Takes precedence over username and password.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"api_secret": schema.StringAttribute{
+				Description:         "pfSense API secret (client token), required with 'api_key'.",
+				MarkdownDescription: "pfSense API secret (client token), required with `api_key`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
 			"tls_skip_verify": schema.BoolAttribute{
 				Description:         fmt.Sprintf("Skip verification of TLS certificates, defaults to '%t'.", pfsense.DefaultTLSSkipVerify),
 				MarkdownDescription: fmt.Sprintf("Skip verification of TLS certificates, defaults to `%t`.", pfsense.DefaultTLSSkipVerify),
 				Optional:            true,
 			},
+			"ca_certificate": schema.StringAttribute{
+				Description:         "PEM encoded CA certificate bundle used to verify the pfSense Web GUI certificate (e.g. a self-signed certificate). Takes precedence over 'tls_skip_verify'.",
+				MarkdownDescription: "PEM encoded CA certificate bundle used to verify the pfSense Web GUI certificate (e.g. a self-signed certificate). Takes precedence over `tls_skip_verify`.",
+				Optional:            true,
+			},
 			"max_attempts": schema.Int64Attribute{
 				Description:         fmt.Sprintf("Maximum number of attempts (only applicable for retryable errors), defaults to '%d'.", pfsense.DefaultMaxAttempts),
 				MarkdownDescription: fmt.Sprintf("Maximum number of attempts (only applicable for retryable errors), defaults to `%d`.", pfsense.DefaultMaxAttempts),
 				Optional:            true,
 			},
+			"retry_min_wait": schema.StringAttribute{
+				Description:         fmt.Sprintf("Minimum wait between attempts (e.g. '2s'), multiplied by the attempt number, defaults to '%s'.", pfsense.DefaultRetryMinWait),
+				MarkdownDescription: fmt.Sprintf("Minimum wait between attempts (e.g. `2s`), multiplied by the attempt number, defaults to `%s`.", pfsense.DefaultRetryMinWait),
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description:         fmt.Sprintf("Maximum wait between attempts (e.g. '10s'), multiplied by the attempt number, defaults to '%s'.", pfsense.DefaultRetryMaxWait),
+				MarkdownDescription: fmt.Sprintf("Maximum wait between attempts (e.g. `10s`), multiplied by the attempt number, defaults to `%s`.", pfsense.DefaultRetryMaxWait),
+				Optional:            true,
+			},
+			"http_timeout": schema.StringAttribute{
+				Description:         "Timeout for each HTTP request attempt (e.g. '10m'), timed out reads are retried up to the maximum number of attempts while timed out changes are not, defaults to '30s'. Some changes (e.g. applying pfBlockerNG) wait for the operation to complete, increase the timeout for them.",
+				MarkdownDescription: "Timeout for each HTTP request attempt (e.g. `10m`), timed out reads are retried up to the maximum number of attempts while timed out changes are not, defaults to `30s`. Some changes (e.g. applying pfBlockerNG) wait for the operation to complete, increase the timeout for them.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description:         "HTTP, HTTPS, or SOCKS5 proxy URL (e.g. 'socks5://proxy.example.com:1080'), defaults to the 'HTTPS_PROXY', 'HTTP_PROXY', and 'NO_PROXY' environment variables.",
+				MarkdownDescription: "HTTP, HTTPS, or SOCKS5 proxy URL (e.g. `socks5://proxy.example.com:1080`), defaults to the `HTTPS_PROXY`, `HTTP_PROXY`, and `NO_PROXY` environment variables.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -153,16 +202,51 @@ func (p *pfSenseProvider) Configure(ctx context.Context, req provider.ConfigureR
 		resp.Diagnostics.AddAttributeError(path.Root("password"), summary, detail)
 	}
 
+	if config.APIKey.IsUnknown() {
+		summary, detail := unknownProviderValue("API key")
+		resp.Diagnostics.AddAttributeError(path.Root("api_key"), summary, detail)
+	}
+
+	if config.APISecret.IsUnknown() {
+		summary, detail := unknownProviderValue("API secret")
+		resp.Diagnostics.AddAttributeError(path.Root("api_secret"), summary, detail)
+	}
+
 	if config.TLSSkipVerify.IsUnknown() {
 		summary, detail := unknownProviderValue("tls_skip_verify")
 		resp.Diagnostics.AddAttributeError(path.Root("tls_skip_verify"), summary, detail)
 	}
 
+	if config.CACertificate.IsUnknown() {
+		summary, detail := unknownProviderValue("ca_certificate")
+		resp.Diagnostics.AddAttributeError(path.Root("ca_certificate"), summary, detail)
+	}
+
 	if config.MaxAttempts.IsUnknown() {
 		summary, detail := unknownProviderValue("max_attempts")
 		resp.Diagnostics.AddAttributeError(path.Root("max_attempts"), summary, detail)
 	}
 
+	if config.RetryMinWait.IsUnknown() {
+		summary, detail := unknownProviderValue("retry_min_wait")
+		resp.Diagnostics.AddAttributeError(path.Root("retry_min_wait"), summary, detail)
+	}
+
+	if config.RetryMaxWait.IsUnknown() {
+		summary, detail := unknownProviderValue("retry_max_wait")
+		resp.Diagnostics.AddAttributeError(path.Root("retry_max_wait"), summary, detail)
+	}
+
+	if config.HTTPTimeout.IsUnknown() {
+		summary, detail := unknownProviderValue("http_timeout")
+		resp.Diagnostics.AddAttributeError(path.Root("http_timeout"), summary, detail)
+	}
+
+	if config.ProxyURL.IsUnknown() {
+		summary, detail := unknownProviderValue("proxy_url")
+		resp.Diagnostics.AddAttributeError(path.Root("proxy_url"), summary, detail)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -188,16 +272,85 @@ func (p *pfSenseProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	opts.Password = config.Password.ValueString()
+	opts.APIKey = config.APIKey.ValueString()
+	opts.APISecret = config.APISecret.ValueString()
 
 	if !config.TLSSkipVerify.IsNull() {
 		opts.TLSSkipVerify = config.TLSSkipVerify.ValueBoolPointer()
 	}
 
+	opts.CACertificatePEM = config.CACertificate.ValueString()
+
 	if !config.MaxAttempts.IsNull() {
 		i := int(config.MaxAttempts.ValueInt64())
 		opts.MaxAttempts = &i
 	}
 
+	if !config.RetryMinWait.IsNull() {
+		td, err := time.ParseDuration(config.RetryMinWait.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_min_wait"),
+				"pfSense retry minimum wait cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		opts.RetryMinWait = &td
+	}
+
+	if !config.RetryMaxWait.IsNull() {
+		td, err := time.ParseDuration(config.RetryMaxWait.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"pfSense retry maximum wait cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		opts.RetryMaxWait = &td
+	}
+
+	if !config.HTTPTimeout.IsNull() {
+		td, err := time.ParseDuration(config.HTTPTimeout.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("http_timeout"),
+				"pfSense HTTP timeout cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		opts.HTTPTimeout = &td
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL, err := url.Parse(config.ProxyURL.ValueString())
+		if err == nil {
+			err = validateProxyURL(proxyURL)
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("proxy_url"),
+				"pfSense proxy URL cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		opts.HTTPProxy = proxyURL
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateRetryWaits(opts.RetryMinWait, opts.RetryMaxWait)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,7 +372,9 @@ func (p *pfSenseProvider) Configure(ctx context.Context, req provider.ConfigureR
 	ctx = tflog.SetField(ctx, "pfsense_url", client.Options.URL.String())
 	ctx = tflog.SetField(ctx, "pfsense_username", client.Options.Username)
 	ctx = tflog.SetField(ctx, "pfsense_password", client.Options.Password)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pfsense_password")
+	ctx = tflog.SetField(ctx, "pfsense_api_key", client.Options.APIKey)
+	ctx = tflog.SetField(ctx, "pfsense_api_secret", client.Options.APISecret)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "pfsense_password", "pfsense_api_key", "pfsense_api_secret")
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -227,22 +382,135 @@ func (p *pfSenseProvider) Configure(ctx context.Context, req provider.ConfigureR
 	tflog.Info(ctx, "Configured pfSense client", map[string]any{"success": true})
 }
 
+// validateProxyURL checks the proxy URL uses a scheme supported by the HTTP transport and includes a host.
+func validateProxyURL(proxyURL *url.URL) error {
+	if !slices.Contains([]string{"http", "https", "socks5"}, proxyURL.Scheme) {
+		return fmt.Errorf("proxy URL scheme must be one of 'http', 'https', or 'socks5', got '%s'", proxyURL.Scheme)
+	}
+
+	if proxyURL.Hostname() == "" {
+		return errors.New("proxy URL must include a host")
+	}
+
+	return nil
+}
+
+// validateRetryWaits checks the waits between attempts are not negative and ordered, unset waits use the client defaults.
+func validateRetryWaits(minWait *time.Duration, maxWait *time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if minWait != nil && *minWait < 0 {
+		diags.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"pfSense retry minimum wait is invalid",
+			fmt.Sprintf("Retry minimum wait '%s' must not be negative.", *minWait),
+		)
+	}
+
+	if maxWait != nil && *maxWait < 0 {
+		diags.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"pfSense retry maximum wait is invalid",
+			fmt.Sprintf("Retry maximum wait '%s' must not be negative.", *maxWait),
+		)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	minWaitValue, maxWaitValue := pfsense.DefaultRetryMinWait, pfsense.DefaultRetryMaxWait
+	if minWait != nil {
+		minWaitValue = *minWait
+	}
+
+	if maxWait != nil {
+		maxWaitValue = *maxWait
+	}
+
+	if minWaitValue > maxWaitValue {
+		attribute := path.Root("retry_min_wait")
+		if minWait == nil {
+			attribute = path.Root("retry_max_wait")
+		}
+
+		diags.AddAttributeError(
+			attribute,
+			"pfSense retry waits are invalid",
+			fmt.Sprintf("Retry minimum wait '%s' must not be greater than retry maximum wait '%s'.", minWaitValue, maxWaitValue),
+		)
+	}
+
+	return diags
+}
+
 func (p *pfSenseProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDNSResolverDomainOverrideDataSource,
 		NewDNSResolverDomainOverridesDataSource,
+		NewDNSResolverHostOverrideDataSource,
 		NewDNSResolverHostOverridesDataSource,
 		NewFirewallAliasesDataSource,
+		NewFirewallIPAliasDataSource,
+		NewFirewallIPAliasDiffDataSource,
+		NewInterfaceVLANsDataSource,
+		NewSystemCertificateDataSource,
+		NewSystemCertificatesExpiringDataSource,
+		NewSystemGatewaysDataSource,
+		NewSystemInfoDataSource,
+		NewSystemUserDataSource,
 		NewSystemVersionDataSource,
 	}
 }
 
 func (p *pfSenseProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewACMECertificateResource,
+		NewDHCPv4PoolResource,
+		NewDNSForwarderHostOverrideResource,
 		NewDNSResolverApplyResource,
 		NewDNSResolverConfigFileResource,
+		NewDNSResolverConfigFilesResource,
 		NewDNSResolverDomainOverrideResource,
+		NewDNSResolverDomainOverrideGroupResource,
 		NewDNSResolverHostOverrideResource,
+		NewDNSResolverSettingsResource,
 		NewFirewallFilterReloadResource,
 		NewFirewallIPAliasResource,
+		NewFirewallNATOutboundResource,
+		NewFirewallNATOutboundModeResource,
+		NewFirewallPortForwardResource,
+		NewFirewallRuleResource,
+		NewFirewallScheduleResource,
+		NewFirewallVirtualIPResource,
+		NewInterfaceVLANResource,
+		NewPfBlockerNGFeedResource,
+		NewPfBlockerNGSettingsResource,
+		NewSystemAdvancedAdminResource,
+		NewSystemAdvancedMiscResource,
+		NewSystemAdvancedNetworkIPv6Resource,
+		NewSystemAdvancedNotificationsResource,
+		NewSystemCAResource,
+		NewSystemCertificateResource,
+		NewSystemCryptoModuleResource,
+		NewSystemGatewayResource,
+		NewSystemGatewayDefaultResource,
+		NewSystemGroupResource,
+		NewSystemLogClearResource,
+		NewSystemNTPResource,
+		NewSystemStaticRouteResource,
+		NewSystemSyslogResource,
+		NewSystemTunableResource,
+		NewSystemTunablesResource,
+		NewSystemUserResource,
+		NewSystemUserAuthenticationServerResource,
+	}
+}
+
+func (p *pfSenseProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewDNSResolverConfigFileContentFunction,
+		NewFirewallIPAliasBlocklistFunction,
+		NewFirewallServicePortFunction,
 	}
 }
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemAdvancedAdminResource{}
+
+func NewSystemAdvancedAdminResource() resource.Resource {
+	return &SystemAdvancedAdminResource{}
+}
+
+type SystemAdvancedAdminResource struct {
+	client *pfsense.Client
+}
+
+type SystemAdvancedAdminResourceModel struct {
+	DisableHTTPRedirect     types.Bool `tfsdk:"disable_http_redirect"`
+	DisableHTTPRefererCheck types.Bool `tfsdk:"disable_http_referer_check"`
+}
+
+func (r *SystemAdvancedAdminResourceModel) SetFromValue(ctx context.Context, aa *pfsense.AdvancedAdmin) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.DisableHTTPRedirect = types.BoolValue(aa.DisableHTTPRedirect)
+	r.DisableHTTPRefererCheck = types.BoolValue(aa.DisableHTTPRefererCheck)
+
+	return diags
+}
+
+func (r SystemAdvancedAdminResourceModel) Value(ctx context.Context) (*pfsense.AdvancedAdmin, diag.Diagnostics) {
+	var aa pfsense.AdvancedAdmin
+	var err error
+	var diags diag.Diagnostics
+
+	err = aa.SetDisableHTTPRedirect(r.DisableHTTPRedirect.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disable_http_redirect"),
+			"Disable HTTP redirect cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = aa.SetDisableHTTPRefererCheck(r.DisableHTTPRefererCheck.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disable_http_referer_check"),
+			"Disable HTTP referer check cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &aa, diags
+}
+
+func (r *SystemAdvancedAdminResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_advanced_admin", req.ProviderTypeName)
+}
+
+func (r *SystemAdvancedAdminResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Advanced admin access settings (web configurator redirect rule and HTTP_REFERER check). Saving restarts the web configurator. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[Advanced admin access settings](https://docs.netgate.com/pfsense/en/latest/config/advanced-admin.html) (web configurator redirect rule and `HTTP_REFERER` check). Saving restarts the web configurator. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"disable_http_redirect": schema.BoolAttribute{
+				Description:         "Disable the web configurator rule that redirects HTTP to HTTPS, defaults to 'false'.",
+				MarkdownDescription: "Disable the web configurator rule that redirects HTTP to HTTPS, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"disable_http_referer_check": schema.BoolAttribute{
+				Description:         "Disable the HTTP_REFERER check of the web configurator, defaults to 'false'. The provider does not send a referer header, its own session is not affected by the check.",
+				MarkdownDescription: "Disable the `HTTP_REFERER` check of the web configurator, defaults to `false`. The provider does not send a referer header, its own session is not affected by the check.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SystemAdvancedAdminResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemAdvancedAdminResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemAdvancedAdminResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aaReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aa, err := r.client.UpdateSystemAdvancedAdmin(ctx, *aaReq)
+	if addError(&resp.Diagnostics, "Error creating advanced admin settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, aa)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedAdminResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemAdvancedAdminResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aa, err := r.client.GetSystemAdvancedAdmin(ctx)
+	if addError(&resp.Diagnostics, "Error reading advanced admin settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, aa)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedAdminResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemAdvancedAdminResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aaReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aa, err := r.client.UpdateSystemAdvancedAdmin(ctx, *aaReq)
+	if addError(&resp.Diagnostics, "Error updating advanced admin settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, aa)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedAdminResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemAdvancedMiscResource{}
+
+func NewSystemAdvancedMiscResource() resource.Resource {
+	return &SystemAdvancedMiscResource{}
+}
+
+type SystemAdvancedMiscResource struct {
+	client *pfsense.Client
+}
+
+type SystemAdvancedMiscResourceModel struct {
+	CryptoHardware   types.String `tfsdk:"crypto_hardware"`
+	ThermalHardware  types.String `tfsdk:"thermal_hardware"`
+	PowerSaving      types.Bool   `tfsdk:"power_saving"`
+	PowerACMode      types.String `tfsdk:"power_ac_mode"`
+	PowerBatteryMode types.String `tfsdk:"power_battery_mode"`
+	PowerNormalMode  types.String `tfsdk:"power_normal_mode"`
+}
+
+func (r *SystemAdvancedMiscResourceModel) SetFromValue(ctx context.Context, am *pfsense.AdvancedMisc) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.CryptoHardware = types.StringNull()
+	if am.CryptoHardware != "" {
+		r.CryptoHardware = types.StringValue(am.CryptoHardware)
+	}
+
+	r.ThermalHardware = types.StringNull()
+	if am.ThermalHardware != "" {
+		r.ThermalHardware = types.StringValue(am.ThermalHardware)
+	}
+
+	r.PowerSaving = types.BoolValue(am.PowerSaving)
+	r.PowerACMode = types.StringValue(am.PowerACMode)
+	r.PowerBatteryMode = types.StringValue(am.PowerBatteryMode)
+	r.PowerNormalMode = types.StringValue(am.PowerNormalMode)
+
+	return diags
+}
+
+func (r SystemAdvancedMiscResourceModel) Value(ctx context.Context) (*pfsense.AdvancedMisc, diag.Diagnostics) {
+	var am pfsense.AdvancedMisc
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.CryptoHardware.IsNull() {
+		err = am.SetCryptoHardware(r.CryptoHardware.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("crypto_hardware"),
+				"Crypto hardware cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.ThermalHardware.IsNull() {
+		err = am.SetThermalHardware(r.ThermalHardware.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("thermal_hardware"),
+				"Thermal hardware cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = am.SetPowerSaving(r.PowerSaving.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("power_saving"),
+			"Power saving cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = am.SetPowerACMode(r.PowerACMode.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("power_ac_mode"),
+			"Power AC mode cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = am.SetPowerBatteryMode(r.PowerBatteryMode.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("power_battery_mode"),
+			"Power battery mode cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = am.SetPowerNormalMode(r.PowerNormalMode.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("power_normal_mode"),
+			"Power normal mode cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &am, diags
+}
+
+func (r *SystemAdvancedMiscResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_advanced_misc", req.ProviderTypeName)
+}
+
+func (r *SystemAdvancedMiscResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Miscellaneous advanced system settings (cryptographic and thermal hardware, power saving). Do not use together with 'pfsense_system_crypto_module', which manages the same hardware settings. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[Miscellaneous advanced system settings](https://docs.netgate.com/pfsense/en/latest/config/advanced-misc.html) (cryptographic and thermal hardware, power saving). Do not use together with 'pfsense_system_crypto_module', which manages the same hardware settings. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_hardware": schema.StringAttribute{
+				Description:         fmt.Sprintf("Cryptographic accelerator module, options: '%s'. Unset for none.", strings.Join(pfsense.AdvancedMiscCryptoHardwares(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Cryptographic accelerator module, options: `%s`. Unset for none.", strings.Join(pfsense.AdvancedMiscCryptoHardwares(), "`, `")),
+				Optional:            true,
+			},
+			"thermal_hardware": schema.StringAttribute{
+				Description:         fmt.Sprintf("Thermal sensor module, options: '%s'. Unset for none.", strings.Join(pfsense.AdvancedMiscThermalHardwares(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Thermal sensor module, options: `%s`. Unset for none.", strings.Join(pfsense.AdvancedMiscThermalHardwares(), "`, `")),
+				Optional:            true,
+			},
+			"power_saving": schema.BoolAttribute{
+				Description:         "Enable PowerD, defaults to 'false'.",
+				MarkdownDescription: "Enable PowerD, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"power_ac_mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("PowerD mode while on AC power, options: '%s', defaults to 'hadp'.", strings.Join(pfsense.AdvancedMiscPowerModes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("PowerD mode while on AC power, options: `%s`, defaults to `hadp`.", strings.Join(pfsense.AdvancedMiscPowerModes(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("hadp"),
+			},
+			"power_battery_mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("PowerD mode while on battery power, options: '%s', defaults to 'hadp'.", strings.Join(pfsense.AdvancedMiscPowerModes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("PowerD mode while on battery power, options: `%s`, defaults to `hadp`.", strings.Join(pfsense.AdvancedMiscPowerModes(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("hadp"),
+			},
+			"power_normal_mode": schema.StringAttribute{
+				Description:         fmt.Sprintf("PowerD mode when the power source is unknown, options: '%s', defaults to 'hadp'.", strings.Join(pfsense.AdvancedMiscPowerModes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("PowerD mode when the power source is unknown, options: `%s`, defaults to `hadp`.", strings.Join(pfsense.AdvancedMiscPowerModes(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("hadp"),
+			},
+		},
+	}
+}
+
+func (r *SystemAdvancedMiscResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemAdvancedMiscResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemAdvancedMiscResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	amReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	am, err := r.client.UpdateSystemAdvancedMisc(ctx, *amReq)
+	if addError(&resp.Diagnostics, "Error creating advanced misc settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, am)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedMiscResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemAdvancedMiscResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	am, err := r.client.GetSystemAdvancedMisc(ctx)
+	if addError(&resp.Diagnostics, "Error reading advanced misc settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, am)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedMiscResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemAdvancedMiscResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	amReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	am, err := r.client.UpdateSystemAdvancedMisc(ctx, *amReq)
+	if addError(&resp.Diagnostics, "Error updating advanced misc settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, am)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedMiscResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
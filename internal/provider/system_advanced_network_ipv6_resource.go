@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemAdvancedNetworkIPv6Resource{}
+
+func NewSystemAdvancedNetworkIPv6Resource() resource.Resource {
+	return &SystemAdvancedNetworkIPv6Resource{}
+}
+
+type SystemAdvancedNetworkIPv6Resource struct {
+	client *pfsense.Client
+}
+
+type SystemAdvancedNetworkIPv6ResourceModel struct {
+	AllowIPv6  types.Bool `tfsdk:"allow_ipv6"`
+	PreferIPv4 types.Bool `tfsdk:"prefer_ipv4"`
+}
+
+func (r *SystemAdvancedNetworkIPv6ResourceModel) SetFromValue(ctx context.Context, a *pfsense.AdvancedIPv6) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.AllowIPv6 = types.BoolValue(a.AllowIPv6)
+	r.PreferIPv4 = types.BoolValue(a.PreferIPv4)
+
+	return diags
+}
+
+func (r SystemAdvancedNetworkIPv6ResourceModel) Value(ctx context.Context) (*pfsense.AdvancedIPv6, diag.Diagnostics) {
+	var a pfsense.AdvancedIPv6
+	var err error
+	var diags diag.Diagnostics
+
+	err = a.SetAllowIPv6(r.AllowIPv6.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("allow_ipv6"),
+			"Allow IPv6 cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = a.SetPreferIPv4(r.PreferIPv4.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("prefer_ipv4"),
+			"Prefer IPv4 cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &a, diags
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_advanced_network_ipv6", req.ProviderTypeName)
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Advanced IPv6 networking settings (allow IPv6, prefer IPv4). Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[Advanced IPv6 networking settings](https://docs.netgate.com/pfsense/en/latest/config/advanced-networking.html) (allow IPv6, prefer IPv4). Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"allow_ipv6": schema.BoolAttribute{
+				Description:         "Allow IPv6 traffic, when disabled all IPv6 traffic is blocked by the firewall, defaults to 'true'.",
+				MarkdownDescription: "Allow IPv6 traffic, when disabled all IPv6 traffic is blocked by the firewall, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"prefer_ipv4": schema.BoolAttribute{
+				Description:         "Prefer IPv4 over IPv6 when resolving hostnames for outgoing connections of the firewall itself, defaults to 'false'.",
+				MarkdownDescription: "Prefer IPv4 over IPv6 when resolving hostnames for outgoing connections of the firewall itself, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemAdvancedNetworkIPv6ResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a, err := r.client.UpdateSystemAdvancedNetworkIPv6(ctx, *aReq)
+	if addError(&resp.Diagnostics, "Error creating advanced IPv6 settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, a)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemAdvancedNetworkIPv6ResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a, err := r.client.GetSystemAdvancedNetworkIPv6(ctx)
+	if addError(&resp.Diagnostics, "Error reading advanced IPv6 settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, a)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemAdvancedNetworkIPv6ResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	aReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a, err := r.client.UpdateSystemAdvancedNetworkIPv6(ctx, *aReq)
+	if addError(&resp.Diagnostics, "Error updating advanced IPv6 settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, a)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNetworkIPv6Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
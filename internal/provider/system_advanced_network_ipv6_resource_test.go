@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSystemAdvancedNetworkIPv6Resource toggles IPv6 off and back on, the last step restores the pfSense default
+// as destroying leaves the settings unchanged.
+func TestAccSystemAdvancedNetworkIPv6Resource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_advanced_network_ipv6" "test" {
+  allow_ipv6 = false
+}
+`,
+				Check: resource.TestCheckResourceAttr("pfsense_system_advanced_network_ipv6.test", "allow_ipv6", "false"),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_advanced_network_ipv6" "test" {
+  allow_ipv6 = true
+}
+`,
+				Check: resource.TestCheckResourceAttr("pfsense_system_advanced_network_ipv6.test", "allow_ipv6", "true"),
+			},
+		},
+	})
+}
@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemAdvancedNotificationsResource{}
+
+func NewSystemAdvancedNotificationsResource() resource.Resource {
+	return &SystemAdvancedNotificationsResource{}
+}
+
+type SystemAdvancedNotificationsResource struct {
+	client *pfsense.Client
+}
+
+type SystemAdvancedNotificationsResourceModel struct {
+	SMTPDisabled      types.Bool   `tfsdk:"smtp_disabled"`
+	SMTPServer        types.String `tfsdk:"smtp_server"`
+	SMTPPort          types.Int64  `tfsdk:"smtp_port"`
+	SMTPSecure        types.Bool   `tfsdk:"smtp_secure"`
+	SMTPValidateCert  types.Bool   `tfsdk:"smtp_validate_certificate"`
+	SMTPFrom          types.String `tfsdk:"smtp_from"`
+	SMTPTo            types.String `tfsdk:"smtp_to"`
+	SMTPUsername      types.String `tfsdk:"smtp_username"`
+	SMTPPassword      types.String `tfsdk:"smtp_password"`
+	SMTPAuthMechanism types.String `tfsdk:"smtp_auth_mechanism"`
+	TelegramEnabled   types.Bool   `tfsdk:"telegram_enabled"`
+	TelegramAPIKey    types.String `tfsdk:"telegram_api_key"`
+	TelegramChatID    types.String `tfsdk:"telegram_chat_id"`
+}
+
+func (r *SystemAdvancedNotificationsResourceModel) SetFromValue(ctx context.Context, n *pfsense.Notifications) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.SMTPDisabled = types.BoolValue(n.SMTPDisabled)
+
+	r.SMTPServer = types.StringNull()
+	if n.SMTPServer != "" {
+		r.SMTPServer = types.StringValue(n.SMTPServer)
+	}
+
+	r.SMTPPort = types.Int64Value(int64(n.SMTPPort))
+	r.SMTPSecure = types.BoolValue(n.SMTPSecure)
+	r.SMTPValidateCert = types.BoolValue(n.SMTPValidateCert)
+
+	r.SMTPFrom = types.StringNull()
+	if n.SMTPFrom != "" {
+		r.SMTPFrom = types.StringValue(n.SMTPFrom)
+	}
+
+	r.SMTPTo = types.StringNull()
+	if n.SMTPTo != "" {
+		r.SMTPTo = types.StringValue(n.SMTPTo)
+	}
+
+	r.SMTPUsername = types.StringNull()
+	if n.SMTPUsername != "" {
+		r.SMTPUsername = types.StringValue(n.SMTPUsername)
+	}
+
+	r.SMTPPassword = types.StringNull()
+	if n.SMTPPassword != "" {
+		r.SMTPPassword = types.StringValue(n.SMTPPassword)
+	}
+
+	r.SMTPAuthMechanism = types.StringValue(n.SMTPAuthMechanism)
+	r.TelegramEnabled = types.BoolValue(n.TelegramEnabled)
+
+	r.TelegramAPIKey = types.StringNull()
+	if n.TelegramAPIKey != "" {
+		r.TelegramAPIKey = types.StringValue(n.TelegramAPIKey)
+	}
+
+	r.TelegramChatID = types.StringNull()
+	if n.TelegramChatID != "" {
+		r.TelegramChatID = types.StringValue(n.TelegramChatID)
+	}
+
+	return diags
+}
+
+func (r SystemAdvancedNotificationsResourceModel) Value(ctx context.Context) (*pfsense.Notifications, diag.Diagnostics) {
+	var n pfsense.Notifications
+	var err error
+	var diags diag.Diagnostics
+
+	err = n.SetSMTPDisabled(r.SMTPDisabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("smtp_disabled"),
+			"SMTP disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.SMTPServer.IsNull() {
+		err = n.SetSMTPServer(r.SMTPServer.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("smtp_server"),
+				"SMTP server cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = n.SetSMTPPort(int(r.SMTPPort.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("smtp_port"),
+			"SMTP port cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = n.SetSMTPSecure(r.SMTPSecure.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("smtp_secure"),
+			"SMTP secure cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = n.SetSMTPValidateCert(r.SMTPValidateCert.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("smtp_validate_certificate"),
+			"SMTP validate certificate cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.SMTPFrom.IsNull() {
+		err = n.SetSMTPFrom(r.SMTPFrom.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("smtp_from"),
+				"SMTP from cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.SMTPTo.IsNull() {
+		err = n.SetSMTPTo(r.SMTPTo.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("smtp_to"),
+				"SMTP to cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.SMTPUsername.IsNull() {
+		err = n.SetSMTPUsername(r.SMTPUsername.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("smtp_username"),
+				"SMTP username cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.SMTPPassword.IsNull() {
+		err = n.SetSMTPPassword(r.SMTPPassword.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("smtp_password"),
+				"SMTP password cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = n.SetSMTPAuthMechanism(r.SMTPAuthMechanism.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("smtp_auth_mechanism"),
+			"SMTP authentication mechanism cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = n.SetTelegramEnabled(r.TelegramEnabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("telegram_enabled"),
+			"Telegram enabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.TelegramAPIKey.IsNull() {
+		err = n.SetTelegramAPIKey(r.TelegramAPIKey.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("telegram_api_key"),
+				"Telegram API key cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.TelegramChatID.IsNull() {
+		err = n.SetTelegramChatID(r.TelegramChatID.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("telegram_chat_id"),
+				"Telegram chat ID cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &n, diags
+}
+
+func (r *SystemAdvancedNotificationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_advanced_notifications", req.ProviderTypeName)
+}
+
+func (r *SystemAdvancedNotificationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Notification settings, delivery of system alerts (e.g. gateway or certificate events) by SMTP (e-mail) or Telegram. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[Notification settings](https://docs.netgate.com/pfsense/en/latest/config/advanced-notifications.html), delivery of system alerts (e.g. gateway or certificate events) by SMTP (e-mail) or Telegram. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"smtp_disabled": schema.BoolAttribute{
+				Description:         "Disable SMTP notifications but preserve the settings, defaults to 'false'.",
+				MarkdownDescription: "Disable SMTP notifications but preserve the settings, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"smtp_server": schema.StringAttribute{
+				Description: "IP address or hostname of the SMTP server. Unset to not send e-mail notifications.",
+				Optional:    true,
+			},
+			"smtp_port": schema.Int64Attribute{
+				Description:         "Port of the SMTP server, defaults to '25'.",
+				MarkdownDescription: "Port of the SMTP server, defaults to `25`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(25),
+			},
+			"smtp_secure": schema.BoolAttribute{
+				Description:         "Connect to the SMTP server using SSL/TLS, defaults to 'false'.",
+				MarkdownDescription: "Connect to the SMTP server using SSL/TLS, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"smtp_validate_certificate": schema.BoolAttribute{
+				Description:         "Validate the SSL/TLS certificate of the SMTP server, defaults to 'true'.",
+				MarkdownDescription: "Validate the SSL/TLS certificate of the SMTP server, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"smtp_from": schema.StringAttribute{
+				Description: "E-mail address notifications are sent from.",
+				Optional:    true,
+			},
+			"smtp_to": schema.StringAttribute{
+				Description: "E-mail address notifications are sent to.",
+				Optional:    true,
+			},
+			"smtp_username": schema.StringAttribute{
+				Description: "Username for SMTP authentication.",
+				Optional:    true,
+			},
+			"smtp_password": schema.StringAttribute{
+				Description: "Password for SMTP authentication.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"smtp_auth_mechanism": schema.StringAttribute{
+				Description:         fmt.Sprintf("SMTP authentication mechanism, options: '%s', defaults to 'PLAIN'.", strings.Join(pfsense.NotificationsSMTPAuthMechanisms(), "', '")),
+				MarkdownDescription: fmt.Sprintf("SMTP authentication mechanism, options: `%s`, defaults to `PLAIN`.", strings.Join(pfsense.NotificationsSMTPAuthMechanisms(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("PLAIN"),
+			},
+			"telegram_enabled": schema.BoolAttribute{
+				Description:         "Enable Telegram notifications, defaults to 'false'.",
+				MarkdownDescription: "Enable Telegram notifications, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"telegram_api_key": schema.StringAttribute{
+				Description: "Telegram bot API key.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"telegram_chat_id": schema.StringAttribute{
+				Description: "Telegram chat ID notifications are sent to.",
+				Optional:    true,
+			},
+		},
+	}
+}
+func (r *SystemAdvancedNotificationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemAdvancedNotificationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemAdvancedNotificationsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	n, err := r.client.UpdateSystemAdvancedNotifications(ctx, *nReq)
+	if addError(&resp.Diagnostics, "Error creating notification settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, n)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNotificationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemAdvancedNotificationsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	n, err := r.client.GetSystemAdvancedNotifications(ctx)
+	if addError(&resp.Diagnostics, "Error reading notification settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, n)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNotificationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemAdvancedNotificationsResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	n, err := r.client.UpdateSystemAdvancedNotifications(ctx, *nReq)
+	if addError(&resp.Diagnostics, "Error updating notification settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, n)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemAdvancedNotificationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
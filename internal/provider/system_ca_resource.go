@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemCAResource{}
+var _ resource.ResourceWithImportState = &SystemCAResource{}
+
+func NewSystemCAResource() resource.Resource {
+	return &SystemCAResource{}
+}
+
+type SystemCAResource struct {
+	client *pfsense.Client
+}
+
+type SystemCAResourceModel struct {
+	Description types.String `tfsdk:"description"`
+	Method      types.String `tfsdk:"method"`
+	Certificate types.String `tfsdk:"certificate"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+	CommonName  types.String `tfsdk:"common_name"`
+	KeyLength   types.Int64  `tfsdk:"key_length"`
+	Digest      types.String `tfsdk:"digest"`
+	Lifetime    types.Int64  `tfsdk:"lifetime"`
+	Trust       types.Bool   `tfsdk:"trust"`
+	RefID       types.String `tfsdk:"refid"`
+}
+
+func (r *SystemCAResourceModel) SetFromValue(ctx context.Context, ca *pfsense.CertificateAuthority) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Description = types.StringValue(ca.Description)
+	r.Certificate = equivalentPEM(r.Certificate, ca.Certificate)
+
+	r.PrivateKey = types.StringNull()
+	if ca.PrivateKey != "" {
+		r.PrivateKey = equivalentPEM(r.PrivateKey, ca.PrivateKey)
+	}
+
+	// the method and internal CA parameters are not stored by pfSense, an imported CA is treated as existing
+	if r.Method.IsNull() || r.Method.IsUnknown() {
+		r.Method = types.StringValue(pfsense.CertificateAuthorityMethodExisting)
+	}
+
+	r.Trust = types.BoolValue(ca.Trust)
+	r.RefID = types.StringValue(ca.RefID)
+
+	return diags
+}
+
+func (r SystemCAResourceModel) Value(ctx context.Context) (*pfsense.CertificateAuthority, diag.Diagnostics) {
+	var ca pfsense.CertificateAuthority
+	var err error
+	var diags diag.Diagnostics
+
+	err = ca.SetDescription(r.Description.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("description"),
+			"Description cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = ca.SetMethod(r.Method.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("method"),
+			"Method cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Certificate.IsNull() && !r.Certificate.IsUnknown() {
+		err = ca.SetCertificate(r.Certificate.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("certificate"),
+				"Certificate cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.PrivateKey.IsNull() && !r.PrivateKey.IsUnknown() {
+		err = ca.SetPrivateKey(r.PrivateKey.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("private_key"),
+				"Private key cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if ca.Method == pfsense.CertificateAuthorityMethodInternal {
+		err = ca.SetCommonName(r.CommonName.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("common_name"),
+				"Common name cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ca.SetKeyLength(int(r.KeyLength.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("key_length"),
+				"Key length cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ca.SetDigest(r.Digest.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("digest"),
+				"Digest cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ca.SetLifetime(int(r.Lifetime.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("lifetime"),
+				"Lifetime cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = ca.SetTrust(r.Trust.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("trust"),
+			"Trust cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.RefID.IsNull() && !r.RefID.IsUnknown() {
+		err = ca.SetRefID(r.RefID.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("refid"),
+				"Reference ID cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &ca, diags
+}
+
+func (r *SystemCAResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_ca", req.ProviderTypeName)
+}
+
+func (r *SystemCAResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Certificate authority, either an existing CA that is imported or an internal CA generated by pfSense that can issue certificates.",
+		MarkdownDescription: "[Certificate authority](https://docs.netgate.com/pfsense/en/latest/certificates/ca.html), either an existing CA that is imported or an internal CA generated by pfSense that can issue certificates.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "Descriptive name of the certificate authority.",
+				Required:    true,
+			},
+			"method": schema.StringAttribute{
+				Description:         fmt.Sprintf("How the certificate authority is created, options: '%s'.", strings.Join(pfsense.CertificateAuthorityMethods(), "', '")),
+				MarkdownDescription: fmt.Sprintf("How the certificate authority is created, options: `%s`.", strings.Join(pfsense.CertificateAuthorityMethods(), "`, `")),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				Description:         "PEM encoded CA certificate. Required for 'existing', generated for 'internal'.",
+				MarkdownDescription: "PEM encoded CA certificate. Required for `existing`, generated for `internal`.",
+				Computed:            true,
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Description:         "PEM encoded private key of the CA. Optional for 'existing' (required to issue certificates), generated for 'internal'.",
+				MarkdownDescription: "PEM encoded private key of the CA. Optional for `existing` (required to issue certificates), generated for `internal`.",
+				Computed:            true,
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"common_name": schema.StringAttribute{
+				Description:         "Common name (CN) of an 'internal' CA.",
+				MarkdownDescription: "Common name (CN) of an `internal` CA.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_length": schema.Int64Attribute{
+				Description:         "RSA key length (bits) of an 'internal' CA, defaults to '2048'.",
+				MarkdownDescription: "RSA key length (bits) of an `internal` CA, defaults to `2048`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(2048),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"digest": schema.StringAttribute{
+				Description:         fmt.Sprintf("Digest algorithm of an 'internal' CA, options: '%s', defaults to 'sha256'.", strings.Join(pfsense.CertificateAuthorityDigests(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Digest algorithm of an `internal` CA, options: `%s`, defaults to `sha256`.", strings.Join(pfsense.CertificateAuthorityDigests(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("sha256"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"lifetime": schema.Int64Attribute{
+				Description:         "Lifetime (days) of an 'internal' CA, defaults to '3650'.",
+				MarkdownDescription: "Lifetime (days) of an `internal` CA, defaults to `3650`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(3650),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"trust": schema.BoolAttribute{
+				Description:         "Add the CA to the operating system trust store, defaults to 'false'.",
+				MarkdownDescription: "Add the CA to the operating system trust store, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"refid": schema.StringAttribute{
+				Description: "Reference ID generated by pfSense, used to refer to the certificate authority elsewhere in the configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+func (r *SystemCAResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemCAResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemCAResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca, err := r.client.CreateSystemCertificateAuthority(ctx, *caReq)
+	if addError(&resp.Diagnostics, "Error creating certificate authority", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, ca)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCAResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemCAResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca, err := r.client.GetSystemCertificateAuthority(ctx, data.RefID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading certificate authority", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, ca)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCAResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemCAResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	caReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ca, err := r.client.UpdateSystemCertificateAuthority(ctx, *caReq)
+	if addError(&resp.Diagnostics, "Error updating certificate authority", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, ca)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCAResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemCAResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSystemCertificateAuthority(ctx, data.RefID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting certificate authority", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SystemCAResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("refid"), req, resp)
+}
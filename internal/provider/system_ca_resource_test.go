@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccSystemCAResourceExisting imports an external CA with its key and a certificate it issued, pfSense links the
+// certificate to the CA by its issuer.
+func TestAccSystemCAResourceExisting(t *testing.T) {
+	caPEM, caKeyPEM := testAccSelfSignedCertificatePEM(t, "tf-acc-test-ca", true)
+	certPEM, keyPEM := testAccIssuedCertificatePEM(t, "tf-acc-test", false, caPEM, caKeyPEM)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_system_ca" "test" {
+  description = "tf acc test external"
+  method      = "existing"
+  certificate = <<-EOT
+%sEOT
+  private_key = <<-EOT
+%sEOT
+}
+
+resource "pfsense_system_certificate" "test" {
+  description = "tf acc test issued"
+  certificate = <<-EOT
+%sEOT
+  private_key = <<-EOT
+%sEOT
+
+  depends_on = [pfsense_system_ca.test]
+}
+`, caPEM, caKeyPEM, certPEM, keyPEM),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_ca.test", "certificate", caPEM),
+					resource.TestMatchResourceAttr("pfsense_system_ca.test", "refid", regexp.MustCompile(`^[0-9a-f]{13}$`)),
+					resource.TestCheckResourceAttrPair("pfsense_system_certificate.test", "ca_refid", "pfsense_system_ca.test", "refid"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_system_ca.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "refid",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["pfsense_system_ca.test"].Primary.Attributes["refid"], nil
+				},
+				ImportStateVerifyIgnore: []string{"method", "common_name", "key_length", "digest", "lifetime"},
+			},
+		},
+	})
+}
+
+// TestAccSystemCAResourceInternal creates an internal CA, the generated certificate, key and reference ID are exposed.
+func TestAccSystemCAResourceInternal(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_ca" "test" {
+  description = "tf acc test internal"
+  method      = "internal"
+  common_name = "tf-acc-test-internal-ca"
+  key_length  = 2048
+  digest      = "sha256"
+  lifetime    = 365
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("pfsense_system_ca.test", "refid", regexp.MustCompile(`^[0-9a-f]{13}$`)),
+					resource.TestMatchResourceAttr("pfsense_system_ca.test", "certificate", regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+					resource.TestCheckResourceAttrSet("pfsense_system_ca.test", "private_key"),
+				),
+			},
+		},
+	})
+}
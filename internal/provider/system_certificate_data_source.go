@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &SystemCertificateDataSource{}
+	_ datasource.DataSourceWithConfigure = &SystemCertificateDataSource{}
+)
+
+func NewSystemCertificateDataSource() datasource.DataSource {
+	return &SystemCertificateDataSource{}
+}
+
+type SystemCertificateDataSource struct {
+	client *pfsense.Client
+}
+
+type SystemCertificateDataSourceModel struct {
+	RefID             types.String `tfsdk:"refid"`
+	IncludePrivateKey types.Bool   `tfsdk:"include_private_key"`
+	Description       types.String `tfsdk:"description"`
+	Certificate       types.String `tfsdk:"certificate"`
+	PrivateKey        types.String `tfsdk:"private_key"`
+	CARefID           types.String `tfsdk:"ca_refid"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	NotAfter          types.String `tfsdk:"not_after"`
+}
+
+func (d *SystemCertificateDataSourceModel) SetFromValue(ctx context.Context, cert *pfsense.Certificate) diag.Diagnostics {
+	d.RefID = types.StringValue(cert.RefID)
+
+	if cert.Description != "" {
+		d.Description = types.StringValue(cert.Description)
+	}
+
+	d.Certificate = types.StringValue(cert.Certificate)
+
+	if d.IncludePrivateKey.ValueBool() && cert.PrivateKey != "" {
+		d.PrivateKey = types.StringValue(cert.PrivateKey)
+	}
+
+	if cert.CARefID != "" {
+		d.CARefID = types.StringValue(cert.CARefID)
+	}
+
+	d.NotBefore = types.StringValue(cert.NotBefore.Format(time.RFC3339))
+	d.NotAfter = types.StringValue(cert.NotAfter.Format(time.RFC3339))
+
+	return nil
+}
+
+func (d *SystemCertificateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_certificate", req.ProviderTypeName)
+}
+
+func (d *SystemCertificateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves a single certificate by reference ID, exporting the PEM encoded certificate (and optionally private key) for use elsewhere.",
+		MarkdownDescription: "Retrieves a single [certificate](https://docs.netgate.com/pfsense/en/latest/certificates/certificate.html) by reference ID, exporting the PEM encoded certificate (and optionally private key) for use elsewhere.",
+		Attributes: map[string]schema.Attribute{
+			"refid": schema.StringAttribute{
+				Description: "Reference ID of the certificate.",
+				Required:    true,
+			},
+			"include_private_key": schema.BoolAttribute{
+				Description:         "Export the private key, defaults to 'false'.",
+				MarkdownDescription: "Export the private key, defaults to `false`.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Computed:    true,
+			},
+			"certificate": schema.StringAttribute{
+				Description: "PEM encoded X.509 certificate.",
+				Computed:    true,
+			},
+			"private_key": schema.StringAttribute{
+				Description:         "PEM encoded private key, only set when 'include_private_key' is enabled and pfSense holds the key.",
+				MarkdownDescription: "PEM encoded private key, only set when `include_private_key` is enabled and pfSense holds the key.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"ca_refid": schema.StringAttribute{
+				Description: "Reference ID of the certificate authority that issued the certificate, if it is known to pfSense.",
+				Computed:    true,
+			},
+			"not_before": schema.StringAttribute{
+				Description: "Start of the validity period (RFC 3339).",
+				Computed:    true,
+			},
+			"not_after": schema.StringAttribute{
+				Description: "Expiration time (RFC 3339).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SystemCertificateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemCertificateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemCertificateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := d.client.GetSystemCertificate(ctx, data.RefID.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get certificate", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromValue(ctx, cert)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+func TestSystemCertificateDataSourceModelPrivateKey(t *testing.T) {
+	cert := &pfsense.Certificate{
+		RefID:       "5f0a1b2c3d4e5",
+		Certificate: "certificate",
+		PrivateKey:  "private key",
+		NotBefore:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:    time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	// the private key is only exported when requested
+	tests := []struct {
+		includePrivateKey types.Bool
+		want              types.String
+	}{
+		{types.BoolNull(), types.StringNull()},
+		{types.BoolValue(false), types.StringNull()},
+		{types.BoolValue(true), types.StringValue("private key")},
+	}
+
+	for _, tt := range tests {
+		data := SystemCertificateDataSourceModel{IncludePrivateKey: tt.includePrivateKey}
+		if diags := data.SetFromValue(context.Background(), cert); diags.HasError() {
+			t.Fatalf("unexpected error, %v", diags)
+		}
+
+		if !data.PrivateKey.Equal(tt.want) {
+			t.Errorf("include private key %s: private key = %s, want %s", tt.includePrivateKey, data.PrivateKey, tt.want)
+		}
+
+		if data.NotAfter.ValueString() != "2027-01-01T00:00:00Z" || !data.CARefID.IsNull() || !data.Description.IsNull() {
+			t.Errorf("unexpected data %+v", data)
+		}
+	}
+}
+
+// TestAccSystemCertificateDataSource exports an imported certificate and its key by reference ID.
+func TestAccSystemCertificateDataSource(t *testing.T) {
+	certPEM, keyPEM := testAccSelfSignedCertificatePEM(t, "tf-acc-test-export", false)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_system_certificate" "test" {
+  description = "tf acc test export"
+  certificate = <<-EOT
+%sEOT
+  private_key = <<-EOT
+%sEOT
+}
+
+data "pfsense_system_certificate" "test" {
+  refid               = pfsense_system_certificate.test.refid
+  include_private_key = true
+}
+
+data "pfsense_system_certificate" "without_key" {
+  refid = pfsense_system_certificate.test.refid
+}
+`, certPEM, keyPEM),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pfsense_system_certificate.test", "description", "tf acc test export"),
+					resource.TestCheckResourceAttr("data.pfsense_system_certificate.test", "certificate", certPEM),
+					resource.TestCheckResourceAttr("data.pfsense_system_certificate.test", "private_key", keyPEM),
+					resource.TestCheckResourceAttrSet("data.pfsense_system_certificate.test", "not_after"),
+					resource.TestCheckResourceAttr("data.pfsense_system_certificate.without_key", "certificate", certPEM),
+					resource.TestCheckNoResourceAttr("data.pfsense_system_certificate.without_key", "private_key"),
+				),
+			},
+		},
+	})
+}
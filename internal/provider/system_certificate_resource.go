@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemCertificateResource{}
+var _ resource.ResourceWithImportState = &SystemCertificateResource{}
+
+func NewSystemCertificateResource() resource.Resource {
+	return &SystemCertificateResource{}
+}
+
+type SystemCertificateResource struct {
+	client *pfsense.Client
+}
+
+type SystemCertificateResourceModel struct {
+	Description types.String `tfsdk:"description"`
+	Certificate types.String `tfsdk:"certificate"`
+	PrivateKey  types.String `tfsdk:"private_key"`
+	RefID       types.String `tfsdk:"refid"`
+	CARefID     types.String `tfsdk:"ca_refid"`
+}
+
+// equivalentPEM keeps the configured PEM when it only differs from the stored one by surrounding whitespace.
+func equivalentPEM(configured types.String, stored string) types.String {
+	if !configured.IsNull() && !configured.IsUnknown() && strings.TrimSpace(configured.ValueString()) == strings.TrimSpace(stored) {
+		return configured
+	}
+
+	return types.StringValue(stored)
+}
+
+func (r *SystemCertificateResourceModel) SetFromValue(ctx context.Context, cert *pfsense.Certificate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Description = types.StringNull()
+	if cert.Description != "" {
+		r.Description = types.StringValue(cert.Description)
+	}
+
+	r.Certificate = equivalentPEM(r.Certificate, cert.Certificate)
+	r.PrivateKey = equivalentPEM(r.PrivateKey, cert.PrivateKey)
+	r.RefID = types.StringValue(cert.RefID)
+
+	r.CARefID = types.StringNull()
+	if cert.CARefID != "" {
+		r.CARefID = types.StringValue(cert.CARefID)
+	}
+
+	return diags
+}
+
+func (r SystemCertificateResourceModel) Value(ctx context.Context) (*pfsense.Certificate, diag.Diagnostics) {
+	var cert pfsense.Certificate
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.Description.IsNull() {
+		err = cert.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = cert.SetCertificate(r.Certificate.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("certificate"),
+			"Certificate cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = cert.SetPrivateKey(r.PrivateKey.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("private_key"),
+			"Private key cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.RefID.IsNull() && !r.RefID.IsUnknown() {
+		err = cert.SetRefID(r.RefID.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("refid"),
+				"Reference ID cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &cert, diags
+}
+
+func (r *SystemCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_certificate", req.ProviderTypeName)
+}
+
+func (r *SystemCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Certificate, an imported certificate and private key for use by the web configurator, VPNs, packages and other services.",
+		MarkdownDescription: "[Certificate](https://docs.netgate.com/pfsense/en/latest/certificates/certificate.html), an imported certificate and private key for use by the web configurator, VPNs, packages and other services.",
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"certificate": schema.StringAttribute{
+				Description: "PEM encoded X.509 certificate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Description: "PEM encoded private key matching the certificate.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"refid": schema.StringAttribute{
+				Description: "Reference ID generated by pfSense, used to refer to the certificate elsewhere in the configuration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ca_refid": schema.StringAttribute{
+				Description: "Reference ID of the certificate authority that issued the certificate, if it is known to pfSense.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SystemCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemCertificateResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.CreateSystemCertificate(ctx, *certReq)
+	if addError(&resp.Diagnostics, "Error creating certificate", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemCertificateResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.GetSystemCertificate(ctx, data.RefID.ValueString())
+	if addError(&resp.Diagnostics, "Error reading certificate", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemCertificateResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert, err := r.client.UpdateSystemCertificate(ctx, *certReq)
+	if addError(&resp.Diagnostics, "Error updating certificate", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cert)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSystemCertificate(ctx, data.RefID.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting certificate", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SystemCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("refid"), req, resp)
+}
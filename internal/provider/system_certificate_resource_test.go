@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccSelfSignedCertificatePEM returns a self-signed certificate and its private key.
+func testAccSelfSignedCertificatePEM(t *testing.T, commonName string, isCA bool) (string, string) {
+	t.Helper()
+
+	return testAccIssuedCertificatePEM(t, commonName, isCA, "", "")
+}
+
+// testAccIssuedCertificatePEM returns a certificate issued by the given CA certificate and key and its private key,
+// the certificate is self-signed when no CA is given.
+func testAccIssuedCertificatePEM(t *testing.T, commonName string, isCA bool, caCertPEM string, caKeyPEM string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key, %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(30 * 24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+
+	if isCA {
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+
+	parent, parentKey := template, any(key)
+	if caCertPEM != "" {
+		certBlock, _ := pem.Decode([]byte(caCertPEM))
+		keyBlock, _ := pem.Decode([]byte(caKeyPEM))
+		if certBlock == nil || keyBlock == nil {
+			t.Fatal("unable to decode CA certificate and key")
+		}
+
+		parent, err = x509.ParseCertificate(certBlock.Bytes)
+		if err != nil {
+			t.Fatalf("unable to parse CA certificate, %s", err)
+		}
+
+		parentKey, err = x509.ParsePKCS8PrivateKey(keyBlock.Bytes)
+		if err != nil {
+			t.Fatalf("unable to parse CA key, %s", err)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("unable to create certificate, %s", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key, %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+// TestAccSystemCertificateResource imports a self-signed certificate and key pair, then imports the resource by the
+// reference ID pfSense generated.
+func TestAccSystemCertificateResource(t *testing.T) {
+	certPEM, keyPEM := testAccSelfSignedCertificatePEM(t, "tf-acc-test", false)
+
+	config := func(description string) string {
+		return testAccProviderConfig() + fmt.Sprintf(`
+resource "pfsense_system_certificate" "test" {
+  description = %q
+  certificate = <<-EOT
+%sEOT
+  private_key = <<-EOT
+%sEOT
+}
+`, description, certPEM, keyPEM)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf acc test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_certificate.test", "description", "tf acc test"),
+					resource.TestCheckResourceAttr("pfsense_system_certificate.test", "certificate", certPEM),
+					resource.TestMatchResourceAttr("pfsense_system_certificate.test", "refid", regexp.MustCompile(`^[0-9a-f]{13}$`)),
+					resource.TestCheckNoResourceAttr("pfsense_system_certificate.test", "ca_refid"),
+				),
+			},
+			{
+				Config: config("tf acc test updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_certificate.test", "description", "tf acc test updated"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_system_certificate.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "refid",
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["pfsense_system_certificate.test"].Primary.Attributes["refid"], nil
+				},
+			},
+		},
+	})
+}
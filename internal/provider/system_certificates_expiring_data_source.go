@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+const defaultCertificateExpirationThreshold = 30 * 24 * time.Hour
+
+var (
+	_ datasource.DataSource              = &SystemCertificatesExpiringDataSource{}
+	_ datasource.DataSourceWithConfigure = &SystemCertificatesExpiringDataSource{}
+)
+
+func NewSystemCertificatesExpiringDataSource() datasource.DataSource {
+	return &SystemCertificatesExpiringDataSource{}
+}
+
+type SystemCertificatesExpiringDataSource struct {
+	client *pfsense.Client
+}
+
+type SystemCertificatesExpiringDataSourceModel struct {
+	Threshold    types.String `tfsdk:"threshold"`
+	Certificates types.List   `tfsdk:"certificates"`
+}
+
+type SystemCertificateExpiringDataSourceModel struct {
+	RefID       types.String `tfsdk:"refid"`
+	Description types.String `tfsdk:"description"`
+	NotAfter    types.String `tfsdk:"not_after"`
+	Expired     types.Bool   `tfsdk:"expired"`
+}
+
+func (d SystemCertificateExpiringDataSourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"refid":       types.StringType,
+		"description": types.StringType,
+		"not_after":   types.StringType,
+		"expired":     types.BoolType,
+	}}
+}
+
+func (d *SystemCertificateExpiringDataSourceModel) SetFromValue(ctx context.Context, cert *pfsense.Certificate, now time.Time) diag.Diagnostics {
+	d.RefID = types.StringValue(cert.RefID)
+
+	if cert.Description != "" {
+		d.Description = types.StringValue(cert.Description)
+	}
+
+	d.NotAfter = types.StringValue(cert.NotAfter.Format(time.RFC3339))
+	d.Expired = types.BoolValue(cert.ExpiresWithin(now, 0))
+
+	return nil
+}
+
+func (d *SystemCertificatesExpiringDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_certificates_expiring", req.ProviderTypeName)
+}
+
+func (d *SystemCertificatesExpiringDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves certificates that have expired or will expire within a threshold, for use in triggering renewal.",
+		MarkdownDescription: "Retrieves [certificates](https://docs.netgate.com/pfsense/en/latest/certificates/certificate.html) that have expired or will expire within a threshold, for use in triggering renewal.",
+		Attributes: map[string]schema.Attribute{
+			"threshold": schema.StringAttribute{
+				Description:         "Duration (e.g. '720h') before expiration at which a certificate is included, defaults to '720h'.",
+				MarkdownDescription: "Duration (e.g. `720h`) before expiration at which a certificate is included, defaults to `720h`.",
+				Optional:            true,
+			},
+			"certificates": schema.ListNestedAttribute{
+				Description: "Certificates expiring within the threshold.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"refid": schema.StringAttribute{
+							Description: "Reference ID of certificate.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descriptive name of certificate.",
+							Computed:    true,
+						},
+						"not_after": schema.StringAttribute{
+							Description: "Expiration date of certificate (RFC3339).",
+							Computed:    true,
+						},
+						"expired": schema.BoolAttribute{
+							Description: "Certificate has already expired.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemCertificatesExpiringDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemCertificatesExpiringDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemCertificatesExpiringDataSourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	threshold := defaultCertificateExpirationThreshold
+	if !data.Threshold.IsNull() {
+		var err error
+		threshold, err = time.ParseDuration(data.Threshold.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("threshold"),
+				"Threshold cannot be parsed",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	certs, err := d.client.GetSystemCertificates(ctx)
+	if addError(&resp.Diagnostics, "Unable to get certificates", err) {
+		return
+	}
+
+	now := time.Now()
+	certModels := []SystemCertificateExpiringDataSourceModel{}
+	for _, cert := range *certs {
+		if !cert.ExpiresWithin(now, threshold) {
+			continue
+		}
+
+		var certModel SystemCertificateExpiringDataSourceModel
+		diags = certModel.SetFromValue(ctx, &cert, now)
+		resp.Diagnostics.Append(diags...)
+		certModels = append(certModels, certModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Certificates, diags = types.ListValueFrom(ctx, SystemCertificateExpiringDataSourceModel{}.GetAttrType(), certModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemCryptoModuleResource{}
+
+func NewSystemCryptoModuleResource() resource.Resource {
+	return &SystemCryptoModuleResource{}
+}
+
+type SystemCryptoModuleResource struct {
+	client *pfsense.Client
+}
+
+type SystemCryptoModuleResourceModel struct {
+	CryptoHardware  types.String `tfsdk:"crypto_hardware"`
+	ThermalHardware types.String `tfsdk:"thermal_hardware"`
+}
+
+func (r *SystemCryptoModuleResourceModel) SetFromValue(ctx context.Context, cm *pfsense.CryptoModule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.CryptoHardware = types.StringNull()
+	if cm.CryptoHardware != "" {
+		r.CryptoHardware = types.StringValue(cm.CryptoHardware)
+	}
+
+	r.ThermalHardware = types.StringNull()
+	if cm.ThermalHardware != "" {
+		r.ThermalHardware = types.StringValue(cm.ThermalHardware)
+	}
+
+	return diags
+}
+
+func (r SystemCryptoModuleResourceModel) Value(ctx context.Context) (*pfsense.CryptoModule, diag.Diagnostics) {
+	var cm pfsense.CryptoModule
+	var err error
+	var diags diag.Diagnostics
+
+	if !r.CryptoHardware.IsNull() {
+		err = cm.SetCryptoHardware(r.CryptoHardware.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("crypto_hardware"),
+				"Crypto hardware cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	if !r.ThermalHardware.IsNull() {
+		err = cm.SetThermalHardware(r.ThermalHardware.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("thermal_hardware"),
+				"Thermal hardware cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &cm, diags
+}
+
+func (r *SystemCryptoModuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_crypto_module", req.ProviderTypeName)
+}
+
+func (r *SystemCryptoModuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Cryptographic hardware acceleration and thermal sensor modules. Modules are validated against those offered by the system. Do not use together with 'pfsense_system_advanced_misc', which manages the same settings. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[Cryptographic hardware acceleration and thermal sensor modules](https://docs.netgate.com/pfsense/en/latest/config/advanced-misc.html#cryptographic-thermal-hardware). Modules are validated against those offered by the system. Do not use together with `pfsense_system_advanced_misc`, which manages the same settings. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"crypto_hardware": schema.StringAttribute{
+				Description:         fmt.Sprintf("Cryptographic accelerator module, options: '%s'. Unset for none.", strings.Join(pfsense.AdvancedMiscCryptoHardwares(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Cryptographic accelerator module, options: `%s`. Unset for none.", strings.Join(pfsense.AdvancedMiscCryptoHardwares(), "`, `")),
+				Optional:            true,
+			},
+			"thermal_hardware": schema.StringAttribute{
+				Description:         fmt.Sprintf("Thermal sensor module, options: '%s'. Unset for none.", strings.Join(pfsense.AdvancedMiscThermalHardwares(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Thermal sensor module, options: `%s`. Unset for none.", strings.Join(pfsense.AdvancedMiscThermalHardwares(), "`, `")),
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *SystemCryptoModuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemCryptoModuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemCryptoModuleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cmReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm, err := r.client.UpdateSystemCryptoModule(ctx, *cmReq)
+	if addError(&resp.Diagnostics, "Error creating crypto module", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cm)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCryptoModuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemCryptoModuleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm, err := r.client.GetSystemCryptoModule(ctx)
+	if addError(&resp.Diagnostics, "Error reading crypto module", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cm)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCryptoModuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemCryptoModuleResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cmReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cm, err := r.client.UpdateSystemCryptoModule(ctx, *cmReq)
+	if addError(&resp.Diagnostics, "Error updating crypto module", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, cm)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemCryptoModuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSystemCryptoModuleResource selects AES-NI, which the test system is expected to offer, then clears it again
+// as destroying leaves the settings unchanged.
+func TestAccSystemCryptoModuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_crypto_module" "test" {
+  crypto_hardware = "aesni"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_crypto_module.test", "crypto_hardware", "aesni"),
+					resource.TestCheckNoResourceAttr("pfsense_system_crypto_module.test", "thermal_hardware"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_crypto_module" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("pfsense_system_crypto_module.test", "crypto_hardware"),
+				),
+			},
+		},
+	})
+}
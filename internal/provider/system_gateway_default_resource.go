@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemGatewayDefaultResource{}
+
+func NewSystemGatewayDefaultResource() resource.Resource {
+	return &SystemGatewayDefaultResource{}
+}
+
+type SystemGatewayDefaultResource struct {
+	client *pfsense.Client
+}
+
+type SystemGatewayDefaultResourceModel struct {
+	IPv4  types.String `tfsdk:"ipv4"`
+	IPv6  types.String `tfsdk:"ipv6"`
+	Apply types.Bool   `tfsdk:"apply"`
+}
+
+func (r *SystemGatewayDefaultResourceModel) SetFromValue(ctx context.Context, dgw *pfsense.DefaultGateway) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.IPv4 = types.StringValue(dgw.IPv4)
+	r.IPv6 = types.StringValue(dgw.IPv6)
+
+	return diags
+}
+
+func (r SystemGatewayDefaultResourceModel) Value(ctx context.Context) (*pfsense.DefaultGateway, diag.Diagnostics) {
+	var dgw pfsense.DefaultGateway
+	var err error
+	var diags diag.Diagnostics
+
+	err = dgw.SetIPv4(r.IPv4.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ipv4"),
+			"IPv4 default gateway cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = dgw.SetIPv6(r.IPv6.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ipv6"),
+			"IPv6 default gateway cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &dgw, diags
+}
+
+func (r *SystemGatewayDefaultResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_gateway_default", req.ProviderTypeName)
+}
+
+func (r *SystemGatewayDefaultResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Default IPv4 and IPv6 gateway selection. Destroying the resource leaves the selection unchanged.",
+		MarkdownDescription: "Default IPv4 and IPv6 [gateway](https://docs.netgate.com/pfsense/en/latest/routing/gateway-default.html) selection. Destroying the resource leaves the selection unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"ipv4": schema.StringAttribute{
+				Description:         fmt.Sprintf("Name of IPv4 gateway or gateway group, '%s' or '%s', defaults to '%s'.", pfsense.DefaultGatewayAutomatic, pfsense.DefaultGatewayNone, pfsense.DefaultGatewayAutomatic),
+				MarkdownDescription: fmt.Sprintf("Name of IPv4 gateway or gateway group, `%s` or `%s`, defaults to `%s`.", pfsense.DefaultGatewayAutomatic, pfsense.DefaultGatewayNone, pfsense.DefaultGatewayAutomatic),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(pfsense.DefaultGatewayAutomatic),
+			},
+			"ipv6": schema.StringAttribute{
+				Description:         fmt.Sprintf("Name of IPv6 gateway or gateway group, '%s' or '%s', defaults to '%s'.", pfsense.DefaultGatewayAutomatic, pfsense.DefaultGatewayNone, pfsense.DefaultGatewayAutomatic),
+				MarkdownDescription: fmt.Sprintf("Name of IPv6 gateway or gateway group, `%s` or `%s`, defaults to `%s`.", pfsense.DefaultGatewayAutomatic, pfsense.DefaultGatewayNone, pfsense.DefaultGatewayAutomatic),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString(pfsense.DefaultGatewayAutomatic),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemGatewayDefaultResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemGatewayDefaultResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemGatewayDefaultResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dgwReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dgw, err := r.client.UpdateDefaultGateway(ctx, *dgwReq)
+	if addError(&resp.Diagnostics, "Error creating default gateway", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, dgw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemGatewayChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying default gateway", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemGatewayDefaultResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemGatewayDefaultResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dgw, err := r.client.GetDefaultGateway(ctx)
+	if addError(&resp.Diagnostics, "Error reading default gateway", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, dgw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGatewayDefaultResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemGatewayDefaultResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dgwReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dgw, err := r.client.UpdateDefaultGateway(ctx, *dgwReq)
+	if addError(&resp.Diagnostics, "Error updating default gateway", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, dgw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemGatewayChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying default gateway", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemGatewayDefaultResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemGatewayResource{}
+var _ resource.ResourceWithImportState = &SystemGatewayResource{}
+
+func NewSystemGatewayResource() resource.Resource {
+	return &SystemGatewayResource{}
+}
+
+type SystemGatewayResource struct {
+	client *pfsense.Client
+}
+
+type SystemGatewayResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Interface   types.String `tfsdk:"interface"`
+	Address     types.String `tfsdk:"address"`
+	IPProtocol  types.String `tfsdk:"ip_protocol"`
+	Monitor     types.String `tfsdk:"monitor"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Default     types.Bool   `tfsdk:"default"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+func (r *SystemGatewayResourceModel) SetFromValue(ctx context.Context, gw *pfsense.Gateway) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(gw.Name)
+	r.Interface = types.StringValue(gw.Interface)
+	r.Address = types.StringValue(gw.Address)
+	r.IPProtocol = types.StringValue(gw.IPProtocol)
+	r.Monitor = types.StringValue(gw.Monitor)
+	r.Weight = types.Int64Value(int64(gw.Weight))
+
+	if gw.Description != "" {
+		r.Description = types.StringValue(gw.Description)
+	}
+
+	r.Disabled = types.BoolValue(gw.Disabled)
+	r.Default = types.BoolValue(gw.Default)
+
+	return diags
+}
+
+func (r SystemGatewayResourceModel) Value(ctx context.Context) (*pfsense.Gateway, diag.Diagnostics) {
+	var gw pfsense.Gateway
+	var err error
+	var diags diag.Diagnostics
+
+	err = gw.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = gw.SetInterface(r.Interface.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("interface"),
+			"Interface cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = gw.SetIPProtocol(r.IPProtocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ip_protocol"),
+			"IP protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = gw.SetAddress(r.Address.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("address"),
+			"Address cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Monitor.IsNull() && !r.Monitor.IsUnknown() {
+		err = gw.SetMonitor(r.Monitor.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("monitor"),
+				"Monitor cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = gw.SetWeight(int(r.Weight.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("weight"),
+			"Weight cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = gw.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = gw.SetDisabled(r.Disabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !diags.HasError() {
+		err = gw.Validate()
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ip_protocol"),
+				"IP protocol does not match addresses",
+				err.Error(),
+			)
+		}
+	}
+
+	return &gw, diags
+}
+
+func (r *SystemGatewayResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_gateway", req.ProviderTypeName)
+}
+
+func (r *SystemGatewayResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Gateway, a system through which other networks are reachable. Referenced by static routes, gateway groups, and firewall rules.",
+		MarkdownDescription: "[Gateway](https://docs.netgate.com/pfsense/en/latest/routing/gateway-configure.html), a system through which other networks are reachable. Referenced by static routes, gateway groups, and firewall rules.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of gateway.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description:         "Interface the gateway is reachable through (e.g. 'wan', 'lan', 'opt1').",
+				MarkdownDescription: "Interface the gateway is reachable through (e.g. `wan`, `lan`, `opt1`).",
+				Required:            true,
+			},
+			"address": schema.StringAttribute{
+				Description: "IP address of gateway.",
+				Required:    true,
+			},
+			"ip_protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("Address family of gateway, options: '%s', defaults to 'inet'.", strings.Join(pfsense.GatewayIPProtocols(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Address family of gateway, options: `%s`, defaults to `inet`.", strings.Join(pfsense.GatewayIPProtocols(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("inet"),
+			},
+			"monitor": schema.StringAttribute{
+				Description: "IP address used to monitor the gateway, defaults to the gateway address.",
+				Computed:    true,
+				Optional:    true,
+			},
+			"weight": schema.Int64Attribute{
+				Description:         "Weight for gateway groups (1-30), defaults to '1'.",
+				MarkdownDescription: "Weight for gateway groups (1-30), defaults to `1`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable this gateway without removing it, defaults to 'false'.",
+				MarkdownDescription: "Disable this gateway without removing it, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default": schema.BoolAttribute{
+				Description:         "Gateway is the default gateway of its address family, see 'pfsense_system_gateway_default'.",
+				MarkdownDescription: "Gateway is the default gateway of its address family, see `pfsense_system_gateway_default`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemGatewayResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemGatewayResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gwReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gw, err := r.client.CreateGateway(ctx, *gwReq)
+	if addError(&resp.Diagnostics, "Error creating gateway", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, gw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemGatewayChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying gateway", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemGatewayResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gw, err := r.client.GetGateway(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading gateway", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, gw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemGatewayResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gwReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gw, err := r.client.UpdateGateway(ctx, *gwReq)
+	if addError(&resp.Diagnostics, "Error updating gateway", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, gw)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemGatewayChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying gateway", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemGatewayResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGateway(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting gateway", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemGatewayChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying gateway", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
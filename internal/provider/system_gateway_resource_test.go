@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSystemGatewayResource creates an IPv4 gateway on the LAN interface, which is expected to use the default
+// 192.168.1.1/24 address, and lists it through the gateways data source.
+func TestAccSystemGatewayResource(t *testing.T) {
+	config := testAccProviderConfig() + `
+resource "pfsense_system_gateway" "test" {
+  name        = "TF_ACC_LAN"
+  interface   = "lan"
+  address     = "192.168.1.254"
+  monitor     = "192.168.1.253"
+  weight      = 2
+  description = "acceptance test"
+}
+
+data "pfsense_system_gateways" "test" {
+  depends_on = [pfsense_system_gateway.test]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_gateway.test", "ip_protocol", "inet"),
+					resource.TestCheckResourceAttr("pfsense_system_gateway.test", "address", "192.168.1.254"),
+					resource.TestCheckResourceAttr("pfsense_system_gateway.test", "default", "false"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.pfsense_system_gateways.test", "all.*", map[string]string{
+						"name":        "TF_ACC_LAN",
+						"interface":   "lan",
+						"address":     "192.168.1.254",
+						"ip_protocol": "inet",
+						"monitor":     "192.168.1.253",
+						"weight":      "2",
+						"description": "acceptance test",
+					}),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_system_gateway.test",
+				ImportState:                          true,
+				ImportStateId:                        "TF_ACC_LAN",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"apply"},
+			},
+		},
+	})
+}
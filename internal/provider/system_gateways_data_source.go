@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &SystemGatewaysDataSource{}
+	_ datasource.DataSourceWithConfigure = &SystemGatewaysDataSource{}
+)
+
+func NewSystemGatewaysDataSource() datasource.DataSource {
+	return &SystemGatewaysDataSource{}
+}
+
+type SystemGatewaysDataSource struct {
+	client *pfsense.Client
+}
+
+type SystemGatewaysDataSourceModel struct {
+	All types.List `tfsdk:"all"`
+}
+
+type SystemGatewayDataSourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Interface   types.String `tfsdk:"interface"`
+	Address     types.String `tfsdk:"address"`
+	IPProtocol  types.String `tfsdk:"ip_protocol"`
+	Monitor     types.String `tfsdk:"monitor"`
+	Weight      types.Int64  `tfsdk:"weight"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Default     types.Bool   `tfsdk:"default"`
+}
+
+func (d SystemGatewayDataSourceModel) GetAttrType() attr.Type {
+	return types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"interface":   types.StringType,
+		"address":     types.StringType,
+		"ip_protocol": types.StringType,
+		"monitor":     types.StringType,
+		"weight":      types.Int64Type,
+		"description": types.StringType,
+		"disabled":    types.BoolType,
+		"default":     types.BoolType,
+	}}
+}
+
+func (d *SystemGatewayDataSourceModel) SetFromValue(ctx context.Context, gw *pfsense.Gateway) diag.Diagnostics {
+	d.Name = types.StringValue(gw.Name)
+	d.Interface = types.StringValue(gw.Interface)
+	d.Address = types.StringValue(gw.Address)
+	d.IPProtocol = types.StringValue(gw.IPProtocol)
+
+	if gw.Monitor != "" {
+		d.Monitor = types.StringValue(gw.Monitor)
+	}
+
+	d.Weight = types.Int64Value(int64(gw.Weight))
+
+	if gw.Description != "" {
+		d.Description = types.StringValue(gw.Description)
+	}
+
+	d.Disabled = types.BoolValue(gw.Disabled)
+	d.Default = types.BoolValue(gw.Default)
+
+	return nil
+}
+
+func (d *SystemGatewaysDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_gateways", req.ProviderTypeName)
+}
+
+func (d *SystemGatewaysDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves all gateways, including dynamic gateways provided by DHCP, PPP, etc.",
+		MarkdownDescription: "Retrieves all [gateways](https://docs.netgate.com/pfsense/en/latest/routing/gateway-configure.html), including dynamic gateways provided by DHCP, PPP, etc.",
+		Attributes: map[string]schema.Attribute{
+			"all": schema.ListNestedAttribute{
+				Description: "All gateways.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of gateway.",
+							Computed:    true,
+						},
+						"interface": schema.StringAttribute{
+							Description: "Interface the gateway is reachable through.",
+							Computed:    true,
+						},
+						"address": schema.StringAttribute{
+							Description:         "IP address of gateway, 'dynamic' when not yet known.",
+							MarkdownDescription: "IP address of gateway, `dynamic` when not yet known.",
+							Computed:            true,
+						},
+						"ip_protocol": schema.StringAttribute{
+							Description: "Address family of gateway.",
+							Computed:    true,
+						},
+						"monitor": schema.StringAttribute{
+							Description: "IP address used to monitor the gateway.",
+							Computed:    true,
+						},
+						"weight": schema.Int64Attribute{
+							Description: "Weight for gateway groups.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "For administrative reference (not parsed).",
+							Computed:    true,
+						},
+						"disabled": schema.BoolAttribute{
+							Description: "Gateway is disabled.",
+							Computed:    true,
+						},
+						"default": schema.BoolAttribute{
+							Description: "Gateway is the default gateway of its address family.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemGatewaysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemGatewaysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemGatewaysDataSourceModel
+	var diags diag.Diagnostics
+
+	gws, err := d.client.GetGateways(ctx)
+	if addError(&resp.Diagnostics, "Unable to get gateways", err) {
+		return
+	}
+
+	gwModels := []SystemGatewayDataSourceModel{}
+	for _, gw := range *gws {
+		var gwModel SystemGatewayDataSourceModel
+		diags = gwModel.SetFromValue(ctx, &gw)
+		resp.Diagnostics.Append(diags...)
+		gwModels = append(gwModels, gwModel)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.All, diags = types.ListValueFrom(ctx, SystemGatewayDataSourceModel{}.GetAttrType(), gwModels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemGroupResource{}
+var _ resource.ResourceWithImportState = &SystemGroupResource{}
+
+func NewSystemGroupResource() resource.Resource {
+	return &SystemGroupResource{}
+}
+
+type SystemGroupResource struct {
+	client *pfsense.Client
+}
+
+type SystemGroupResourceModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Privileges  []types.String `tfsdk:"privileges"`
+}
+
+func (r *SystemGroupResourceModel) SetFromValue(ctx context.Context, group *pfsense.Group) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(group.Name)
+
+	r.Description = types.StringNull()
+	if group.Description != "" {
+		r.Description = types.StringValue(group.Description)
+	}
+
+	r.Privileges = unorderedStrings(r.Privileges, group.Privileges)
+
+	return diags
+}
+
+func (r SystemGroupResourceModel) Value(ctx context.Context) (*pfsense.Group, diag.Diagnostics) {
+	var group pfsense.Group
+	var err error
+	var diags diag.Diagnostics
+
+	err = group.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = group.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	privileges := []string{}
+	for _, privilege := range r.Privileges {
+		privileges = append(privileges, privilege.ValueString())
+	}
+
+	err = group.SetPrivileges(privileges)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("privileges"),
+			"Privileges cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &group, diags
+}
+
+func (r *SystemGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_group", req.ProviderTypeName)
+}
+
+func (r *SystemGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Local group, grants privileges to its members. Membership is managed with the 'groups' attribute of users.",
+		MarkdownDescription: "Local [group](https://docs.netgate.com/pfsense/en/latest/usermanager/groups.html), grants privileges to its members. Membership is managed with the `groups` attribute of users.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Name of group.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"privileges": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "Privileges assigned to members of the group (e.g. 'page-all', 'user-shell-access'), defaults to '[]'.",
+				MarkdownDescription: "Privileges assigned to members of the group (e.g. `page-all`, `user-shell-access`), defaults to `[]`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+func (r *SystemGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemGroupResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.CreateGroup(ctx, *groupReq)
+	if addError(&resp.Diagnostics, "Error creating group", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, group)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemGroupResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetGroup(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading group", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, group)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemGroupResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.UpdateGroup(ctx, *groupReq)
+	if addError(&resp.Diagnostics, "Error updating group", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, group)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGroup(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting group", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SystemGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
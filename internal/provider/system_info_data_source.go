@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &SystemInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &SystemInfoDataSource{}
+)
+
+func NewSystemInfoDataSource() datasource.DataSource {
+	return &SystemInfoDataSource{}
+}
+
+type SystemInfoDataSource struct {
+	client *pfsense.Client
+}
+
+type SystemInfoDataSourceModel struct {
+	Version          types.String    `tfsdk:"version"`
+	UptimeSeconds    types.Int64     `tfsdk:"uptime_seconds"`
+	CPUCount         types.Int64     `tfsdk:"cpu_count"`
+	CPUUsage         types.Float64   `tfsdk:"cpu_usage"`
+	MemoryTotalBytes types.Int64     `tfsdk:"memory_total_bytes"`
+	MemoryUsage      types.Float64   `tfsdk:"memory_usage"`
+	Temperature      types.Float64   `tfsdk:"temperature"`
+	DiskTotalBytes   types.Int64     `tfsdk:"disk_total_bytes"`
+	DiskUsage        types.Float64   `tfsdk:"disk_usage"`
+	LoadAverage      []types.Float64 `tfsdk:"load_average"`
+}
+
+func (d *SystemInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_info", req.ProviderTypeName)
+}
+
+func (d *SystemInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves system status information (version, uptime, CPU, memory, temperature and disk usage).",
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				Description: "Current pfSense system version.",
+				Computed:    true,
+			},
+			"uptime_seconds": schema.Int64Attribute{
+				Description: "Seconds since the system booted.",
+				Computed:    true,
+			},
+			"cpu_count": schema.Int64Attribute{
+				Description: "Number of CPUs.",
+				Computed:    true,
+			},
+			"cpu_usage": schema.Float64Attribute{
+				Description: "CPU usage percentage, sampled over half a second.",
+				Computed:    true,
+			},
+			"memory_total_bytes": schema.Int64Attribute{
+				Description: "Total memory in bytes.",
+				Computed:    true,
+			},
+			"memory_usage": schema.Float64Attribute{
+				Description: "Memory usage percentage, memory that is not inactive, cached or free.",
+				Computed:    true,
+			},
+			"temperature": schema.Float64Attribute{
+				Description:         "CPU or thermal zone temperature in degrees Celsius, null when no sensor is available (see 'thermal_hardware' of 'pfsense_system_advanced_misc').",
+				MarkdownDescription: "CPU or thermal zone temperature in degrees Celsius, null when no sensor is available (see `thermal_hardware` of `pfsense_system_advanced_misc`).",
+				Computed:            true,
+			},
+			"disk_total_bytes": schema.Int64Attribute{
+				Description: "Size of the root filesystem in bytes.",
+				Computed:    true,
+			},
+			"disk_usage": schema.Float64Attribute{
+				Description: "Root filesystem usage percentage.",
+				Computed:    true,
+			},
+			"load_average": schema.ListAttribute{
+				ElementType: types.Float64Type,
+				Description: "System load averages over the last 1, 5 and 15 minutes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SystemInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemInfoDataSourceModel
+
+	info, err := d.client.GetSystemInfo(ctx)
+	if addError(&resp.Diagnostics, "Unable to get system info", err) {
+		return
+	}
+
+	data.Version = types.StringValue(info.Version)
+	data.UptimeSeconds = types.Int64Value(info.UptimeSeconds)
+	data.CPUCount = types.Int64Value(int64(info.CPUCount))
+	data.CPUUsage = types.Float64Value(info.CPUUsage)
+	data.MemoryTotalBytes = types.Int64Value(info.MemoryTotalBytes)
+	data.MemoryUsage = types.Float64Value(info.MemoryUsage)
+	data.Temperature = types.Float64PointerValue(info.Temperature)
+	data.DiskTotalBytes = types.Int64Value(info.DiskTotalBytes)
+	data.DiskUsage = types.Float64Value(info.DiskUsage)
+
+	data.LoadAverage = []types.Float64{}
+	for _, load := range info.LoadAverage {
+		data.LoadAverage = append(data.LoadAverage, types.Float64Value(load))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemNTPResource{}
+
+func NewSystemNTPResource() resource.Resource {
+	return &SystemNTPResource{}
+}
+
+type SystemNTPResource struct {
+	client *pfsense.Client
+}
+
+type SystemNTPResourceModel struct {
+	TimeServers      []types.String `tfsdk:"time_servers"`
+	ListenInterfaces []types.String `tfsdk:"listen_interfaces"`
+	OrphanStratum    types.Int64    `tfsdk:"orphan_stratum"`
+	Timezone         types.String   `tfsdk:"timezone"`
+}
+
+func (r *SystemNTPResourceModel) SetFromValue(ctx context.Context, settings *pfsense.NTPSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.TimeServers = []types.String{}
+	for _, server := range settings.TimeServers {
+		r.TimeServers = append(r.TimeServers, types.StringValue(server))
+	}
+
+	r.ListenInterfaces = []types.String{}
+	for _, iface := range settings.ListenInterfaces {
+		r.ListenInterfaces = append(r.ListenInterfaces, types.StringValue(iface))
+	}
+
+	r.OrphanStratum = types.Int64Value(int64(settings.OrphanStratum))
+	r.Timezone = types.StringValue(settings.Timezone)
+
+	return diags
+}
+
+func (r SystemNTPResourceModel) Value(ctx context.Context) (*pfsense.NTPSettings, diag.Diagnostics) {
+	var settings pfsense.NTPSettings
+	var err error
+	var diags diag.Diagnostics
+
+	timeServers := []string{}
+	for _, server := range r.TimeServers {
+		timeServers = append(timeServers, server.ValueString())
+	}
+
+	err = settings.SetTimeServers(timeServers)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("time_servers"),
+			"Time servers cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	listenInterfaces := []string{}
+	for _, iface := range r.ListenInterfaces {
+		listenInterfaces = append(listenInterfaces, iface.ValueString())
+	}
+
+	err = settings.SetListenInterfaces(listenInterfaces)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("listen_interfaces"),
+			"Listen interfaces cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = settings.SetOrphanStratum(int(r.OrphanStratum.ValueInt64()))
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("orphan_stratum"),
+			"Orphan stratum cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = settings.SetTimezone(r.Timezone.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("timezone"),
+			"Timezone cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &settings, diags
+}
+
+func (r *SystemNTPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_ntp", req.ProviderTypeName)
+}
+
+func (r *SystemNTPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "NTP settings (time servers, listen interfaces, orphan mode) and the system timezone. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[NTP settings](https://docs.netgate.com/pfsense/en/latest/services/ntpd/server.html) (time servers, listen interfaces, orphan mode) and the system timezone. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"time_servers": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Hostnames or IP addresses of the time servers (or pools) to synchronize with. The prefer, noselect and pool flags of servers already configured are kept.",
+				Required:    true,
+			},
+			"listen_interfaces": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "Interfaces NTP listens on (e.g. 'lan', 'opt1'), defaults to '[]' (all interfaces).",
+				MarkdownDescription: "Interfaces NTP listens on (e.g. `lan`, `opt1`), defaults to `[]` (all interfaces).",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"orphan_stratum": schema.Int64Attribute{
+				Description:         "Stratum used in orphan mode, when no time server is reachable, defaults to '12'.",
+				MarkdownDescription: "Stratum used in orphan mode, when no time server is reachable, defaults to `12`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             int64default.StaticInt64(12),
+			},
+			"timezone": schema.StringAttribute{
+				Description:         "System timezone as a tz database name, defaults to 'Etc/UTC'.",
+				MarkdownDescription: "System timezone as a tz database name, defaults to `Etc/UTC`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("Etc/UTC"),
+			},
+		},
+	}
+}
+
+func (r *SystemNTPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemNTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemNTPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSystemNTPSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error creating NTP settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemNTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemNTPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSystemNTPSettings(ctx)
+	if addError(&resp.Diagnostics, "Error reading NTP settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemNTPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemNTPResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSystemNTPSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error updating NTP settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemNTPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
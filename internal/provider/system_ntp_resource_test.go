@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSystemNTPResourceModelValueAggregatesErrors(t *testing.T) {
+	model := SystemNTPResourceModel{
+		TimeServers:      []types.String{types.StringValue("-invalid-"), types.StringValue("pool.ntp.org"), types.StringValue("bad_host")},
+		ListenInterfaces: []types.String{types.StringValue("lan"), types.StringValue("opt 1")},
+		OrphanStratum:    types.Int64Value(16),
+		Timezone:         types.StringValue("Etc/UTC"),
+	}
+
+	_, diags := model.Value(context.Background())
+
+	details := map[string]string{}
+	for _, d := range diags.Errors() {
+		if d, ok := d.(diag.DiagnosticWithPath); ok {
+			details[d.Path().String()] = d.Detail()
+		}
+	}
+
+	if len(details) != 3 || diags.ErrorsCount() != 3 {
+		t.Fatalf("expected errors for 3 attributes, got %v", diags)
+	}
+
+	for _, attr := range []path.Path{path.Root("time_servers"), path.Root("listen_interfaces"), path.Root("orphan_stratum")} {
+		if _, ok := details[attr.String()]; !ok {
+			t.Errorf("expected error for attribute '%s'", attr)
+		}
+	}
+
+	// every invalid time server is reported, not only the first
+	for _, server := range []string{"-invalid-", "bad_host"} {
+		if !strings.Contains(details["time_servers"], server) {
+			t.Errorf("expected time servers error to mention '%s', got '%s'", server, details["time_servers"])
+		}
+	}
+}
+
+// TestAccSystemNTPResource changes the time servers and timezone, the last step restores the pfSense defaults as
+// destroying leaves the settings unchanged.
+func TestAccSystemNTPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_ntp" "test" {
+  time_servers = ["time.cloudflare.com", "0.pfsense.pool.ntp.org", "192.0.2.123"]
+  timezone     = "America/New_York"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_ntp.test", "time_servers.#", "3"),
+					resource.TestCheckResourceAttr("pfsense_system_ntp.test", "time_servers.0", "time.cloudflare.com"),
+					resource.TestCheckResourceAttr("pfsense_system_ntp.test", "time_servers.2", "192.0.2.123"),
+					resource.TestCheckResourceAttr("pfsense_system_ntp.test", "timezone", "America/New_York"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_ntp" "test" {
+  time_servers = ["0.pfsense.pool.ntp.org"]
+  timezone     = "Etc/UTC"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_ntp.test", "time_servers.#", "1"),
+					resource.TestCheckResourceAttr("pfsense_system_ntp.test", "timezone", "Etc/UTC"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemStaticRouteResource{}
+var _ resource.ResourceWithImportState = &SystemStaticRouteResource{}
+
+func NewSystemStaticRouteResource() resource.Resource {
+	return &SystemStaticRouteResource{}
+}
+
+type SystemStaticRouteResource struct {
+	client *pfsense.Client
+}
+
+type SystemStaticRouteResourceModel struct {
+	Network     types.String `tfsdk:"network"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Apply       types.Bool   `tfsdk:"apply"`
+}
+
+func (r *SystemStaticRouteResourceModel) SetFromValue(ctx context.Context, route *pfsense.StaticRoute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Network = types.StringValue(route.Network.String())
+	r.Gateway = types.StringValue(route.Gateway)
+
+	if route.Description != "" {
+		r.Description = types.StringValue(route.Description)
+	}
+
+	r.Disabled = types.BoolValue(route.Disabled)
+
+	return diags
+}
+
+func (r SystemStaticRouteResourceModel) Value(ctx context.Context) (*pfsense.StaticRoute, diag.Diagnostics) {
+	var route pfsense.StaticRoute
+	var err error
+	var diags diag.Diagnostics
+
+	err = route.SetNetwork(r.Network.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("network"),
+			"Network cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = route.SetGateway(r.Gateway.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("gateway"),
+			"Gateway cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = route.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = route.SetDisabled(r.Disabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &route, diags
+}
+
+func (r *SystemStaticRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_staticroute", req.ProviderTypeName)
+}
+
+func (r *SystemStaticRouteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Static route, routes traffic for a network through a gateway.",
+		MarkdownDescription: "[Static route](https://docs.netgate.com/pfsense/en/latest/routing/static.html), routes traffic for a network through a gateway.",
+		Attributes: map[string]schema.Attribute{
+			"network": schema.StringAttribute{
+				Description: "Destination network in CIDR notation, identifies the route.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"gateway": schema.StringAttribute{
+				Description: "Name of gateway or gateway group, must exist and match the address family of the network.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable this route without removing it, defaults to 'false'.",
+				MarkdownDescription: "Disable this route without removing it, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemStaticRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemStaticRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemStaticRouteResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routeReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := r.client.CreateStaticRoute(ctx, *routeReq)
+	if addError(&resp.Diagnostics, "Error creating static route", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, route)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyStaticRouteChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying static route", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemStaticRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemStaticRouteResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := netip.ParsePrefix(data.Network.ValueString())
+	if addError(&resp.Diagnostics, "Error reading static route", err) {
+		return
+	}
+
+	route, err := r.client.GetStaticRoute(ctx, network)
+	if addError(&resp.Diagnostics, "Error reading static route", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, route)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemStaticRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemStaticRouteResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	routeReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	route, err := r.client.UpdateStaticRoute(ctx, *routeReq)
+	if addError(&resp.Diagnostics, "Error updating static route", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, route)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyStaticRouteChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying static route", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemStaticRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemStaticRouteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := netip.ParsePrefix(data.Network.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting static route", err) {
+		return
+	}
+
+	err = r.client.DeleteStaticRoute(ctx, network)
+	if addError(&resp.Diagnostics, "Error deleting static route", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplyStaticRouteChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying static route", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemStaticRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("network"), req, resp)
+}
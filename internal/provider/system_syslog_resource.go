@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemSyslogResource{}
+
+func NewSystemSyslogResource() resource.Resource {
+	return &SystemSyslogResource{}
+}
+
+type SystemSyslogResource struct {
+	client *pfsense.Client
+}
+
+type SystemSyslogResourceModel struct {
+	EnableRemote  types.Bool     `tfsdk:"enable_remote"`
+	RemoteServers []types.String `tfsdk:"remote_servers"`
+	Categories    []types.String `tfsdk:"categories"`
+	IPProtocol    types.String   `tfsdk:"ip_protocol"`
+}
+
+func (r *SystemSyslogResourceModel) SetFromValue(ctx context.Context, settings *pfsense.SyslogSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.EnableRemote = types.BoolValue(settings.EnableRemote)
+
+	r.RemoteServers = []types.String{}
+	for _, server := range settings.RemoteServers {
+		r.RemoteServers = append(r.RemoteServers, types.StringValue(server))
+	}
+
+	r.Categories = unorderedStrings(r.Categories, settings.Categories)
+	r.IPProtocol = types.StringValue(settings.IPProtocol)
+
+	return diags
+}
+
+func (r SystemSyslogResourceModel) Value(ctx context.Context) (*pfsense.SyslogSettings, diag.Diagnostics) {
+	var settings pfsense.SyslogSettings
+	var err error
+	var diags diag.Diagnostics
+
+	err = settings.SetEnableRemote(r.EnableRemote.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("enable_remote"),
+			"Enable remote cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	remoteServers := []string{}
+	for _, server := range r.RemoteServers {
+		remoteServers = append(remoteServers, server.ValueString())
+	}
+
+	err = settings.SetRemoteServers(remoteServers)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("remote_servers"),
+			"Remote servers cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	categories := []string{}
+	for _, category := range r.Categories {
+		categories = append(categories, category.ValueString())
+	}
+
+	err = settings.SetCategories(categories)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("categories"),
+			"Categories cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = settings.SetIPProtocol(r.IPProtocol.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ip_protocol"),
+			"IP protocol cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &settings, diags
+}
+
+func (r *SystemSyslogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_syslog", req.ProviderTypeName)
+}
+
+func (r *SystemSyslogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Remote logging settings, sends log messages to remote syslog servers. Destroying the resource leaves the settings unchanged.",
+		MarkdownDescription: "[Remote logging settings](https://docs.netgate.com/pfsense/en/latest/monitoring/logs/remote.html), sends log messages to remote syslog servers. Destroying the resource leaves the settings unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"enable_remote": schema.BoolAttribute{
+				Description:         "Send log messages to remote syslog servers, defaults to 'false'.",
+				MarkdownDescription: "Send log messages to remote syslog servers, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"remote_servers": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "Up to three remote syslog servers, IP address with an optional port (e.g. '10.0.0.1:514'), defaults to '[]'.",
+				MarkdownDescription: "Up to three remote syslog servers, IP address with an optional port (e.g. `10.0.0.1:514`), defaults to `[]`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"categories": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         fmt.Sprintf("Log categories sent to the remote servers, options: '%s', defaults to '[]'.", strings.Join(pfsense.SyslogCategories(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Log categories sent to the remote servers, options: `%s`, defaults to `[]`.", strings.Join(pfsense.SyslogCategories(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"ip_protocol": schema.StringAttribute{
+				Description:         fmt.Sprintf("IP protocol used to reach the remote servers, options: '%s', defaults to 'ipv4'.", strings.Join(pfsense.SyslogIPProtocols(), "', '")),
+				MarkdownDescription: fmt.Sprintf("IP protocol used to reach the remote servers, options: `%s`, defaults to `ipv4`.", strings.Join(pfsense.SyslogIPProtocols(), "`, `")),
+				Computed:            true,
+				Optional:            true,
+				Default:             stringdefault.StaticString("ipv4"),
+			},
+		},
+	}
+}
+
+func (r *SystemSyslogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemSyslogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemSyslogResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSystemSyslogSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error creating syslog settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemSyslogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemSyslogResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.GetSystemSyslogSettings(ctx)
+	if addError(&resp.Diagnostics, "Error reading syslog settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemSyslogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemSyslogResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settingsReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings, err := r.client.UpdateSystemSyslogSettings(ctx, *settingsReq)
+	if addError(&resp.Diagnostics, "Error updating syslog settings", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, settings)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemSyslogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
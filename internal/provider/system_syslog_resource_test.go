@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSystemSyslogResource enables remote logging to two collectors, the last step disables remote logging as
+// destroying leaves the settings unchanged.
+func TestAccSystemSyslogResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_syslog" "test" {
+  enable_remote  = true
+  remote_servers = ["192.0.2.10:514", "192.0.2.11"]
+  categories     = ["system", "firewall", "auth"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "enable_remote", "true"),
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "remote_servers.#", "2"),
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "remote_servers.1", "192.0.2.11"),
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "categories.#", "3"),
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "categories.1", "firewall"),
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "ip_protocol", "ipv4"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_syslog" "test" {
+  enable_remote  = true
+  remote_servers = ["192.0.2.10:514", "192.0.2.11"]
+  categories     = ["system", "firewall", "auth"]
+}
+`,
+				PlanOnly: true,
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_syslog" "test" {
+  enable_remote = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "enable_remote", "false"),
+					resource.TestCheckResourceAttr("pfsense_system_syslog.test", "remote_servers.#", "0"),
+				),
+			},
+		},
+	})
+}
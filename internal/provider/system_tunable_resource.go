@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemTunableResource{}
+var _ resource.ResourceWithImportState = &SystemTunableResource{}
+
+func NewSystemTunableResource() resource.Resource {
+	return &SystemTunableResource{}
+}
+
+type SystemTunableResource struct {
+	client *pfsense.Client
+}
+
+type SystemTunableResourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	TunableValue types.String `tfsdk:"value"`
+	Description  types.String `tfsdk:"description"`
+	Apply        types.Bool   `tfsdk:"apply"`
+}
+
+func (r *SystemTunableResourceModel) SetFromValue(ctx context.Context, tunable *pfsense.Tunable) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(tunable.Name)
+	r.TunableValue = types.StringValue(tunable.Value)
+
+	if tunable.Description != "" {
+		r.Description = types.StringValue(tunable.Description)
+	}
+
+	return diags
+}
+
+func (r SystemTunableResourceModel) Value(ctx context.Context) (*pfsense.Tunable, diag.Diagnostics) {
+	var tunable pfsense.Tunable
+	var err error
+	var diags diag.Diagnostics
+
+	err = tunable.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = tunable.SetValue(r.TunableValue.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("value"),
+			"Value cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.Description.IsNull() {
+		err = tunable.SetDescription(r.Description.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("description"),
+				"Description cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	return &tunable, diags
+}
+
+func (r *SystemTunableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_tunable", req.ProviderTypeName)
+}
+
+func (r *SystemTunableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "System tunable, sets the value of a single sysctl tunable.",
+		MarkdownDescription: "System [tunable](https://docs.netgate.com/pfsense/en/latest/config/advanced-tunables.html), sets the value of a single sysctl tunable.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:         "Name of the sysctl tunable (e.g. 'net.inet.tcp.tso').",
+				MarkdownDescription: "Name of the sysctl tunable (e.g. `net.inet.tcp.tso`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the tunable, stored as a string.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "For administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemTunableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemTunableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemTunableResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunableReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunable, err := r.client.CreateSystemTunable(ctx, *tunableReq)
+	if addError(&resp.Diagnostics, "Error creating tunable", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, tunable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemTunableChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying tunable", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemTunableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemTunableResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunable, err := r.client.GetSystemTunable(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading tunable", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, tunable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemTunableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemTunableResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunableReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunable, err := r.client.UpdateSystemTunable(ctx, *tunableReq)
+	if addError(&resp.Diagnostics, "Error updating tunable", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, tunable)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemTunableChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying tunable", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemTunableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemTunableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSystemTunable(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting tunable", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemTunableChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying tunable", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemTunableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
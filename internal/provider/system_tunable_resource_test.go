@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSystemTunableResource creates a tunable that is not set by default, updates its value and imports it by
+// name. The tunable is removed when the test is destroyed.
+func TestAccSystemTunableResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_tunable" "test" {
+  name        = "net.inet.tcp.keepinit"
+  value       = "75000"
+  description = "tf acc test"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_tunable.test", "value", "75000"),
+					resource.TestCheckResourceAttr("pfsense_system_tunable.test", "description", "tf acc test"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_tunable" "test" {
+  name        = "net.inet.tcp.keepinit"
+  value       = "60000"
+  description = "tf acc test updated"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_tunable.test", "value", "60000"),
+					resource.TestCheckResourceAttr("pfsense_system_tunable.test", "description", "tf acc test updated"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_system_tunable.test",
+				ImportState:                          true,
+				ImportStateId:                        "net.inet.tcp.keepinit",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"apply"},
+			},
+		},
+	})
+}
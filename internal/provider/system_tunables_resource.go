@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemTunablesResource{}
+
+func NewSystemTunablesResource() resource.Resource {
+	return &SystemTunablesResource{}
+}
+
+type SystemTunablesResource struct {
+	client *pfsense.Client
+}
+
+type SystemTunablesResourceModel struct {
+	Tunables types.Map  `tfsdk:"tunables"`
+	Apply    types.Bool `tfsdk:"apply"`
+}
+
+func (r SystemTunablesResourceModel) names(ctx context.Context) ([]string, diag.Diagnostics) {
+	var values map[string]string
+	diags := r.Tunables.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names, diags
+}
+
+func (r *SystemTunablesResourceModel) SetFromValue(ctx context.Context, tunables *pfsense.Tunables, names []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values := map[string]string{}
+	for _, name := range names {
+		tunable, err := tunables.GetByName(name)
+		if err != nil {
+			continue
+		}
+		values[tunable.Name] = tunable.Value
+	}
+
+	r.Tunables, diags = types.MapValueFrom(ctx, types.StringType, values)
+
+	return diags
+}
+
+func (r SystemTunablesResourceModel) Value(ctx context.Context) (pfsense.Tunables, diag.Diagnostics) {
+	var tunables pfsense.Tunables
+	var err error
+
+	var values map[string]string
+	diags := r.Tunables.ElementsAs(ctx, &values, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var tunable pfsense.Tunable
+
+		err = tunable.SetName(name)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tunables").AtMapKey(name),
+				"Tunable name cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = tunable.SetValue(values[name])
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("tunables").AtMapKey(name),
+				"Tunable value cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		tunables = append(tunables, tunable)
+	}
+
+	return tunables, diags
+}
+
+func (r *SystemTunablesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_tunables", req.ProviderTypeName)
+}
+
+func (r *SystemTunablesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "System tunables, manages a set of sysctl tunables and their values in a single write. Tunables not included are left untouched, existing tunables with the same name are adopted.",
+		MarkdownDescription: "System [tunables](https://docs.netgate.com/pfsense/en/latest/config/advanced-tunables.html), manages a set of sysctl tunables and their values in a single write. Tunables not included are left untouched, existing tunables with the same name are adopted.",
+		Attributes: map[string]schema.Attribute{
+			"tunables": schema.MapAttribute{
+				Description: "Map of tunable name to value.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"apply": schema.BoolAttribute{
+				Description:         "Apply change, defaults to 'true'.",
+				MarkdownDescription: "Apply change, defaults to `true`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *SystemTunablesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemTunablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemTunablesResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunablesReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, d := data.names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunables, err := r.client.UpdateSystemTunables(ctx, tunablesReq, nil)
+	if addError(&resp.Diagnostics, "Error creating tunables", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, tunables, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemTunableChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying tunables", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemTunablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemTunablesResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, d := data.names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunables, err := r.client.GetSystemTunables(ctx)
+	if addError(&resp.Diagnostics, "Error reading tunables", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, tunables, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemTunablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemTunablesResourceModel
+	var state *SystemTunablesResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tunablesReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, d := data.names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prevNames, d := state.names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var remove []string
+	for _, name := range prevNames {
+		if _, err := tunablesReq.GetByName(name); err != nil {
+			remove = append(remove, name)
+		}
+	}
+
+	tunables, err := r.client.UpdateSystemTunables(ctx, tunablesReq, remove)
+	if addError(&resp.Diagnostics, "Error updating tunables", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, tunables, names)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemTunableChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying tunables", err) {
+			return
+		}
+	}
+}
+
+func (r *SystemTunablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemTunablesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names, d := data.names(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateSystemTunables(ctx, nil, names)
+	if addError(&resp.Diagnostics, "Error deleting tunables", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	if data.Apply.ValueBool() {
+		err = r.client.ApplySystemTunableChanges(ctx)
+		if addError(&resp.Diagnostics, "Error applying tunables", err) {
+			return
+		}
+	}
+}
@@ -0,0 +1,648 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemUserAuthenticationServerResource{}
+var _ resource.ResourceWithImportState = &SystemUserAuthenticationServerResource{}
+
+func NewSystemUserAuthenticationServerResource() resource.Resource {
+	return &SystemUserAuthenticationServerResource{}
+}
+
+type SystemUserAuthenticationServerResource struct {
+	client *pfsense.Client
+}
+
+type SystemUserAuthenticationServerResourceModel struct {
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Host   types.String `tfsdk:"host"`
+	LDAP   types.Object `tfsdk:"ldap"`
+	RADIUS types.Object `tfsdk:"radius"`
+}
+
+type SystemUserAuthenticationServerLDAPResourceModel struct {
+	Port                 types.Int64  `tfsdk:"port"`
+	Transport            types.String `tfsdk:"transport"`
+	Scope                types.String `tfsdk:"scope"`
+	BaseDN               types.String `tfsdk:"base_dn"`
+	AuthContainers       types.String `tfsdk:"auth_containers"`
+	BindDN               types.String `tfsdk:"bind_dn"`
+	BindPassword         types.String `tfsdk:"bind_password"`
+	UserNamingAttribute  types.String `tfsdk:"user_naming_attribute"`
+	GroupNamingAttribute types.String `tfsdk:"group_naming_attribute"`
+	GroupMemberAttribute types.String `tfsdk:"group_member_attribute"`
+	Timeout              types.Int64  `tfsdk:"timeout"`
+}
+
+func (r SystemUserAuthenticationServerLDAPResourceModel) GetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"port":                   types.Int64Type,
+		"transport":              types.StringType,
+		"scope":                  types.StringType,
+		"base_dn":                types.StringType,
+		"auth_containers":        types.StringType,
+		"bind_dn":                types.StringType,
+		"bind_password":          types.StringType,
+		"user_naming_attribute":  types.StringType,
+		"group_naming_attribute": types.StringType,
+		"group_member_attribute": types.StringType,
+		"timeout":                types.Int64Type,
+	}
+}
+
+type SystemUserAuthenticationServerRADIUSResourceModel struct {
+	Secret             types.String `tfsdk:"secret"`
+	Protocol           types.String `tfsdk:"protocol"`
+	AuthenticationPort types.Int64  `tfsdk:"authentication_port"`
+	AccountingPort     types.Int64  `tfsdk:"accounting_port"`
+	Timeout            types.Int64  `tfsdk:"timeout"`
+	NASIPAttribute     types.String `tfsdk:"nas_ip_attribute"`
+}
+
+func (r SystemUserAuthenticationServerRADIUSResourceModel) GetAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"secret":              types.StringType,
+		"protocol":            types.StringType,
+		"authentication_port": types.Int64Type,
+		"accounting_port":     types.Int64Type,
+		"timeout":             types.Int64Type,
+		"nas_ip_attribute":    types.StringType,
+	}
+}
+
+func (r *SystemUserAuthenticationServerResourceModel) SetFromValue(ctx context.Context, authServer *pfsense.AuthServer) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(authServer.Name)
+	r.Type = types.StringValue(authServer.Type)
+	r.Host = types.StringValue(authServer.Host)
+
+	r.LDAP = types.ObjectNull(SystemUserAuthenticationServerLDAPResourceModel{}.GetAttrTypes())
+	if authServer.LDAP != nil {
+		ldapModel := SystemUserAuthenticationServerLDAPResourceModel{
+			Port:                 types.Int64Value(int64(authServer.LDAP.Port)),
+			Transport:            types.StringValue(authServer.LDAP.Transport),
+			Scope:                types.StringValue(authServer.LDAP.Scope),
+			BaseDN:               types.StringValue(authServer.LDAP.BaseDN),
+			AuthContainers:       types.StringValue(authServer.LDAP.AuthContainers),
+			UserNamingAttribute:  types.StringValue(authServer.LDAP.UserAttribute),
+			GroupNamingAttribute: types.StringValue(authServer.LDAP.GroupAttribute),
+			GroupMemberAttribute: types.StringValue(authServer.LDAP.MemberAttribute),
+			Timeout:              types.Int64Value(int64(authServer.LDAP.Timeout)),
+		}
+
+		if authServer.LDAP.BindDN != "" {
+			ldapModel.BindDN = types.StringValue(authServer.LDAP.BindDN)
+		}
+
+		if authServer.LDAP.BindPassword != "" {
+			ldapModel.BindPassword = types.StringValue(authServer.LDAP.BindPassword)
+		}
+
+		r.LDAP, diags = types.ObjectValueFrom(ctx, SystemUserAuthenticationServerLDAPResourceModel{}.GetAttrTypes(), ldapModel)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	r.RADIUS = types.ObjectNull(SystemUserAuthenticationServerRADIUSResourceModel{}.GetAttrTypes())
+	if authServer.RADIUS != nil {
+		radiusModel := SystemUserAuthenticationServerRADIUSResourceModel{
+			Secret:             types.StringValue(authServer.RADIUS.Secret),
+			Protocol:           types.StringValue(authServer.RADIUS.Protocol),
+			AuthenticationPort: types.Int64Value(int64(authServer.RADIUS.AuthPort)),
+			Timeout:            types.Int64Value(int64(authServer.RADIUS.Timeout)),
+			NASIPAttribute:     types.StringValue(authServer.RADIUS.NASIPAttribute),
+		}
+
+		if authServer.RADIUS.AcctPort != 0 {
+			radiusModel.AccountingPort = types.Int64Value(int64(authServer.RADIUS.AcctPort))
+		}
+
+		r.RADIUS, diags = types.ObjectValueFrom(ctx, SystemUserAuthenticationServerRADIUSResourceModel{}.GetAttrTypes(), radiusModel)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r SystemUserAuthenticationServerResourceModel) Value(ctx context.Context) (*pfsense.AuthServer, diag.Diagnostics) {
+	var authServer pfsense.AuthServer
+	var err error
+	var diags diag.Diagnostics
+
+	err = authServer.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = authServer.SetType(r.Type.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Type cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = authServer.SetHost(r.Host.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Host cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	ldapSet, radiusSet := !r.LDAP.IsNull(), !r.RADIUS.IsNull()
+	if ldapSet != (authServer.Type == "ldap") || radiusSet != (authServer.Type == "radius") {
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Type does not match configuration",
+			fmt.Sprintf("Exactly one of 'ldap' or 'radius' must be set, matching type '%s'.", authServer.Type),
+		)
+		return nil, diags
+	}
+
+	if ldapSet {
+		var ldapModel SystemUserAuthenticationServerLDAPResourceModel
+		var ldap pfsense.AuthServerLDAP
+
+		diags.Append(r.LDAP.As(ctx, &ldapModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		err = ldap.SetPort(int(ldapModel.Port.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("port"),
+				"LDAP port cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetTransport(ldapModel.Transport.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("transport"),
+				"LDAP transport cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetScope(ldapModel.Scope.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("scope"),
+				"LDAP scope cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetBaseDN(ldapModel.BaseDN.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("base_dn"),
+				"LDAP base DN cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetAuthContainers(ldapModel.AuthContainers.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("auth_containers"),
+				"LDAP authentication containers cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetBindDN(ldapModel.BindDN.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("bind_dn"),
+				"LDAP bind DN cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetBindPassword(ldapModel.BindPassword.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("bind_password"),
+				"LDAP bind password cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetUserAttribute(ldapModel.UserNamingAttribute.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("user_naming_attribute"),
+				"LDAP user naming attribute cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetGroupAttribute(ldapModel.GroupNamingAttribute.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("group_naming_attribute"),
+				"LDAP group naming attribute cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetMemberAttribute(ldapModel.GroupMemberAttribute.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("group_member_attribute"),
+				"LDAP group member attribute cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = ldap.SetTimeout(int(ldapModel.Timeout.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ldap").AtName("timeout"),
+				"LDAP timeout cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		authServer.LDAP = &ldap
+	}
+
+	if radiusSet {
+		var radiusModel SystemUserAuthenticationServerRADIUSResourceModel
+		var radius pfsense.AuthServerRADIUS
+
+		diags.Append(r.RADIUS.As(ctx, &radiusModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		err = radius.SetSecret(radiusModel.Secret.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("radius").AtName("secret"),
+				"RADIUS secret cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = radius.SetProtocol(radiusModel.Protocol.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("radius").AtName("protocol"),
+				"RADIUS protocol cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = radius.SetAuthPort(int(radiusModel.AuthenticationPort.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("radius").AtName("authentication_port"),
+				"RADIUS authentication port cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = radius.SetAcctPort(int(radiusModel.AccountingPort.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("radius").AtName("accounting_port"),
+				"RADIUS accounting port cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = radius.SetTimeout(int(radiusModel.Timeout.ValueInt64()))
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("radius").AtName("timeout"),
+				"RADIUS timeout cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		err = radius.SetNASIPAttribute(radiusModel.NASIPAttribute.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("radius").AtName("nas_ip_attribute"),
+				"RADIUS NAS IP attribute cannot be parsed",
+				err.Error(),
+			)
+		}
+
+		authServer.RADIUS = &radius
+	}
+
+	return &authServer, diags
+}
+
+func (r *SystemUserAuthenticationServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_user_authentication_server", req.ProviderTypeName)
+}
+
+func (r *SystemUserAuthenticationServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "User authentication server, an LDAP or RADIUS server used to authenticate users of the webConfigurator, VPNs, and other services.",
+		MarkdownDescription: "User [authentication server](https://docs.netgate.com/pfsense/en/latest/usermanager/authservers.html), an LDAP or RADIUS server used to authenticate users of the webConfigurator, VPNs, and other services.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Descriptive name of authentication server.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description:         fmt.Sprintf("Type of authentication server, options: '%s'.", strings.Join(pfsense.AuthServerTypes(), "', '")),
+				MarkdownDescription: fmt.Sprintf("Type of authentication server, options: `%s`.", strings.Join(pfsense.AuthServerTypes(), "`, `")),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Description: "Hostname or IP address of authentication server.",
+				Required:    true,
+			},
+			"ldap": schema.SingleNestedAttribute{
+				Description:         "LDAP server settings, required when type is 'ldap'.",
+				MarkdownDescription: "LDAP server settings, required when type is `ldap`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"port": schema.Int64Attribute{
+						Description:         "Port of LDAP server, defaults to '389'.",
+						MarkdownDescription: "Port of LDAP server, defaults to `389`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             int64default.StaticInt64(389),
+					},
+					"transport": schema.StringAttribute{
+						Description:         fmt.Sprintf("Transport of LDAP connection, options: '%s', defaults to 'Standard TCP'.", strings.Join(pfsense.AuthServerLDAPTransports(), "', '")),
+						MarkdownDescription: fmt.Sprintf("Transport of LDAP connection, options: `%s`, defaults to `Standard TCP`.", strings.Join(pfsense.AuthServerLDAPTransports(), "`, `")),
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("Standard TCP"),
+					},
+					"scope": schema.StringAttribute{
+						Description:         fmt.Sprintf("Search scope, options: '%s', defaults to 'one'.", strings.Join(pfsense.AuthServerLDAPScopes(), "', '")),
+						MarkdownDescription: fmt.Sprintf("Search scope, options: `%s`, defaults to `one`.", strings.Join(pfsense.AuthServerLDAPScopes(), "`, `")),
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("one"),
+					},
+					"base_dn": schema.StringAttribute{
+						Description: "Root of the LDAP search.",
+						Required:    true,
+					},
+					"auth_containers": schema.StringAttribute{
+						Description: "Semicolon separated list of containers (relative to the base DN) holding users.",
+						Required:    true,
+					},
+					"bind_dn": schema.StringAttribute{
+						Description: "Distinguished name used to bind, anonymous binds are used when not set.",
+						Optional:    true,
+					},
+					"bind_password": schema.StringAttribute{
+						Description: "Password used to bind.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"user_naming_attribute": schema.StringAttribute{
+						Description:         "User naming attribute, defaults to 'cn'.",
+						MarkdownDescription: "User naming attribute, defaults to `cn`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("cn"),
+					},
+					"group_naming_attribute": schema.StringAttribute{
+						Description:         "Group naming attribute, defaults to 'cn'.",
+						MarkdownDescription: "Group naming attribute, defaults to `cn`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("cn"),
+					},
+					"group_member_attribute": schema.StringAttribute{
+						Description:         "Group member attribute, defaults to 'memberOf'.",
+						MarkdownDescription: "Group member attribute, defaults to `memberOf`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("memberOf"),
+					},
+					"timeout": schema.Int64Attribute{
+						Description:         "Server timeout in seconds, defaults to '25'.",
+						MarkdownDescription: "Server timeout in seconds, defaults to `25`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             int64default.StaticInt64(25),
+					},
+				},
+			},
+			"radius": schema.SingleNestedAttribute{
+				Description:         "RADIUS server settings, required when type is 'radius'.",
+				MarkdownDescription: "RADIUS server settings, required when type is `radius`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"secret": schema.StringAttribute{
+						Description: "Shared secret.",
+						Required:    true,
+						Sensitive:   true,
+					},
+					"protocol": schema.StringAttribute{
+						Description:         fmt.Sprintf("Authentication protocol, options: '%s', defaults to 'MSCHAPv2'.", strings.Join(pfsense.AuthServerRADIUSProtocols(), "', '")),
+						MarkdownDescription: fmt.Sprintf("Authentication protocol, options: `%s`, defaults to `MSCHAPv2`.", strings.Join(pfsense.AuthServerRADIUSProtocols(), "`, `")),
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("MSCHAPv2"),
+					},
+					"authentication_port": schema.Int64Attribute{
+						Description:         "Authentication port, defaults to '1812'.",
+						MarkdownDescription: "Authentication port, defaults to `1812`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             int64default.StaticInt64(1812),
+					},
+					"accounting_port": schema.Int64Attribute{
+						Description: "Accounting port, accounting is disabled when not set.",
+						Optional:    true,
+					},
+					"timeout": schema.Int64Attribute{
+						Description:         "Server timeout in seconds, defaults to '5'.",
+						MarkdownDescription: "Server timeout in seconds, defaults to `5`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             int64default.StaticInt64(5),
+					},
+					"nas_ip_attribute": schema.StringAttribute{
+						Description:         "Interface whose IP address is sent as the NAS-IP-Address attribute, defaults to 'lan'.",
+						MarkdownDescription: "Interface whose IP address is sent as the NAS-IP-Address attribute, defaults to `lan`.",
+						Computed:            true,
+						Optional:            true,
+						Default:             stringdefault.StaticString("lan"),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SystemUserAuthenticationServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemUserAuthenticationServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemUserAuthenticationServerResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authServerReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authServer, err := r.client.CreateSystemAuthServer(ctx, *authServerReq)
+	if addError(&resp.Diagnostics, "Error creating authentication server", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, authServer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUserAuthenticationServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemUserAuthenticationServerResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authServer, err := r.client.GetSystemAuthServer(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading authentication server", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, authServer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUserAuthenticationServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemUserAuthenticationServerResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authServerReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authServer, err := r.client.UpdateSystemAuthServer(ctx, *authServerReq)
+	if addError(&resp.Diagnostics, "Error updating authentication server", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, authServer)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUserAuthenticationServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemUserAuthenticationServerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteSystemAuthServer(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting authentication server", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SystemUserAuthenticationServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
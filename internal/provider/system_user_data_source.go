@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var (
+	_ datasource.DataSource              = &SystemUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &SystemUserDataSource{}
+)
+
+func NewSystemUserDataSource() datasource.DataSource {
+	return &SystemUserDataSource{}
+}
+
+type SystemUserDataSource struct {
+	client *pfsense.Client
+}
+
+type SystemUserDataSourceModel struct {
+	Name       types.String `tfsdk:"name"`
+	FullName   types.String `tfsdk:"full_name"`
+	Disabled   types.Bool   `tfsdk:"disabled"`
+	Expiration types.String `tfsdk:"expiration"`
+	Groups     types.List   `tfsdk:"groups"`
+	Privileges types.List   `tfsdk:"privileges"`
+}
+
+func (d *SystemUserDataSourceModel) SetFromValue(ctx context.Context, user *pfsense.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Name = types.StringValue(user.Name)
+
+	d.FullName = types.StringNull()
+	if user.FullName != "" {
+		d.FullName = types.StringValue(user.FullName)
+	}
+
+	d.Disabled = types.BoolValue(user.Disabled)
+
+	d.Expiration = types.StringNull()
+	if user.Expiration != "" {
+		d.Expiration = types.StringValue(user.Expiration)
+	}
+
+	groups := user.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+
+	d.Groups, diags = types.ListValueFrom(ctx, types.StringType, groups)
+	if diags.HasError() {
+		return diags
+	}
+
+	privileges := user.Privileges
+	if privileges == nil {
+		privileges = []string{}
+	}
+
+	d.Privileges, diags = types.ListValueFrom(ctx, types.StringType, privileges)
+
+	return diags
+}
+
+func (d *SystemUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_user", req.ProviderTypeName)
+}
+
+func (d *SystemUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Retrieves a single user by name. The password is not exposed.",
+		MarkdownDescription: "Retrieves a single [user](https://docs.netgate.com/pfsense/en/latest/usermanager/index.html) by name. The password is not exposed.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Username.",
+				Required:    true,
+			},
+			"full_name": schema.StringAttribute{
+				Description: "Full name of the user, for administrative reference.",
+				Computed:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "User is disabled and cannot log in.",
+				Computed:    true,
+			},
+			"expiration": schema.StringAttribute{
+				Description: "Date (MM/DD/YYYY) the account expires, unset when the account does not expire.",
+				Computed:    true,
+			},
+			"groups": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Groups the user is a member of.",
+				Computed:    true,
+			},
+			"privileges": schema.ListAttribute{
+				ElementType: types.StringType,
+				Description: "Privileges assigned directly to the user (not inherited from groups).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *SystemUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureDataSourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *SystemUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data SystemUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := d.client.GetUser(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Unable to get user", err) {
+		return
+	}
+
+	resp.Diagnostics.Append(data.SetFromValue(ctx, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+func TestSystemUserDataSourceModelSetFromValue(t *testing.T) {
+	var model SystemUserDataSourceModel
+	diags := model.SetFromValue(context.Background(), &pfsense.User{Name: "tf-acc-test", Password: "secret", Disabled: true})
+	if diags.HasError() {
+		t.Fatalf("unexpected error, %v", diags)
+	}
+
+	// unset values are null, missing lists are empty rather than null
+	if !model.FullName.IsNull() || !model.Expiration.IsNull() {
+		t.Errorf("expected null full name and expiration, got %s and %s", model.FullName, model.Expiration)
+	}
+
+	if model.Groups.IsNull() || len(model.Groups.Elements()) != 0 || model.Privileges.IsNull() || len(model.Privileges.Elements()) != 0 {
+		t.Errorf("expected empty groups and privileges, got %s and %s", model.Groups, model.Privileges)
+	}
+
+	if !model.Disabled.ValueBool() {
+		t.Errorf("expected disabled user")
+	}
+}
+
+// TestAccSystemUserDataSource reads a user created by the user resource, an unknown user is an error.
+func TestAccSystemUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_user" "test" {
+  name      = "tf-acc-test-data"
+  password  = "tf-acc-test-password"
+  full_name = "tf acc test"
+  groups    = ["admins"]
+}
+
+data "pfsense_system_user" "test" {
+  name = pfsense_system_user.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pfsense_system_user.test", "full_name", "tf acc test"),
+					resource.TestCheckResourceAttr("data.pfsense_system_user.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("data.pfsense_system_user.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("data.pfsense_system_user.test", "groups.0", "admins"),
+					resource.TestCheckNoResourceAttr("data.pfsense_system_user.test", "password"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+data "pfsense_system_user" "test" {
+  name = "tf-acc-test-unknown"
+}
+`,
+				ExpectError: regexp.MustCompile(`not found`),
+			},
+		},
+	})
+}
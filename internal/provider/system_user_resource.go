@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/marshallford/terraform-provider-pfsense/pkg/pfsense"
+)
+
+var _ resource.Resource = &SystemUserResource{}
+var _ resource.ResourceWithImportState = &SystemUserResource{}
+
+func NewSystemUserResource() resource.Resource {
+	return &SystemUserResource{}
+}
+
+type SystemUserResource struct {
+	client *pfsense.Client
+}
+
+type SystemUserResourceModel struct {
+	Name       types.String   `tfsdk:"name"`
+	Password   types.String   `tfsdk:"password"`
+	FullName   types.String   `tfsdk:"full_name"`
+	Disabled   types.Bool     `tfsdk:"disabled"`
+	Groups     []types.String `tfsdk:"groups"`
+	Privileges []types.String `tfsdk:"privileges"`
+}
+
+// equivalentStrings reports whether the configured values contain the same strings in any order.
+func equivalentStrings(configured []types.String, values []string) bool {
+	if len(configured) != len(values) {
+		return false
+	}
+
+	counts := map[string]int{}
+	for _, value := range values {
+		counts[value]++
+	}
+
+	for _, c := range configured {
+		if counts[c.ValueString()] == 0 {
+			return false
+		}
+		counts[c.ValueString()]--
+	}
+
+	return true
+}
+
+// unorderedStrings returns the configured values when equivalent (pfSense may reorder them), otherwise the values.
+func unorderedStrings(configured []types.String, values []string) []types.String {
+	if configured != nil && equivalentStrings(configured, values) {
+		return configured
+	}
+
+	result := []types.String{}
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+
+	return result
+}
+
+func (r *SystemUserResourceModel) SetFromValue(ctx context.Context, user *pfsense.User) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r.Name = types.StringValue(user.Name)
+
+	r.FullName = types.StringNull()
+	if user.FullName != "" {
+		r.FullName = types.StringValue(user.FullName)
+	}
+
+	r.Disabled = types.BoolValue(user.Disabled)
+	r.Groups = unorderedStrings(r.Groups, user.Groups)
+	r.Privileges = unorderedStrings(r.Privileges, user.Privileges)
+
+	return diags
+}
+
+func (r SystemUserResourceModel) Value(ctx context.Context) (*pfsense.User, diag.Diagnostics) {
+	var user pfsense.User
+	var err error
+	var diags diag.Diagnostics
+
+	err = user.SetName(r.Name.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"Name cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	err = user.SetPassword(r.Password.ValueString())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("password"),
+			"Password cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	if !r.FullName.IsNull() {
+		err = user.SetFullName(r.FullName.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("full_name"),
+				"Full name cannot be parsed",
+				err.Error(),
+			)
+		}
+	}
+
+	err = user.SetDisabled(r.Disabled.ValueBool())
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("disabled"),
+			"Disabled cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	groups := []string{}
+	for _, group := range r.Groups {
+		groups = append(groups, group.ValueString())
+	}
+
+	err = user.SetGroups(groups)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("groups"),
+			"Groups cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	privileges := []string{}
+	for _, privilege := range r.Privileges {
+		privileges = append(privileges, privilege.ValueString())
+	}
+
+	err = user.SetPrivileges(privileges)
+
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("privileges"),
+			"Privileges cannot be parsed",
+			err.Error(),
+		)
+	}
+
+	return &user, diags
+}
+
+func (r *SystemUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_system_user", req.ProviderTypeName)
+}
+
+func (r *SystemUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Local user, for access to the web configurator, SSH, VPNs and other services.",
+		MarkdownDescription: "Local [user](https://docs.netgate.com/pfsense/en/latest/usermanager/users.html), for access to the web configurator, SSH, VPNs and other services.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Username.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: "Password, only submitted when changed. pfSense stores a hash, changes made outside of Terraform are not detected.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"full_name": schema.StringAttribute{
+				Description: "Full name of the user, for administrative reference (not parsed).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description:         "Disable this user without removing it, defaults to 'false'.",
+				MarkdownDescription: "Disable this user without removing it, defaults to `false`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "Names of the groups the user is a member of, defaults to '[]'.",
+				MarkdownDescription: "Names of the groups the user is a member of, defaults to `[]`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"privileges": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Description:         "Privileges assigned directly to the user (e.g. 'page-all', 'user-shell-access'), defaults to '[]'.",
+				MarkdownDescription: "Privileges assigned directly to the user (e.g. `page-all`, `user-shell-access`), defaults to `[]`.",
+				Computed:            true,
+				Optional:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *SystemUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureResourceClient(req, resp)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SystemUserResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.CreateUser(ctx, *userReq)
+	if addError(&resp.Diagnostics, "Error creating user", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, user)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SystemUserResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error reading user", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, user)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SystemUserResourceModel
+	var state *SystemUserResourceModel
+	var diags diag.Diagnostics
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userReq, d := data.Value(ctx)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// an empty password leaves the current password unchanged
+	if data.Password.Equal(state.Password) {
+		userReq.Password = ""
+	}
+
+	user, err := r.client.UpdateUser(ctx, *userReq)
+	if addError(&resp.Diagnostics, "Error updating user", err) {
+		return
+	}
+
+	diags = data.SetFromValue(ctx, user)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SystemUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(ctx, data.Name.ValueString())
+	if addError(&resp.Diagnostics, "Error deleting user", err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SystemUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccSystemUserResource creates a user in a group that grants a privilege, adds a privilege to the user, then
+// imports the user by username.
+func TestAccSystemUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_group" "test" {
+  name        = "tf-acc-test"
+  description = "tf acc test"
+  privileges  = ["page-dashboard-all"]
+}
+
+resource "pfsense_system_user" "test" {
+  name      = "tf-acc-test"
+  password  = "tf-acc-test-password"
+  full_name = "tf acc test"
+  groups    = [pfsense_system_group.test.name]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_group.test", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("pfsense_system_group.test", "privileges.0", "page-dashboard-all"),
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "full_name", "tf acc test"),
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "groups.#", "1"),
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "groups.0", "tf-acc-test"),
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "privileges.#", "0"),
+				),
+			},
+			{
+				Config: testAccProviderConfig() + `
+resource "pfsense_system_group" "test" {
+  name        = "tf-acc-test"
+  description = "tf acc test"
+  privileges  = ["page-dashboard-all"]
+}
+
+resource "pfsense_system_user" "test" {
+  name       = "tf-acc-test"
+  password   = "tf-acc-test-password"
+  full_name  = "tf acc test"
+  groups     = [pfsense_system_group.test.name]
+  privileges = ["page-diagnostics-ping"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("pfsense_system_user.test", "privileges.0", "page-diagnostics-ping"),
+				),
+			},
+			{
+				ResourceName:                         "pfsense_system_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "tf-acc-test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"password"},
+			},
+			{
+				ResourceName:                         "pfsense_system_group.test",
+				ImportState:                          true,
+				ImportStateId:                        "tf-acc-test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
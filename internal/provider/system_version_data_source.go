@@ -24,8 +24,11 @@ type SystemVersionDataSource struct {
 }
 
 type SystemVersionDataSourceModel struct {
-	Current types.String `tfsdk:"current"`
-	Latest  types.String `tfsdk:"latest"`
+	Current        types.String `tfsdk:"current"`
+	Latest         types.String `tfsdk:"latest"`
+	Platform       types.String `tfsdk:"platform"`
+	Kernel         types.String `tfsdk:"kernel"`
+	ConfigRevision types.Int64  `tfsdk:"config_revision"`
 }
 
 func (d *SystemVersionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -34,7 +37,7 @@ func (d *SystemVersionDataSource) Metadata(_ context.Context, req datasource.Met
 
 func (d *SystemVersionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieves current and latest system version.",
+		Description: "Retrieves current and latest system version, platform, kernel and config revision.",
 		Attributes: map[string]schema.Attribute{
 			"current": schema.StringAttribute{
 				Description: "Current pfSense system version.",
@@ -44,6 +47,18 @@ func (d *SystemVersionDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description: "Latest pfSense system version.",
 				Computed:    true,
 			},
+			"platform": schema.StringAttribute{
+				Description: "Hardware platform of the system.",
+				Computed:    true,
+			},
+			"kernel": schema.StringAttribute{
+				Description: "Release of the running kernel.",
+				Computed:    true,
+			},
+			"config_revision": schema.Int64Attribute{
+				Description: "Unix time of the last configuration change.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -67,6 +82,9 @@ func (d *SystemVersionDataSource) Read(ctx context.Context, req datasource.ReadR
 
 	data.Current = types.StringValue(version.Current)
 	data.Latest = types.StringValue(version.Latest)
+	data.Platform = types.StringValue(version.Platform)
+	data.Kernel = types.StringValue(version.Kernel)
+	data.ConfigRevision = types.Int64Value(version.ConfigRevision)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
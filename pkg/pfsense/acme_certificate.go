@@ -0,0 +1,523 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	acmeCertificateStatusActive   = "active"
+	acmeCertificateStatusDisabled = "disabled"
+	acmeIssueWaitInterval         = 5 * time.Second
+	DefaultACMEKeyLength          = "2048"
+	DefaultACMERenewAfter         = 60
+	DefaultACMEIssueTimeout       = 5 * time.Minute
+)
+
+var (
+	ErrACMENotInstalled      = errors.New("ACME package not installed")
+	ErrIssueACMECertificate  = errors.New("failed to issue ACME certificate")
+	acmeDomainResponseFields = []string{"status", "name", "method"}
+)
+
+func ACMEKeyLengths() []string {
+	return []string{"2048", "3072", "4096", "ec-256", "ec-384"}
+}
+
+// ACMEChallengeMethods returns the HTTP challenge methods, DNS challenge methods are named after the acme.sh DNS API
+// (e.g. 'dns_cf' for Cloudflare).
+func ACMEChallengeMethods() []string {
+	return []string{"standalone", "webroot", "dns_*"}
+}
+
+type acmeCertificateResponse struct {
+	Name        string `json:"name"`
+	Description string `json:"descr"`
+	Status      string `json:"status"`
+	Account     string `json:"acmeaccount"`
+	KeyLength   string `json:"keylength"`
+	RenewAfter  string `json:"renewafter"`
+	LastRenewal string `json:"lastrenewal"`
+	DomainList  struct {
+		Items []map[string]any `json:"item"`
+	} `json:"a_domainlist"`
+}
+
+type acmeResponse struct {
+	Installed    bool                      `json:"installed"`
+	Accounts     []string                  `json:"accounts"`
+	Certificates []acmeCertificateResponse `json:"certificates"`
+}
+
+type ACMECertificate struct {
+	Name        string
+	Description string
+	Enabled     bool
+	Account     string
+	KeyLength   string
+	RenewAfter  int
+	Domains     []ACMECertificateDomain
+	LastRenewal *time.Time
+}
+
+type ACMECertificateDomain struct {
+	Name        string
+	Method      string
+	Credentials map[string]string // method specific settings (e.g. DNS API tokens)
+}
+
+func (cert *ACMECertificate) SetName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, certificate name must only consist of alphanumeric characters, periods, hyphens, and underscores", ErrClientValidation)
+	}
+
+	cert.Name = name
+
+	return nil
+}
+
+func (cert *ACMECertificate) SetDescription(description string) error {
+	cert.Description = description
+
+	return nil
+}
+
+func (cert *ACMECertificate) SetEnabled(enabled bool) error {
+	cert.Enabled = enabled
+
+	return nil
+}
+
+func (cert *ACMECertificate) SetAccount(account string) error {
+	if account == "" {
+		return fmt.Errorf("%w, account key name required", ErrClientValidation)
+	}
+
+	cert.Account = account
+
+	return nil
+}
+
+func (cert *ACMECertificate) SetKeyLength(keyLength string) error {
+	if !slices.Contains(ACMEKeyLengths(), keyLength) {
+		return fmt.Errorf("%w, key length must be one of '%s'", ErrClientValidation, strings.Join(ACMEKeyLengths(), "', '"))
+	}
+
+	cert.KeyLength = keyLength
+
+	return nil
+}
+
+func (cert *ACMECertificate) SetRenewAfter(days int) error {
+	if days < 1 {
+		return fmt.Errorf("%w, renew after must be at least 1 day", ErrClientValidation)
+	}
+
+	cert.RenewAfter = days
+
+	return nil
+}
+
+func (domain *ACMECertificateDomain) SetName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w, domain name required", ErrClientValidation)
+	}
+
+	domain.Name = name
+
+	return nil
+}
+
+func (domain *ACMECertificateDomain) SetMethod(method string) error {
+	if method != "standalone" && method != "webroot" && !strings.HasPrefix(method, "dns_") {
+		return fmt.Errorf("%w, challenge method must be one of '%s'", ErrClientValidation, strings.Join(ACMEChallengeMethods(), "', '"))
+	}
+
+	domain.Method = method
+
+	return nil
+}
+
+func (domain *ACMECertificateDomain) SetCredentials(credentials map[string]string) error {
+	for key := range credentials {
+		if slices.Contains(acmeDomainResponseFields, key) {
+			return fmt.Errorf("%w, credential '%s' conflicts with a domain setting", ErrClientValidation, key)
+		}
+	}
+
+	domain.Credentials = credentials
+
+	return nil
+}
+
+func (cert ACMECertificate) formatStatus() string {
+	if cert.Enabled {
+		return acmeCertificateStatusActive
+	}
+
+	return acmeCertificateStatusDisabled
+}
+
+type ACMECertificates []ACMECertificate
+
+func (certs ACMECertificates) GetByName(name string) (*ACMECertificate, error) {
+	for _, cert := range certs {
+		if cert.Name == name {
+			return &cert, nil
+		}
+	}
+	return nil, fmt.Errorf("ACME certificate %w with name '%s'", ErrNotFound, name)
+}
+
+func parseACMECertificateResponse(resp acmeCertificateResponse) (*ACMECertificate, error) {
+	var cert ACMECertificate
+	var err error
+
+	err = cert.SetName(resp.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+	}
+
+	err = cert.SetDescription(resp.Description)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+	}
+
+	err = cert.SetEnabled(resp.Status == acmeCertificateStatusActive)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+	}
+
+	err = cert.SetAccount(resp.Account)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+	}
+
+	if resp.KeyLength == "" {
+		resp.KeyLength = DefaultACMEKeyLength
+	}
+
+	err = cert.SetKeyLength(resp.KeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+	}
+
+	renewAfter := DefaultACMERenewAfter
+	if resp.RenewAfter != "" {
+		renewAfter, err = strconv.Atoi(resp.RenewAfter)
+		if err != nil {
+			return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+		}
+	}
+
+	err = cert.SetRenewAfter(renewAfter)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+	}
+
+	if resp.LastRenewal != "" {
+		seconds, err := strconv.ParseInt(resp.LastRenewal, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+		}
+
+		lastRenewal := time.Unix(seconds, 0).UTC()
+		cert.LastRenewal = &lastRenewal
+	}
+
+	for _, item := range resp.DomainList.Items {
+		var domain ACMECertificateDomain
+		var err error
+
+		err = domain.SetName(fmt.Sprint(item["name"]))
+		if err != nil {
+			return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+		}
+
+		err = domain.SetMethod(fmt.Sprint(item["method"]))
+		if err != nil {
+			return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+		}
+
+		// remaining non-empty fields are method specific settings
+		credentials := map[string]string{}
+		for key, value := range item {
+			if s, ok := value.(string); ok && s != "" && !slices.Contains(acmeDomainResponseFields, key) {
+				credentials[key] = s
+			}
+		}
+
+		err = domain.SetCredentials(credentials)
+		if err != nil {
+			return nil, fmt.Errorf("%w ACME certificate response, %w", ErrUnableToParse, err)
+		}
+
+		cert.Domains = append(cert.Domains, domain)
+	}
+
+	return &cert, nil
+}
+
+func parseACMEResponse(b []byte) ([]string, *ACMECertificates, error) {
+	var resp acmeResponse
+	err := json.Unmarshal(b, &resp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	if !resp.Installed {
+		return nil, nil, ErrACMENotInstalled
+	}
+
+	var certs ACMECertificates
+	for _, certResp := range resp.Certificates {
+		cert, err := parseACMECertificateResponse(certResp)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		certs = append(certs, *cert)
+	}
+
+	return resp.Accounts, &certs, nil
+}
+
+func acmeOutputCommand() string {
+	return "$pkg = $config['installedpackages'];" +
+		"$output = array('installed' => file_exists('/usr/local/pkg/acme/acme.inc'), 'accounts' => array(), 'certificates' => array());" +
+		"if (is_array($pkg['acme']['accountkeys']['item'])) { foreach ($pkg['acme']['accountkeys']['item'] as $v) { $output['accounts'][] = $v['name']; } }" +
+		"if (is_array($pkg['acme']['certificates']['item'])) { foreach ($pkg['acme']['certificates']['item'] as $v) {" +
+		"if (!is_array($v['a_domainlist']['item'])) { $v['a_domainlist'] = array('item' => array()); }" +
+		"unset($v['a_actionlist']); $output['certificates'][] = $v;" +
+		"}}" +
+		"print_r(json_encode($output));"
+}
+
+func (pf *Client) getACMECertificates(ctx context.Context) ([]string, *ACMECertificates, error) {
+	b, err := pf.runPHPCommand(ctx, acmeOutputCommand())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parseACMEResponse(b)
+}
+
+// updateACME runs the given PHP statements against the ACME package config in a single config write. The statements
+// have access to the decoded request ($req) and a reference to the ACME certificates ($certs).
+func (pf *Client) updateACME(ctx context.Context, req any, statements string) (*ACMECertificates, error) {
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	command := fmt.Sprintf("$req = json_decode(base64_decode('%s'), true);", base64.StdEncoding.EncodeToString(reqJSON)) +
+		"$pkg = &$config['installedpackages'];" +
+		"if (file_exists('/usr/local/pkg/acme/acme.inc')) {" +
+		"if (!is_array($pkg['acme']['certificates']['item'])) { $pkg['acme']['certificates'] = array('item' => array()); }" +
+		"$certs = &$pkg['acme']['certificates']['item'];" +
+		statements +
+		"write_config('Terraform: updated ACME certificates');" +
+		"unset($certs);" +
+		"}" +
+		"unset($pkg);" +
+		acmeOutputCommand()
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	_, certs, err := parseACMEResponse(b)
+
+	return certs, err
+}
+
+func (pf *Client) GetACMECertificates(ctx context.Context) (*ACMECertificates, error) {
+	pf.mutexes.ACME.Lock()
+	defer pf.mutexes.ACME.Unlock()
+
+	_, certs, err := pf.getACMECertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificates, %w", ErrGetOperationFailed, err)
+	}
+
+	return certs, nil
+}
+
+func (pf *Client) GetACMECertificate(ctx context.Context, name string) (*ACMECertificate, error) {
+	pf.mutexes.ACME.Lock()
+	defer pf.mutexes.ACME.Unlock()
+
+	_, certs, err := pf.getACMECertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return certs.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateACMECertificate(ctx context.Context, certReq ACMECertificate, create bool) (*ACMECertificate, error) {
+	accounts, certs, err := pf.getACMECertificates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(accounts, certReq.Account) {
+		return nil, fmt.Errorf("%w, account key '%s' does not exist", ErrClientValidation, certReq.Account)
+	}
+
+	_, err = certs.GetByName(certReq.Name)
+	if create && err == nil {
+		return nil, fmt.Errorf("certificate with name '%s' already exists", certReq.Name)
+	}
+
+	if !create && err != nil {
+		return nil, err
+	}
+
+	type certificateRequest struct {
+		Create      bool           `json:"create"`
+		Certificate map[string]any `json:"certificate"`
+	}
+
+	domains := []map[string]string{}
+	for _, domain := range certReq.Domains {
+		item := map[string]string{}
+		for key, value := range domain.Credentials {
+			item[key] = value
+		}
+
+		item["status"] = "enable"
+		item["name"] = domain.Name
+		item["method"] = domain.Method
+		domains = append(domains, item)
+	}
+
+	req := certificateRequest{
+		Create: create,
+		Certificate: map[string]any{
+			"name":         certReq.Name,
+			"descr":        certReq.Description,
+			"status":       certReq.formatStatus(),
+			"acmeaccount":  certReq.Account,
+			"keylength":    certReq.KeyLength,
+			"renewafter":   strconv.Itoa(certReq.RenewAfter),
+			"a_domainlist": map[string]any{"item": domains},
+		},
+	}
+
+	// existing certificate settings not managed here (e.g. actions and the last renewal) are preserved
+	statements := "$found = false;" +
+		"foreach ($certs as $k => $v) { if ($v['name'] === $req['certificate']['name']) { $certs[$k] = array_merge($v, $req['certificate']); $found = true; } }" +
+		"if (!$found && $req['create']) { $certs[] = array_merge(array('a_actionlist' => array('item' => array())), $req['certificate']); }"
+
+	certs, err = pf.updateACME(ctx, req, statements)
+	if err != nil {
+		return nil, err
+	}
+
+	return certs.GetByName(certReq.Name)
+}
+
+func (pf *Client) CreateACMECertificate(ctx context.Context, certReq ACMECertificate) (*ACMECertificate, error) {
+	pf.mutexes.ACME.Lock()
+	defer pf.mutexes.ACME.Unlock()
+
+	cert, err := pf.createOrUpdateACMECertificate(ctx, certReq, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate, %w", ErrCreateOperationFailed, err)
+	}
+
+	return cert, nil
+}
+
+func (pf *Client) UpdateACMECertificate(ctx context.Context, certReq ACMECertificate) (*ACMECertificate, error) {
+	pf.mutexes.ACME.Lock()
+	defer pf.mutexes.ACME.Unlock()
+
+	cert, err := pf.createOrUpdateACMECertificate(ctx, certReq, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w ACME certificate, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return cert, nil
+}
+
+// DeleteACMECertificate removes the certificate definition, certificates already issued to the certificate manager
+// are left in place.
+func (pf *Client) DeleteACMECertificate(ctx context.Context, name string) error {
+	pf.mutexes.ACME.Lock()
+	defer pf.mutexes.ACME.Unlock()
+
+	statements := "$certs = array_values(array_filter($certs, function($v) use ($req) { return $v['name'] !== $req; }));"
+
+	certs, err := pf.updateACME(ctx, name, statements)
+	if err != nil {
+		return fmt.Errorf("%w ACME certificate, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err := certs.GetByName(name); err == nil {
+		return fmt.Errorf("%w ACME certificate, certificate with name '%s' still exists", ErrDeleteOperationFailed, name)
+	}
+
+	return nil
+}
+
+// IssueACMECertificate issues (or renews) a certificate and waits until the issuance is recorded. Issuance can take
+// minutes (e.g. waiting for DNS propagation), so it runs in the background on pfSense while the last renewal is polled.
+func (pf *Client) IssueACMECertificate(ctx context.Context, name string, timeout time.Duration) (*ACMECertificate, error) {
+	pf.mutexes.ACME.Lock()
+	defer pf.mutexes.ACME.Unlock()
+
+	_, certs, err := pf.getACMECertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrIssueACMECertificate, err)
+	}
+
+	cert, err := certs.GetByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrIssueACMECertificate, err)
+	}
+
+	previousRenewal := cert.LastRenewal
+
+	script := fmt.Sprintf("require_once('/usr/local/pkg/acme/acme.inc'); \\Acme\\issue_certificate(base64_decode('%s'), true);", base64.StdEncoding.EncodeToString([]byte(name)))
+	command := fmt.Sprintf("mwexec_bg('/usr/local/bin/php -r ' . escapeshellarg(base64_decode('%s')));", base64.StdEncoding.EncodeToString([]byte(script)))
+
+	_, err = pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrIssueACMECertificate, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		_, certs, err := pf.getACMECertificates(ctx)
+		if err == nil {
+			cert, err = certs.GetByName(name)
+		}
+
+		if err == nil && cert.LastRenewal != nil && (previousRenewal == nil || cert.LastRenewal.After(*previousRenewal)) {
+			return cert, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return nil, fmt.Errorf("%w, %w", ErrIssueACMECertificate, err)
+			}
+			return nil, fmt.Errorf("%w, issuance not recorded within %s, check the ACME package log, %w", ErrIssueACMECertificate, timeout, ctx.Err())
+		case <-time.After(acmeIssueWaitInterval):
+		}
+	}
+}
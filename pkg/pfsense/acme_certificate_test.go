@@ -0,0 +1,204 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testACMEServer emulates the ACME package config, requests are decoded from the PHP command and applied to the
+// stored certificates, issuing sets the last renewal.
+func testACMEServer(t *testing.T, installed bool, certs *[]map[string]any) *httptest.Server {
+	t.Helper()
+
+	var mutex sync.Mutex
+	encoded := regexp.MustCompile(`json_decode\(base64_decode\('([^']*)'\), true\)`)
+
+	return httptest.NewServer(testPfSenseHandler(testPHPCommandHandler(func(command string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case strings.Contains(command, "mwexec_bg"):
+			for _, cert := range *certs {
+				cert["lastrenewal"] = "1718000000"
+			}
+
+			return ""
+		case encoded.MatchString(command):
+			b, _ := base64.StdEncoding.DecodeString(encoded.FindStringSubmatch(command)[1])
+
+			var req struct {
+				Create      bool           `json:"create"`
+				Certificate map[string]any `json:"certificate"`
+			}
+
+			if err := json.Unmarshal(b, &req); err != nil {
+				// deletions send only the certificate name
+				var name string
+				if err := json.Unmarshal(b, &name); err != nil {
+					t.Errorf("unable to decode command, %s", err)
+					return ""
+				}
+
+				var kept []map[string]any
+				for _, cert := range *certs {
+					if cert["name"] != name {
+						kept = append(kept, cert)
+					}
+				}
+				*certs = kept
+			} else {
+				found := false
+				for _, cert := range *certs {
+					if cert["name"] == req.Certificate["name"] {
+						maps.Copy(cert, req.Certificate)
+						found = true
+					}
+				}
+
+				if !found && req.Create {
+					*certs = append(*certs, req.Certificate)
+				}
+			}
+		}
+
+		b, _ := json.Marshal(map[string]any{
+			"installed":    installed,
+			"accounts":     []string{"letsencrypt"},
+			"certificates": *certs,
+		})
+
+		return string(b)
+	})))
+}
+
+func testACMECertificateRequest(t *testing.T) ACMECertificate {
+	t.Helper()
+
+	var certReq ACMECertificate
+	_ = certReq.SetName("www.example.com")
+	_ = certReq.SetDescription("web")
+	_ = certReq.SetEnabled(true)
+	_ = certReq.SetAccount("letsencrypt")
+	_ = certReq.SetKeyLength("ec-256")
+	_ = certReq.SetRenewAfter(30)
+
+	var domain ACMECertificateDomain
+	_ = domain.SetName("www.example.com")
+	_ = domain.SetMethod("dns_cf")
+	if err := domain.SetCredentials(map[string]string{"cf_token": "secret", "cf_account_id": "abc"}); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	certReq.Domains = []ACMECertificateDomain{domain}
+
+	return certReq
+}
+
+func TestACMECertificateDomainValidation(t *testing.T) {
+	var domain ACMECertificateDomain
+
+	for _, method := range []string{"standalone", "webroot", "dns_cf"} {
+		if err := domain.SetMethod(method); err != nil {
+			t.Errorf("SetMethod(%q) unexpected error, %s", method, err)
+		}
+	}
+
+	if err := domain.SetMethod("dns"); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected validation error for method 'dns', got %v", err)
+	}
+
+	if err := domain.SetCredentials(map[string]string{"method": "standalone"}); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected validation error for credential conflicting with a domain setting, got %v", err)
+	}
+}
+
+func TestCreateACMECertificate(t *testing.T) {
+	certs := []map[string]any{}
+	server := testACMEServer(t, true, &certs)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	certReq := testACMECertificateRequest(t)
+
+	cert, err := pf.CreateACMECertificate(context.Background(), certReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if cert.Name != certReq.Name || cert.Description != certReq.Description || !cert.Enabled || cert.Account != certReq.Account ||
+		cert.KeyLength != certReq.KeyLength || cert.RenewAfter != certReq.RenewAfter || cert.LastRenewal != nil {
+		t.Errorf("read back %+v, want %+v", *cert, certReq)
+	}
+
+	if len(cert.Domains) != 1 || cert.Domains[0].Method != "dns_cf" || !maps.Equal(cert.Domains[0].Credentials, certReq.Domains[0].Credentials) {
+		t.Errorf("read back domains %+v, want %+v", cert.Domains, certReq.Domains)
+	}
+
+	if _, err := pf.CreateACMECertificate(context.Background(), certReq); !errors.Is(err, ErrCreateOperationFailed) {
+		t.Errorf("expected duplicate certificate to fail, got %v", err)
+	}
+
+	cert, err = pf.IssueACMECertificate(context.Background(), certReq.Name, time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if cert.LastRenewal == nil || !cert.LastRenewal.Equal(time.Unix(1718000000, 0)) {
+		t.Errorf("expected last renewal to be recorded, got %v", cert.LastRenewal)
+	}
+
+	if err := pf.DeleteACMECertificate(context.Background(), certReq.Name); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if _, err := pf.GetACMECertificate(context.Background(), certReq.Name); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestCreateACMECertificateUnknownAccount(t *testing.T) {
+	certs := []map[string]any{}
+	server := testACMEServer(t, true, &certs)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	certReq := testACMECertificateRequest(t)
+	_ = certReq.SetAccount("zerossl")
+
+	if _, err := pf.CreateACMECertificate(context.Background(), certReq); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
+
+func TestGetACMECertificatesNotInstalled(t *testing.T) {
+	certs := []map[string]any{}
+	server := testACMEServer(t, false, &certs)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if _, err := pf.GetACMECertificates(context.Background()); !errors.Is(err, ErrACMENotInstalled) {
+		t.Errorf("expected ACME package not installed error, got %v", err)
+	}
+}
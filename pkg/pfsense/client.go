@@ -3,6 +3,7 @@ package pfsense
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -25,23 +26,57 @@ const (
 	DefaultRetryMinWait  = time.Second
 	DefaultRetryMaxWait  = 5 * time.Second
 	DefaultMaxAttempts   = 3
+	DefaultHTTPTimeout   = 30 * time.Second
 )
 
 type Options struct {
 	URL           *url.URL
 	Username      string
 	Password      string
+	APIKey        string
+	APISecret     string
 	TLSSkipVerify *bool
 	RetryMinWait  *time.Duration
 	RetryMaxWait  *time.Duration
 	MaxAttempts   *int
+	HTTPTimeout   *time.Duration
+	HTTPProxy     *url.URL
+	// CACertificatePEM is a PEM bundle used to verify the pfSense certificate, TLSSkipVerify is ignored when set.
+	CACertificatePEM string
 }
 
 type mutexes struct {
+	ACME                      sync.Mutex
+	DHCPv4                    sync.Mutex
+	DHCPv4Apply               sync.Mutex
+	DNSForwarderApply         sync.Mutex
+	DNSForwarderHostOverride  sync.Mutex
 	DNSResolverApply          sync.Mutex
 	DNSResolverHostOverride   sync.Mutex
 	DNSResolverDomainOverride sync.Mutex
+	DNSResolverSettings       sync.Mutex
 	FirewallAlias             sync.Mutex
+	FirewallNAT               sync.Mutex
+	FirewallRule              sync.Mutex
+	FirewallSchedule          sync.Mutex
+	FirewallVirtualIP         sync.Mutex
+	FirewallVirtualIPApply    sync.Mutex
+	InterfaceVLAN             sync.Mutex
+	PfBlockerNG               sync.Mutex
+	PfBlockerNGApply          sync.Mutex
+	SystemAdvanced            sync.Mutex
+	SystemAuthServer          sync.Mutex
+	SystemCA                  sync.Mutex
+	SystemCertificate         sync.Mutex
+	SystemGateway             sync.Mutex
+	SystemGatewayApply        sync.Mutex
+	SystemNTP                 sync.Mutex
+	SystemStaticRoute         sync.Mutex
+	SystemStaticRouteApply    sync.Mutex
+	SystemSyslog              sync.Mutex
+	SystemTunable             sync.Mutex
+	SystemTunableApply        sync.Mutex
+	SystemUserManager         sync.Mutex
 }
 
 type Client struct {
@@ -50,9 +85,15 @@ type Client struct {
 	tokenKey   string
 	httpClient *http.Client
 	mutexes    *mutexes
+	applies    *applies
 }
 
-func (opts Options) newHTTPClient() *http.Client {
+// applies coalesces concurrent apply requests, applying restarts services so repeated calls are costly.
+type applies struct {
+	DHCPv4 *coalescer // keyed by interface
+}
+
+func (opts Options) newHTTPClient() (*http.Client, error) {
 	jar, err := cookiejar.New(nil)
 	if err != nil {
 		panic(err)
@@ -61,12 +102,31 @@ func (opts Options) newHTTPClient() *http.Client {
 	transport := cleanhttp.DefaultPooledTransport()
 	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: *opts.TLSSkipVerify} // #nosec G402
 
+	// a CA bundle allows self-signed certificates to be verified, prefer it over skipping verification
+	if opts.CACertificatePEM != "" {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM([]byte(opts.CACertificatePEM)) {
+			return nil, fmt.Errorf("%w, CA certificate does not contain a valid PEM certificate", ErrClientValidation)
+		}
+
+		transport.TLSClientConfig.RootCAs = rootCAs
+		transport.TLSClientConfig.InsecureSkipVerify = false
+	}
+
+	// without an explicit proxy the transport uses the HTTPS_PROXY, HTTP_PROXY, and NO_PROXY environment variables
+	if opts.HTTPProxy != nil {
+		transport.Proxy = http.ProxyURL(opts.HTTPProxy)
+	}
+
+	// the timeout applies to each attempt, timed out GET requests are retried while POST requests are not as they may
+	// already have been processed, operations that run synchronously (e.g. applying changes) may need a longer timeout
 	client := &http.Client{
 		Jar:       jar,
 		Transport: transport,
+		Timeout:   *opts.HTTPTimeout,
 	}
 
-	return client
+	return client, nil
 }
 
 func (pf *Client) updateToken(doc *goquery.Document) error {
@@ -111,8 +171,12 @@ func NewClient(ctx context.Context, opts *Options) (*Client, error) {
 		opts.Username = DefaultUsername
 	}
 
-	if opts.Password == "" {
-		return nil, fmt.Errorf("%w, password required", ErrClientValidation)
+	if (opts.APIKey == "") != (opts.APISecret == "") {
+		return nil, fmt.Errorf("%w, API key and API secret must be set together", ErrClientValidation)
+	}
+
+	if opts.Password == "" && opts.APIKey == "" {
+		return nil, fmt.Errorf("%w, password or API key required", ErrClientValidation)
 	}
 
 	if opts.TLSSkipVerify == nil {
@@ -135,14 +199,32 @@ func NewClient(ctx context.Context, opts *Options) (*Client, error) {
 		opts.MaxAttempts = &i
 	}
 
+	if opts.HTTPTimeout == nil {
+		td := DefaultHTTPTimeout
+		opts.HTTPTimeout = &td
+	}
+
+	httpClient, err := opts.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
 	pf := &Client{
 		Options:    opts,
-		httpClient: opts.newHTTPClient(),
+		httpClient: httpClient,
 		mutexes:    &mutexes{},
+		applies: &applies{
+			DHCPv4: newCoalescer(),
+		},
 	}
 
 	u := url.URL{Path: "/"}
 
+	// an API key takes precedence over the login form
+	if opts.APIKey != "" {
+		return pf.verifyAPIKey(ctx)
+	}
+
 	// get initial token
 	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
 	if err != nil {
@@ -184,6 +266,26 @@ func NewClient(ctx context.Context, opts *Options) (*Client, error) {
 	return pf, nil
 }
 
+// verifyAPIKey checks the API key is accepted, as pfSense serves the login page (with a 200 status) when it is not,
+// and scrapes the initial CSRF token.
+func (pf *Client) verifyAPIKey(ctx context.Context) (*Client, error) {
+	doc, err := pf.callHTML(ctx, http.MethodGet, url.URL{Path: "/"}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrLoginFailed, err)
+	}
+
+	if doc.FindMatcher(goquery.Single("input[name='usernamefld']")).Length() != 0 {
+		return nil, fmt.Errorf("%w, API key rejected", ErrLoginFailed)
+	}
+
+	err = pf.updateToken(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return pf, nil
+}
+
 func (pf *Client) callHTML(ctx context.Context, method string, relativeURL url.URL, values *url.Values) (*goquery.Document, error) {
 	resp, err := pf.call(ctx, method, relativeURL, values)
 	if err != nil {
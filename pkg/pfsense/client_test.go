@@ -0,0 +1,389 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"html"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testLoginPage     = `<html><head><script>var csrfMagicToken = "sid:login";var csrfMagicName = "__csrf_magic";</script></head><body><form method="post"><input name="usernamefld" /><input name="passwordfld" /></form></body></html>`
+	testDashboardPage = `<html><head><script>var csrfMagicToken = "sid:dashboard";var csrfMagicName = "__csrf_magic";</script></head><body>Status / Dashboard</body></html>`
+)
+
+// testPfSenseHandler serves a minimal pfSense login flow on '/', other requests are passed to next.
+func testPfSenseHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		switch {
+		case r.Header.Get("Authorization") != "":
+			fmt.Fprint(w, testDashboardPage)
+		case r.Method == http.MethodPost && r.PostFormValue("usernamefld") != "":
+			fmt.Fprint(w, testDashboardPage)
+		default:
+			fmt.Fprint(w, testLoginPage)
+		}
+	})
+}
+
+// newTestClient logs in to a test server, retries wait briefly unless set.
+func newTestClient(t *testing.T, serverURL string, opts Options) (*Client, error) {
+	t.Helper()
+
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		t.Fatalf("unable to parse test server URL, %s", err)
+	}
+
+	opts.URL = u
+	if opts.Password == "" && opts.APIKey == "" {
+		opts.Password = "pfsense"
+	}
+
+	if opts.RetryMinWait == nil {
+		td := time.Millisecond
+		opts.RetryMinWait = &td
+	}
+
+	if opts.RetryMaxWait == nil {
+		td := 2 * time.Millisecond
+		opts.RetryMaxWait = &td
+	}
+
+	return NewClient(context.Background(), &opts)
+}
+
+func TestNewClientDefaultHTTPTimeout(t *testing.T) {
+	server := httptest.NewServer(testPfSenseHandler(http.NotFoundHandler()))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if pf.httpClient.Timeout != 30*time.Second || *pf.Options.HTTPTimeout != DefaultHTTPTimeout {
+		t.Errorf("expected default HTTP timeout of 30s, got %s", pf.httpClient.Timeout)
+	}
+}
+
+func TestCallHTTPTimeout(t *testing.T) {
+	var gets, posts atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(testPfSenseHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			posts.Add(1)
+		} else {
+			gets.Add(1)
+		}
+
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})))
+	defer server.Close()
+	defer close(release)
+
+	timeout := 50 * time.Millisecond
+	pf, err := newTestClient(t, server.URL, Options{HTTPTimeout: &timeout})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		var values *url.Values
+		if method == http.MethodPost {
+			values = &url.Values{"save": {"Save"}}
+		}
+
+		start := time.Now()
+		_, err = pf.call(context.Background(), method, url.URL{Path: "slow.php"}, values)
+
+		var netErr net.Error
+		if !errors.Is(err, ErrFailedRequest) || !errors.As(err, &netErr) || !netErr.Timeout() {
+			t.Errorf("%s: expected timeout error, got %v", method, err)
+		}
+
+		if elapsed := time.Since(start); elapsed > 10*timeout {
+			t.Errorf("%s: expected request to time out after %s, took %s", method, timeout, elapsed)
+		}
+	}
+
+	// timed out reads are retried, timed out changes may have been applied and are not
+	if got := gets.Load(); got != int32(DefaultMaxAttempts) {
+		t.Errorf("expected %d GET attempts, got %d", DefaultMaxAttempts, got)
+	}
+
+	if got := posts.Load(); got != 1 {
+		t.Errorf("expected 1 POST attempt, got %d", got)
+	}
+}
+
+func TestCallRetriesFlakyServer(t *testing.T) {
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		var requests atomic.Int32
+		server := httptest.NewServer(testPfSenseHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// fails twice before succeeding
+			if requests.Add(1) <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			fmt.Fprint(w, testDashboardPage)
+		})))
+
+		var values *url.Values
+		if method == http.MethodPost {
+			values = &url.Values{"save": {"Save"}}
+		}
+
+		maxAttempts := 3
+		pf, err := newTestClient(t, server.URL, Options{MaxAttempts: &maxAttempts})
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		resp, err := pf.call(context.Background(), method, url.URL{Path: "flaky.php"}, values)
+		if err != nil {
+			t.Errorf("%s: expected success within %d attempts, got %s", method, maxAttempts, err)
+		} else {
+			resp.Body.Close()
+		}
+
+		if got := requests.Load(); got != 3 {
+			t.Errorf("%s: expected 3 attempts, got %d", method, got)
+		}
+
+		// one attempt short
+		requests.Store(0)
+		maxAttempts = 2
+
+		_, err = pf.call(context.Background(), method, url.URL{Path: "flaky.php"}, values)
+		if !errors.Is(err, ErrFailedRequest) || !errors.Is(err, ErrHTTPStatus) {
+			t.Errorf("%s: expected failed request after %d attempts, got %v", method, maxAttempts, err)
+		}
+
+		server.Close()
+	}
+}
+
+func TestNewClientAPIKey(t *testing.T) {
+	var logins atomic.Int32
+	var authorizations []string
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		authorizations = append(authorizations, r.Header.Get("Authorization"))
+		mutex.Unlock()
+
+		if r.Method == http.MethodPost && r.PostFormValue("usernamefld") != "" {
+			logins.Add(1)
+		}
+
+		if r.Header.Get("Authorization") != "key secret" {
+			fmt.Fprint(w, testLoginPage)
+			return
+		}
+
+		fmt.Fprint(w, testDashboardPage)
+	}))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{APIKey: "key", APISecret: "secret"})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	resp, err := pf.call(context.Background(), http.MethodPost, url.URL{Path: "diag_command.php"}, &url.Values{"submit": {"EXECPHP"}})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+	resp.Body.Close()
+
+	if got := logins.Load(); got != 0 {
+		t.Errorf("expected no login form submissions, got %d", got)
+	}
+
+	mutex.Lock()
+	got := slices.Clone(authorizations)
+	mutex.Unlock()
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(got))
+	}
+
+	for _, authorization := range got {
+		if authorization != "key secret" {
+			t.Errorf("expected authorization header 'key secret', got '%s'", authorization)
+		}
+	}
+
+	if pf.token != "sid:dashboard" {
+		t.Errorf("expected CSRF token to be scraped, got '%s'", pf.token)
+	}
+
+	// a rejected key is answered with the login page
+	_, err = newTestClient(t, server.URL, Options{APIKey: "key", APISecret: "wrong"})
+	if !errors.Is(err, ErrLoginFailed) {
+		t.Errorf("expected login failed, got %v", err)
+	}
+
+	if got := logins.Load(); got != 0 {
+		t.Errorf("expected no login form submissions, got %d", got)
+	}
+}
+
+func TestNewClientAPIKeyValidation(t *testing.T) {
+	tests := []Options{
+		{APIKey: "key"},
+		{APISecret: "secret"},
+		{Password: "pfsense", APIKey: "key"},
+	}
+
+	for _, opts := range tests {
+		opts.URL = &url.URL{}
+		if _, err := NewClient(context.Background(), &opts); !errors.Is(err, ErrClientValidation) {
+			t.Errorf("expected validation error for API key '%s' and secret '%s', got %v", opts.APIKey, opts.APISecret, err)
+		}
+	}
+}
+
+func TestNewClientHTTPProxy(t *testing.T) {
+	var proxied atomic.Int32
+
+	// requests for the unresolvable pfSense host only succeed through the proxy, which answers them itself
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Host != "pfsense.invalid" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		proxied.Add(1)
+		testPfSenseHandler(http.NotFoundHandler()).ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("unable to parse proxy URL, %s", err)
+	}
+
+	_, err = newTestClient(t, "http://pfsense.invalid", Options{HTTPProxy: proxyURL})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// initial token and login
+	if got := proxied.Load(); got != 2 {
+		t.Errorf("expected 2 proxied requests, got %d", got)
+	}
+}
+
+// testCACertificatePEM returns a self-signed CA certificate unrelated to the httptest server certificate.
+func testCACertificatePEM(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key, %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate, %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestNewClientCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(testPfSenseHandler(http.NotFoundHandler()))
+	defer server.Close()
+
+	serverCA := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	wrongCA := testCACertificatePEM(t)
+	maxAttempts := 1
+
+	tests := []struct {
+		name          string
+		caCertificate string
+		tlsSkipVerify bool
+		valid         bool
+	}{
+		{"good CA", serverCA, false, true},
+		{"good CA and skip verify", serverCA, true, true},
+		{"wrong CA", wrongCA, false, false},
+		{"wrong CA ignores skip verify", wrongCA, true, false},
+		{"system CAs", "", false, false},
+		{"skip verify", "", true, true},
+	}
+
+	for _, tt := range tests {
+		tlsSkipVerify := tt.tlsSkipVerify
+		_, err := newTestClient(t, server.URL, Options{
+			CACertificatePEM: tt.caCertificate,
+			TLSSkipVerify:    &tlsSkipVerify,
+			MaxAttempts:      &maxAttempts,
+		})
+
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		var certErr *tls.CertificateVerificationError
+		if !tt.valid && !errors.As(err, &certErr) {
+			t.Errorf("%s: expected certificate verification error, got %v", tt.name, err)
+		}
+	}
+
+	_, err := newTestClient(t, server.URL, Options{CACertificatePEM: "not a certificate"})
+	if !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected validation error for invalid CA certificate, got %v", err)
+	}
+}
+
+// testPHPCommandHandler answers diag_command.php with the output of run for the submitted PHP command.
+func testPHPCommandHandler(run func(command string) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/diag_command.php" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprintf(w, "<html><body><pre>%s</pre></body></html>", html.EscapeString(run(r.PostFormValue("txtPHPCommand"))))
+	})
+}
@@ -0,0 +1,65 @@
+package pfsense
+
+import (
+	"context"
+	"sync"
+)
+
+type coalescedCall struct {
+	done chan struct{}
+	err  error
+}
+
+// coalescer collapses concurrent calls with the same key. A call requested while another is running waits for it and
+// is shared by every caller that arrives before it starts, so any number of concurrent requests results in at most two
+// calls (the running one and a single follow-up that includes all changes made in the meantime). As the call is shared,
+// it runs with a context detached from the cancellation of the caller that started it.
+type coalescer struct {
+	mutex   sync.Mutex
+	keys    map[string]*sync.Mutex
+	pending map[string]*coalescedCall
+}
+
+func newCoalescer() *coalescer {
+	return &coalescer{
+		keys:    map[string]*sync.Mutex{},
+		pending: map[string]*coalescedCall{},
+	}
+}
+
+func (c *coalescer) do(ctx context.Context, key string, fn func(context.Context) error) error {
+	c.mutex.Lock()
+	if call, ok := c.pending[key]; ok {
+		c.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-call.done:
+			return call.err
+		}
+	}
+
+	call := &coalescedCall{done: make(chan struct{})}
+	c.pending[key] = call
+
+	keyMutex, ok := c.keys[key]
+	if !ok {
+		keyMutex = &sync.Mutex{}
+		c.keys[key] = keyMutex
+	}
+	c.mutex.Unlock()
+
+	keyMutex.Lock()
+	defer keyMutex.Unlock()
+
+	// once started, later callers must request a new call as their changes may not be included
+	c.mutex.Lock()
+	delete(c.pending, key)
+	c.mutex.Unlock()
+
+	call.err = fn(context.WithoutCancel(ctx))
+	close(call.done)
+
+	return call.err
+}
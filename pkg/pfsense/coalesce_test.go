@@ -0,0 +1,236 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCoalescerSingleCall(t *testing.T) {
+	c := newCoalescer()
+
+	var calls int
+	err := c.do(context.Background(), "lan", func(context.Context) error {
+		calls++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestCoalescerConcurrentCalls(t *testing.T) {
+	c := newCoalescer()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+
+	fn := func(context.Context) error {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+
+		return nil
+	}
+
+	// first call is running
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_ = c.do(context.Background(), "lan", fn)
+	}()
+	<-started
+
+	// later calls share a single follow-up call
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.do(context.Background(), "lan", fn)
+		}()
+	}
+
+	waitForPending(t, c, "lan")
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error, %s", err)
+		}
+	}
+
+	if got := calls.Load(); got != 2 {
+		t.Errorf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestCoalescerKeysIndependent(t *testing.T) {
+	c := newCoalescer()
+
+	release := make(chan struct{})
+	lanDone := make(chan struct{})
+	go func() {
+		defer close(lanDone)
+		_ = c.do(context.Background(), "lan", func(context.Context) error {
+			<-release
+			return nil
+		})
+	}()
+
+	err := c.do(context.Background(), "opt1", func(context.Context) error { return nil })
+	if err != nil {
+		t.Errorf("unexpected error, %s", err)
+	}
+
+	close(release)
+	<-lanDone
+}
+
+func TestCoalescerSharedError(t *testing.T) {
+	c := newCoalescer()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	wantErr := errors.New("apply failed")
+	var calls atomic.Int32
+
+	go func() {
+		_ = c.do(context.Background(), "lan", func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			errs <- c.do(context.Background(), "lan", func(context.Context) error {
+				calls.Add(1)
+				return wantErr
+			})
+		}()
+	}
+
+	waitForPending(t, c, "lan")
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	for range 2 {
+		if err := <-errs; !errors.Is(err, wantErr) {
+			t.Errorf("expected shared error, got %v", err)
+		}
+	}
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("expected 1 follow-up call, got %d", got)
+	}
+}
+
+func TestCoalescerCancelledWaiter(t *testing.T) {
+	c := newCoalescer()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		_ = c.do(context.Background(), "lan", func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// the caller that starts the follow-up call is cancelled, other callers sharing it are unaffected
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		first <- c.do(ctx, "lan", func(ctx context.Context) error {
+			return ctx.Err()
+		})
+	}()
+	waitForPending(t, c, "lan")
+
+	second := make(chan error, 1)
+	go func() {
+		second <- c.do(context.Background(), "lan", func(context.Context) error {
+			t.Error("follow-up call should be shared")
+			return nil
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cancel()
+	close(release)
+
+	if err := <-first; err != nil {
+		t.Errorf("expected call to run with a detached context, got %v", err)
+	}
+
+	if err := <-second; err != nil {
+		t.Errorf("expected shared call to succeed, got %v", err)
+	}
+}
+
+func TestCoalescerWaiterContext(t *testing.T) {
+	c := newCoalescer()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		_ = c.do(context.Background(), "lan", func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	go func() {
+		_ = c.do(context.Background(), "lan", func(context.Context) error { return nil })
+	}()
+	waitForPending(t, c, "lan")
+
+	// a waiting caller honours its own context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := c.do(ctx, "lan", func(context.Context) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled, got %v", err)
+	}
+}
+
+func waitForPending(t *testing.T, c *coalescer, key string) {
+	t.Helper()
+
+	for range 1000 {
+		c.mutex.Lock()
+		_, ok := c.pending[key]
+		c.mutex.Unlock()
+
+		if ok {
+			return
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatalf("no pending call for key '%s'", key)
+}
@@ -0,0 +1,34 @@
+package pfsense
+
+import (
+	"fmt"
+)
+
+// Control IDs are the array indexes pfSense uses to address entries (the 'id' form field). They shift whenever an
+// earlier entry is deleted, so they are always resolved by a natural key (name, description, tracker, etc.) from a
+// fresh read, while holding the relevant mutex, immediately before a submission and are never cached.
+
+// getControlID returns the control ID of the only item matching. An item's position is its control ID unless the item
+// carries its own (e.g. when the read skips entries). A key shared by several items is rejected, otherwise a change
+// could land on a neighbor.
+func getControlID[T any](items []T, match func(T) bool, controlID func(int, T) int, kind string, key string) (*int, error) {
+	var found []int
+	for i, item := range items {
+		if match(item) {
+			found = append(found, controlID(i, item))
+		}
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("%s %w with %s", kind, ErrNotFound, key)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, fmt.Errorf("%w, %d %ss with %s", ErrAmbiguous, len(found), kind, key)
+	}
+}
+
+func positionalControlID[T any](i int, _ T) int {
+	return i
+}
@@ -0,0 +1,46 @@
+package pfsense
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetControlID(t *testing.T) {
+	type item struct {
+		name      string
+		controlID int
+	}
+
+	items := []item{{"a", 3}, {"b", 5}, {"c", 8}, {"b", 9}}
+	match := func(name string) func(item) bool {
+		return func(i item) bool { return i.name == name }
+	}
+
+	tests := []struct {
+		name      string
+		controlID func(int, item) int
+		want      int
+		err       error
+	}{
+		{"a", positionalControlID[item], 0, nil},
+		{"c", positionalControlID[item], 2, nil},
+		{"c", func(_ int, i item) int { return i.controlID }, 8, nil},
+		{"b", positionalControlID[item], 0, ErrAmbiguous},
+		{"d", positionalControlID[item], 0, ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		got, err := getControlID(items, match(tt.name), tt.controlID, "item", tt.name)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("%s: expected %v, got %v", tt.name, tt.err, err)
+			}
+
+			continue
+		}
+
+		if err != nil || *got != tt.want {
+			t.Errorf("%s: getControlID() = %v (%v), want %d", tt.name, got, err, tt.want)
+		}
+	}
+}
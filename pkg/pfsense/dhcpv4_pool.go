@@ -0,0 +1,423 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+var (
+	ErrApplyDHCPv4Change = errors.New("failed to apply DHCPv4 changes")
+)
+
+type dhcpv4PoolResponse struct {
+	RangeFrom string `json:"from"`
+	RangeTo   string `json:"to"`
+}
+
+type dhcpv4AdditionalPoolResponse struct {
+	Range dhcpv4PoolResponse `json:"range"`
+}
+
+type interfaceAddressResponse struct {
+	IPAddress string `json:"ipaddr"`
+	Subnet    string `json:"subnet"`
+}
+
+type DHCPv4PoolRange struct {
+	RangeFrom netip.Addr
+	RangeTo   netip.Addr
+}
+
+type DHCPv4Pool struct {
+	Interface string
+	RangeFrom netip.Addr
+	RangeTo   netip.Addr
+	// AdditionalPools are left unchanged on update when nil.
+	AdditionalPools []DHCPv4PoolRange
+}
+
+func parseDHCPv4PoolAddress(addr string, name string) (netip.Addr, error) {
+	a, err := netip.ParseAddr(addr)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	if !a.Is4() {
+		return netip.Addr{}, fmt.Errorf("%w, %s must be an IPv4 address", ErrClientValidation, name)
+	}
+
+	return a, nil
+}
+
+func (r *DHCPv4PoolRange) SetRangeFrom(from string) error {
+	addr, err := parseDHCPv4PoolAddress(from, "range from")
+	if err != nil {
+		return err
+	}
+
+	r.RangeFrom = addr
+
+	return nil
+}
+
+func (r *DHCPv4PoolRange) SetRangeTo(to string) error {
+	addr, err := parseDHCPv4PoolAddress(to, "range to")
+	if err != nil {
+		return err
+	}
+
+	r.RangeTo = addr
+
+	return nil
+}
+
+func (r DHCPv4PoolRange) overlaps(other DHCPv4PoolRange) bool {
+	return !r.RangeTo.Less(other.RangeFrom) && !other.RangeTo.Less(r.RangeFrom)
+}
+
+func (pool *DHCPv4Pool) SetInterface(iface string) error {
+	var isValidInterface = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	if !isValidInterface(iface) {
+		return fmt.Errorf("%w, interface must be an interface name (e.g. 'lan', 'opt1')", ErrClientValidation)
+	}
+
+	pool.Interface = iface
+
+	return nil
+}
+
+func (pool *DHCPv4Pool) SetRangeFrom(from string) error {
+	addr, err := parseDHCPv4PoolAddress(from, "range from")
+	if err != nil {
+		return err
+	}
+
+	pool.RangeFrom = addr
+
+	return nil
+}
+
+func (pool *DHCPv4Pool) SetRangeTo(to string) error {
+	addr, err := parseDHCPv4PoolAddress(to, "range to")
+	if err != nil {
+		return err
+	}
+
+	pool.RangeTo = addr
+
+	return nil
+}
+
+func (pool *DHCPv4Pool) SetAdditionalPools(ranges []DHCPv4PoolRange) error {
+	pool.AdditionalPools = ranges
+
+	return nil
+}
+
+func (pool DHCPv4Pool) primaryRange() DHCPv4PoolRange {
+	return DHCPv4PoolRange{RangeFrom: pool.RangeFrom, RangeTo: pool.RangeTo}
+}
+
+// validate checks every range is ordered, within the subnet of the interface, and does not overlap another range.
+func (pool DHCPv4Pool) validate(subnet netip.Prefix) error {
+	ranges := append([]DHCPv4PoolRange{pool.primaryRange()}, pool.AdditionalPools...)
+
+	for i, r := range ranges {
+		if r.RangeTo.Less(r.RangeFrom) {
+			return fmt.Errorf("%w, range from '%s' must not be greater than range to '%s'", ErrClientValidation, r.RangeFrom, r.RangeTo)
+		}
+
+		if !subnet.Contains(r.RangeFrom) || !subnet.Contains(r.RangeTo) {
+			return fmt.Errorf("%w, range '%s-%s' must be within interface '%s' subnet '%s'", ErrClientValidation, r.RangeFrom, r.RangeTo, pool.Interface, subnet)
+		}
+
+		for _, other := range ranges[:i] {
+			if r.overlaps(other) {
+				return fmt.Errorf("%w, range '%s-%s' must not overlap range '%s-%s'", ErrClientValidation, r.RangeFrom, r.RangeTo, other.RangeFrom, other.RangeTo)
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseDHCPv4AdditionalPoolsResponse(b []byte) ([]DHCPv4PoolRange, error) {
+	var poolsResp []dhcpv4AdditionalPoolResponse
+	err := json.Unmarshal(b, &poolsResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 additional pools response, %w", ErrUnableToParse, err)
+	}
+
+	ranges := []DHCPv4PoolRange{}
+	for _, poolResp := range poolsResp {
+		var r DHCPv4PoolRange
+
+		err = r.SetRangeFrom(poolResp.Range.RangeFrom)
+		if err != nil {
+			return nil, fmt.Errorf("%w DHCPv4 additional pool response, %w", ErrUnableToParse, err)
+		}
+
+		err = r.SetRangeTo(poolResp.Range.RangeTo)
+		if err != nil {
+			return nil, fmt.Errorf("%w DHCPv4 additional pool response, %w", ErrUnableToParse, err)
+		}
+
+		ranges = append(ranges, r)
+	}
+
+	return ranges, nil
+}
+
+func (pf *Client) getInterfaceSubnet(ctx context.Context, iface string) (*netip.Prefix, error) {
+	b, err := pf.getConfigJSON(ctx, fmt.Sprintf("['interfaces']['%s']", iface))
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaceResp interfaceAddressResponse
+	err = json.Unmarshal(b, &ifaceResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w interface '%s', %w", ErrNotFound, iface, err)
+	}
+
+	addr, err := netip.ParseAddr(ifaceResp.IPAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w interface '%s' address, %w", ErrUnableToParse, iface, err)
+	}
+
+	bits, err := strconv.Atoi(ifaceResp.Subnet)
+	if err != nil {
+		return nil, fmt.Errorf("%w interface '%s' subnet, %w", ErrUnableToParse, iface, err)
+	}
+
+	subnet, err := addr.Prefix(bits)
+	if err != nil {
+		return nil, fmt.Errorf("%w interface '%s' subnet, %w", ErrUnableToParse, iface, err)
+	}
+
+	return &subnet, nil
+}
+
+func (pf *Client) getDHCPv4Pool(ctx context.Context, iface string) (*DHCPv4Pool, error) {
+	var pool DHCPv4Pool
+
+	err := pool.SetInterface(iface)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := pf.getConfigJSON(ctx, fmt.Sprintf("['dhcpd']['%s']['range']", iface))
+	if err != nil {
+		return nil, err
+	}
+
+	var poolResp dhcpv4PoolResponse
+	err = json.Unmarshal(b, &poolResp)
+	if err != nil {
+		return nil, fmt.Errorf("DHCPv4 pool %w for interface '%s'", ErrNotFound, iface)
+	}
+
+	err = pool.SetRangeFrom(poolResp.RangeFrom)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool response, %w", ErrUnableToParse, err)
+	}
+
+	err = pool.SetRangeTo(poolResp.RangeTo)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool response, %w", ErrUnableToParse, err)
+	}
+
+	b, err = pf.getConfigJSON(ctx, fmt.Sprintf("['dhcpd']['%s']['pool']", iface))
+	if err != nil {
+		return nil, err
+	}
+
+	ranges, err := parseDHCPv4AdditionalPoolsResponse(b)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pool.SetAdditionalPools(ranges)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool response, %w", ErrUnableToParse, err)
+	}
+
+	return &pool, nil
+}
+
+// saveDHCPv4PoolForm submits a range on the DHCP server page, the query selects the primary range or an additional pool.
+func (pf *Client) saveDHCPv4PoolForm(ctx context.Context, q url.Values, r DHCPv4PoolRange) error {
+	u := url.URL{Path: "services_dhcp.php"}
+	u.RawQuery = q.Encode()
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	for key := range q {
+		v.Set(key, q.Get(key))
+	}
+	v.Set("range_from", r.RangeFrom.String())
+	v.Set("range_to", r.RangeTo.String())
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) deleteDHCPv4AdditionalPool(ctx context.Context, iface string, index int) error {
+	u := url.URL{Path: "services_dhcp.php"}
+	v := url.Values{
+		"if":  {iface},
+		"act": {"delpool"},
+		"id":  {strconv.Itoa(index)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+// deleteExtraDHCPv4AdditionalPools removes pools from the end, this happens before the primary range is saved to
+// avoid overlapping a range that is about to be removed.
+func (pf *Client) deleteExtraDHCPv4AdditionalPools(ctx context.Context, iface string, current []DHCPv4PoolRange, desired []DHCPv4PoolRange) error {
+	for i := len(current) - 1; i >= len(desired); i-- {
+		err := pf.deleteDHCPv4AdditionalPool(ctx, iface, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateDHCPv4AdditionalPools matches pools by position, changed pools are edited and missing pools are added.
+func (pf *Client) updateDHCPv4AdditionalPools(ctx context.Context, iface string, current []DHCPv4PoolRange, desired []DHCPv4PoolRange) error {
+	for i, r := range desired {
+		q := url.Values{"if": {iface}}
+
+		if i < len(current) {
+			if current[i] == r {
+				continue
+			}
+
+			q.Set("pool", strconv.Itoa(i))
+		} else {
+			q.Set("act", "newpool")
+		}
+
+		err := pf.saveDHCPv4PoolForm(ctx, q, r)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pf *Client) GetDHCPv4Pool(ctx context.Context, iface string) (*DHCPv4Pool, error) {
+	pf.mutexes.DHCPv4.Lock()
+	defer pf.mutexes.DHCPv4.Unlock()
+
+	pool, err := pf.getDHCPv4Pool(ctx, iface)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool (interface '%s'), %w", ErrGetOperationFailed, iface, err)
+	}
+
+	return pool, nil
+}
+
+func (pf *Client) UpdateDHCPv4Pool(ctx context.Context, poolReq DHCPv4Pool) (*DHCPv4Pool, error) {
+	pf.mutexes.DHCPv4.Lock()
+	defer pf.mutexes.DHCPv4.Unlock()
+
+	subnet, err := pf.getInterfaceSubnet(ctx, poolReq.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	current, err := pf.getDHCPv4Pool(ctx, poolReq.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	if poolReq.AdditionalPools == nil {
+		poolReq.AdditionalPools = current.AdditionalPools
+	}
+
+	err = poolReq.validate(*subnet)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = pf.deleteExtraDHCPv4AdditionalPools(ctx, poolReq.Interface, current.AdditionalPools, poolReq.AdditionalPools)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = pf.saveDHCPv4PoolForm(ctx, url.Values{"if": {poolReq.Interface}}, poolReq.primaryRange())
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = pf.updateDHCPv4AdditionalPools(ctx, poolReq.Interface, current.AdditionalPools, poolReq.AdditionalPools)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	pool, err := pf.getDHCPv4Pool(ctx, poolReq.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("%w DHCPv4 pool, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return pool, nil
+}
+
+// ApplyDHCPv4Changes restarts the DHCP server, concurrent calls for the same interface are coalesced.
+func (pf *Client) ApplyDHCPv4Changes(ctx context.Context, iface string) error {
+	return pf.applies.DHCPv4.do(ctx, iface, func(ctx context.Context) error {
+		return pf.applyDHCPv4Changes(ctx, iface)
+	})
+}
+
+func (pf *Client) applyDHCPv4Changes(ctx context.Context, iface string) error {
+	// coalescing is per interface, applies for different interfaces must not restart the DHCP server concurrently
+	pf.mutexes.DHCPv4Apply.Lock()
+	defer pf.mutexes.DHCPv4Apply.Unlock()
+
+	u := url.URL{Path: "services_dhcp.php"}
+	q := u.Query()
+	q.Set("if", iface)
+	u.RawQuery = q.Encode()
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyDHCPv4Change, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
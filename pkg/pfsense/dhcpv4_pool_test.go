@@ -0,0 +1,85 @@
+package pfsense
+
+import (
+	"errors"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func testDHCPv4PoolRange(from string, to string) DHCPv4PoolRange {
+	return DHCPv4PoolRange{RangeFrom: netip.MustParseAddr(from), RangeTo: netip.MustParseAddr(to)}
+}
+
+func TestDHCPv4PoolValidate(t *testing.T) {
+	subnet := netip.MustParsePrefix("192.168.1.0/24")
+
+	tests := []struct {
+		name            string
+		primary         DHCPv4PoolRange
+		additionalPools []DHCPv4PoolRange
+		valid           bool
+	}{
+		{"primary", testDHCPv4PoolRange("192.168.1.100", "192.168.1.199"), nil, true},
+		{"single address", testDHCPv4PoolRange("192.168.1.100", "192.168.1.100"), nil, true},
+		{"primary reversed", testDHCPv4PoolRange("192.168.1.199", "192.168.1.100"), nil, false},
+		{"primary outside subnet", testDHCPv4PoolRange("192.168.1.100", "192.168.2.10"), nil, false},
+		{"secondary pool", testDHCPv4PoolRange("192.168.1.100", "192.168.1.149"), []DHCPv4PoolRange{testDHCPv4PoolRange("192.168.1.200", "192.168.1.219")}, true},
+		{"secondary pool reversed", testDHCPv4PoolRange("192.168.1.100", "192.168.1.149"), []DHCPv4PoolRange{testDHCPv4PoolRange("192.168.1.219", "192.168.1.200")}, false},
+		{"secondary pool outside subnet", testDHCPv4PoolRange("192.168.1.100", "192.168.1.149"), []DHCPv4PoolRange{testDHCPv4PoolRange("10.0.0.1", "10.0.0.10")}, false},
+		{"secondary pool overlaps primary", testDHCPv4PoolRange("192.168.1.100", "192.168.1.149"), []DHCPv4PoolRange{testDHCPv4PoolRange("192.168.1.149", "192.168.1.160")}, false},
+		{"secondary pools overlap", testDHCPv4PoolRange("192.168.1.100", "192.168.1.149"), []DHCPv4PoolRange{testDHCPv4PoolRange("192.168.1.200", "192.168.1.219"), testDHCPv4PoolRange("192.168.1.150", "192.168.1.200")}, false},
+		{"adjacent pools", testDHCPv4PoolRange("192.168.1.100", "192.168.1.149"), []DHCPv4PoolRange{testDHCPv4PoolRange("192.168.1.150", "192.168.1.199")}, true},
+	}
+
+	for _, tt := range tests {
+		pool := DHCPv4Pool{Interface: "lan", RangeFrom: tt.primary.RangeFrom, RangeTo: tt.primary.RangeTo, AdditionalPools: tt.additionalPools}
+
+		err := pool.validate(subnet)
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s: expected validation error, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestParseDHCPv4AdditionalPoolsResponse(t *testing.T) {
+	tests := []struct {
+		name  string
+		resp  string
+		want  []DHCPv4PoolRange
+		valid bool
+	}{
+		{"no pools", `null`, []DHCPv4PoolRange{}, true},
+		{
+			"secondary pools",
+			`[{"range": {"from": "192.168.1.200", "to": "192.168.1.219"}, "domain": "lan"}, {"range": {"from": "192.168.1.230", "to": "192.168.1.239"}}]`,
+			[]DHCPv4PoolRange{testDHCPv4PoolRange("192.168.1.200", "192.168.1.219"), testDHCPv4PoolRange("192.168.1.230", "192.168.1.239")},
+			true,
+		},
+		{"invalid address", `[{"range": {"from": "fd00::1", "to": "192.168.1.219"}}]`, nil, false},
+	}
+
+	for _, tt := range tests {
+		got, err := parseDHCPv4AdditionalPoolsResponse([]byte(tt.resp))
+		if !tt.valid {
+			if !errors.Is(err, ErrUnableToParse) {
+				t.Errorf("%s: expected parse error, got %v", tt.name, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+			continue
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
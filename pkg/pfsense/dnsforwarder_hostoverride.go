@@ -0,0 +1,227 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+var (
+	ErrApplyDNSForwarderChange = errors.New("failed to apply DNS forwarder changes")
+)
+
+func (pf *Client) getDNSForwarderHostFQDNs(ctx context.Context) ([]string, error) {
+	command := "$output = array();" +
+		"if (is_array($config['dnsmasq']['hosts'])) {" +
+		"foreach ($config['dnsmasq']['hosts'] as $h) {" +
+		"array_push($output, implode('.', array_filter(array($h['host'], $h['domain']), 'strlen')));" +
+		"if (is_array($h['aliases']['item'])) {" +
+		"foreach ($h['aliases']['item'] as $a) { array_push($output, implode('.', array_filter(array($a['host'], $a['domain']), 'strlen'))); }" +
+		"}}}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var fqdns []string
+	err = json.Unmarshal(b, &fqdns)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	return fqdns, nil
+}
+
+// GetDNSForwarderHostFQDNs returns the FQDNs (including aliases) of all DNS forwarder (dnsmasq) host overrides.
+func (pf *Client) GetDNSForwarderHostFQDNs(ctx context.Context) ([]string, error) {
+	fqdns, err := pf.getDNSForwarderHostFQDNs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host overrides, %w", ErrGetOperationFailed, err)
+	}
+
+	return fqdns, nil
+}
+
+func (pf *Client) getDNSForwarderHostOverrides(ctx context.Context) (*HostOverrides, error) {
+	b, err := pf.getConfigJSON(ctx, "['dnsmasq']['hosts']")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseHostOverridesResponse(b)
+}
+
+func (pf *Client) GetDNSForwarderHostOverrides(ctx context.Context) (*HostOverrides, error) {
+	pf.mutexes.DNSForwarderHostOverride.Lock()
+	defer pf.mutexes.DNSForwarderHostOverride.Unlock()
+
+	hostOverrides, err := pf.getDNSForwarderHostOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host overrides, %w", ErrGetOperationFailed, err)
+	}
+
+	return hostOverrides, nil
+}
+
+func (pf *Client) GetDNSForwarderHostOverride(ctx context.Context, fqdn string) (*HostOverride, error) {
+	pf.mutexes.DNSForwarderHostOverride.Lock()
+	defer pf.mutexes.DNSForwarderHostOverride.Unlock()
+
+	hostOverrides, err := pf.getDNSForwarderHostOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host override (FQDN '%s'), %w", ErrGetOperationFailed, fqdn, err)
+	}
+
+	return hostOverrides.GetByFQDN(fqdn)
+}
+
+func (pf *Client) createOrUpdateDNSForwarderHostOverride(ctx context.Context, hostOverrideReq HostOverride, controlID *int) (*HostOverride, error) {
+	// unlike the resolver, the forwarder accepts a single address per host
+	if len(hostOverrideReq.IPAddresses) != 1 {
+		return nil, fmt.Errorf("%w, DNS forwarder host override requires exactly one IP address", ErrClientValidation)
+	}
+
+	u := url.URL{Path: "services_dnsmasq_edit.php"}
+	v := url.Values{
+		"host":   {hostOverrideReq.Host},
+		"domain": {hostOverrideReq.Domain},
+		"ip":     {hostOverrideReq.formatIPAddresses()},
+		"descr":  {hostOverrideReq.Description},
+		"save":   {"Save"},
+	}
+
+	for i, alias := range hostOverrideReq.Aliases {
+		v.Set(fmt.Sprintf("aliashost%d", i), alias.Host)
+		v.Set(fmt.Sprintf("aliasdomain%d", i), alias.Domain)
+		v.Set(fmt.Sprintf("aliasdescription%d", i), alias.Description)
+	}
+
+	if controlID != nil {
+		q := u.Query()
+		q.Set("id", strconv.Itoa(*controlID))
+		u.RawQuery = q.Encode()
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	hostOverrides, err := pf.getDNSForwarderHostOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hostOverride, err := hostOverrides.GetByFQDN(hostOverrideReq.FQDN())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, aliasReq := range hostOverrideReq.Aliases {
+		if !hostOverride.hasAlias(aliasReq.FQDN()) {
+			return nil, fmt.Errorf("%w, alias '%s' not saved", ErrServerValidation, aliasReq.FQDN())
+		}
+	}
+
+	return hostOverride, nil
+}
+
+func (pf *Client) CreateDNSForwarderHostOverride(ctx context.Context, hostOverrideReq HostOverride) (*HostOverride, error) {
+	pf.mutexes.DNSForwarderHostOverride.Lock()
+	defer pf.mutexes.DNSForwarderHostOverride.Unlock()
+
+	hostOverride, err := pf.createOrUpdateDNSForwarderHostOverride(ctx, hostOverrideReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host override, %w", ErrCreateOperationFailed, err)
+	}
+
+	return hostOverride, nil
+}
+
+func (pf *Client) UpdateDNSForwarderHostOverride(ctx context.Context, hostOverrideReq HostOverride) (*HostOverride, error) {
+	pf.mutexes.DNSForwarderHostOverride.Lock()
+	defer pf.mutexes.DNSForwarderHostOverride.Unlock()
+
+	hostOverrides, err := pf.getDNSForwarderHostOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host override, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := hostOverrides.GetControlIDByFQDN(hostOverrideReq.FQDN())
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host override, %w", ErrUpdateOperationFailed, err)
+	}
+
+	hostOverride, err := pf.createOrUpdateDNSForwarderHostOverride(ctx, hostOverrideReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host override, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = checkDifferences(hostOverrideReq.Differences(*hostOverride))
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS forwarder host override, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return hostOverride, nil
+}
+
+func (pf *Client) DeleteDNSForwarderHostOverride(ctx context.Context, fqdn string) error {
+	pf.mutexes.DNSForwarderHostOverride.Lock()
+	defer pf.mutexes.DNSForwarderHostOverride.Unlock()
+
+	hostOverrides, err := pf.getDNSForwarderHostOverrides(ctx)
+	if err != nil {
+		return fmt.Errorf("%w DNS forwarder host override, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := hostOverrides.GetControlIDByFQDN(fqdn)
+	if err != nil {
+		return fmt.Errorf("%w DNS forwarder host override, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "services_dnsmasq.php"}
+	v := url.Values{
+		"type": {"host"},
+		"act":  {"del"},
+		"id":   {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w DNS forwarder host override, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) ApplyDNSForwarderChanges(ctx context.Context) error {
+	pf.mutexes.DNSForwarderApply.Lock()
+	defer pf.mutexes.DNSForwarderApply.Unlock()
+
+	u := url.URL{Path: "services_dnsmasq.php"}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyDNSForwarderChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
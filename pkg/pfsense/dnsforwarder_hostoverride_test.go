@@ -0,0 +1,121 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestCreateDNSForwarderHostOverrideAliases(t *testing.T) {
+	var mutex sync.Mutex
+	stored := []map[string]any{{"host": "db", "domain": "lan", "ip": "192.168.1.5", "descr": ""}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services_dnsmasq_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		_ = r.ParseForm()
+
+		var aliases []map[string]string
+		for i := 0; r.PostForm.Has(fmt.Sprintf("aliasdomain%d", i)); i++ {
+			aliases = append(aliases, map[string]string{
+				"host":        r.PostFormValue(fmt.Sprintf("aliashost%d", i)),
+				"domain":      r.PostFormValue(fmt.Sprintf("aliasdomain%d", i)),
+				"description": r.PostFormValue(fmt.Sprintf("aliasdescription%d", i)),
+			})
+		}
+
+		stored = append(stored, map[string]any{
+			"host":    r.PostFormValue("host"),
+			"domain":  r.PostFormValue("domain"),
+			"ip":      r.PostFormValue("ip"),
+			"descr":   r.PostFormValue("descr"),
+			"aliases": map[string]any{"item": aliases},
+		})
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var hostOverrideReq HostOverride
+	_ = hostOverrideReq.SetHost("nas")
+	_ = hostOverrideReq.SetDomain("lan")
+	_ = hostOverrideReq.SetIPAddresses([]string{"192.168.1.10"})
+	_ = hostOverrideReq.SetDescription("storage")
+
+	var files, backup HostOverrideAlias
+	_ = files.SetHost("files")
+	_ = files.SetDomain("lan")
+	_ = backup.SetHost("backup")
+	_ = backup.SetDomain("example.com")
+	_ = backup.SetDescription("offsite name")
+	hostOverrideReq.Aliases = []HostOverrideAlias{files, backup}
+
+	hostOverride, err := pf.CreateDNSForwarderHostOverride(context.Background(), hostOverrideReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if differences := hostOverrideReq.Differences(*hostOverride); len(differences) != 0 {
+		t.Errorf("unexpected host override, %v", differences)
+	}
+
+	// the host override is read by FQDN, aliases are not host overrides of their own
+	hostOverride, err = pf.GetDNSForwarderHostOverride(context.Background(), "nas.lan")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if !slices.Equal(hostOverride.FQDNs(), []string{"nas.lan", "files.lan", "backup.example.com"}) {
+		t.Errorf("FQDNs() = %v", hostOverride.FQDNs())
+	}
+
+	if _, err := pf.GetDNSForwarderHostOverride(context.Background(), "files.lan"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestCreateDNSForwarderHostOverrideSingleAddress(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services_dnsmasq_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("expected host override with two addresses to be refused before submitting")
+	})
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var hostOverrideReq HostOverride
+	_ = hostOverrideReq.SetHost("nas")
+	_ = hostOverrideReq.SetDomain("lan")
+	_ = hostOverrideReq.SetIPAddresses([]string{"192.168.1.10", "fd00::10"})
+
+	_, err = pf.CreateDNSForwarderHostOverride(context.Background(), hostOverrideReq)
+	if !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+}
@@ -2,15 +2,23 @@ package pfsense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"time"
+)
+
+const (
+	DefaultDNSResolverWaitTimeout = 30 * time.Second
+	dnsResolverWaitInterval       = time.Second
 )
 
 var (
 	ErrApplyDNSResolverChange = errors.New("failed to apply DNS resolver changes")
+	ErrDNSResolverNotRunning  = errors.New("DNS resolver not running")
 )
 
 func (pf *Client) ApplyDNSResolverChanges(ctx context.Context) error {
@@ -32,3 +40,44 @@ func (pf *Client) ApplyDNSResolverChanges(ctx context.Context) error {
 
 	return nil
 }
+
+func (pf *Client) isDNSResolverRunning(ctx context.Context) (bool, error) {
+	b, err := pf.runPHPCommand(ctx, "print_r(json_encode(is_service_running('unbound')));")
+	if err != nil {
+		return false, err
+	}
+
+	var running bool
+	err = json.Unmarshal(b, &running)
+	if err != nil {
+		return false, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	return running, nil
+}
+
+// WaitForDNSResolver polls until the DNS resolver (Unbound) is running, for use after applying changes that restart
+// the service. Waits until the context deadline, or DefaultDNSResolverWaitTimeout when the context has none.
+func (pf *Client) WaitForDNSResolver(ctx context.Context) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, DefaultDNSResolverWaitTimeout)
+		defer cancel()
+	}
+
+	for {
+		running, err := pf.isDNSResolverRunning(ctx)
+		if err == nil && running {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if err != nil {
+				return fmt.Errorf("%w, %w", ErrDNSResolverNotRunning, err)
+			}
+			return fmt.Errorf("%w, %w", ErrDNSResolverNotRunning, ctx.Err())
+		case <-time.After(dnsResolverWaitInterval):
+		}
+	}
+}
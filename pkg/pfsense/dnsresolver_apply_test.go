@@ -0,0 +1,146 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitForDNSResolver(t *testing.T) {
+	var polls atomic.Int32
+	var runningAfter atomic.Int32
+	var invalid atomic.Bool
+
+	// unbound is restarting until the given number of polls
+	server := httptest.NewServer(testPfSenseHandler(testPHPCommandHandler(func(command string) string {
+		if invalid.Load() {
+			return "unknown"
+		}
+
+		if polls.Add(1) > runningAfter.Load() {
+			return "true"
+		}
+
+		return "false"
+	})))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// not running, then running
+	runningAfter.Store(1)
+	err = pf.WaitForDNSResolver(context.Background())
+	if err != nil {
+		t.Errorf("unexpected error, %s", err)
+	}
+
+	if got := polls.Load(); got != 2 {
+		t.Errorf("expected 2 polls, got %d", got)
+	}
+
+	// never running within the context deadline
+	polls.Store(0)
+	runningAfter.Store(1000)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = pf.WaitForDNSResolver(ctx)
+	if !errors.Is(err, ErrDNSResolverNotRunning) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected not running error, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > DefaultDNSResolverWaitTimeout/2 {
+		t.Errorf("expected wait to end at the context deadline, took %s", elapsed)
+	}
+
+	// invalid status responses are reported once waiting ends
+	invalid.Store(true)
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = pf.WaitForDNSResolver(ctx)
+	if !errors.Is(err, ErrDNSResolverNotRunning) || !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+}
+
+func TestDNSResolverHostOverridesSingleApply(t *testing.T) {
+	var mutex sync.Mutex
+	var stored []map[string]any
+	var applies atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services_unbound_host_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		stored = append(stored, map[string]any{"host": r.PostFormValue("host"), "domain": r.PostFormValue("domain"), "ip": r.PostFormValue("ip")})
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.HandleFunc("/services_unbound.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("apply") != "" {
+			applies.Add(1)
+		}
+
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// saving overrides only stages the change, the resolver restarts when changes are applied
+	for i := range 20 {
+		var hostOverrideReq HostOverride
+		_ = hostOverrideReq.SetHost(fmt.Sprintf("host%d", i))
+		_ = hostOverrideReq.SetDomain("example.com")
+		_ = hostOverrideReq.SetIPAddresses([]string{fmt.Sprintf("10.0.0.%d", i+1)})
+
+		if _, err := pf.CreateDNSResolverHostOverride(context.Background(), hostOverrideReq); err != nil {
+			t.Fatalf("host%d: unexpected error, %s", i, err)
+		}
+	}
+
+	if got := applies.Load(); got != 0 {
+		t.Errorf("expected no apply before the grouped apply, got %d", got)
+	}
+
+	if err := pf.ApplyDNSResolverChanges(context.Background()); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if got := applies.Load(); got != 1 {
+		t.Errorf("expected 1 apply, got %d", got)
+	}
+
+	hostOverrides, err := pf.GetDNSResolverHostOverrides(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(*hostOverrides) != 20 {
+		t.Errorf("expected 20 host overrides, got %d", len(*hostOverrides))
+	}
+}
@@ -15,6 +15,8 @@ import (
 const (
 	dnsResolverConfigFileDir = "/var/unbound/conf.d"
 	dnsResolverConfigFileExt = "conf"
+	dnsResolverConfigPath    = "/var/unbound/unbound.conf"
+	dnsResolverCheckConfPath = "/usr/local/sbin/unbound-checkconf"
 )
 
 type configFileResponse struct {
@@ -179,10 +181,10 @@ func (pf *Client) UpdateDNSResolverConfigFile(ctx context.Context, configFileReq
 	return cf, nil
 }
 
-func (pf *Client) DeleteDNSResolverConfigFile(ctx context.Context, name string) error {
+func (pf *Client) deleteDNSResolverConfigFile(ctx context.Context, name string) error {
 	var cf ConfigFile
 	if err := cf.SetName(name); err != nil {
-		return fmt.Errorf("%w config file, %w", ErrDeleteOperationFailed, err)
+		return err
 	}
 
 	u := url.URL{Path: "diag_command.php"}
@@ -192,9 +194,156 @@ func (pf *Client) DeleteDNSResolverConfigFile(ctx context.Context, name string)
 	}
 
 	_, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (pf *Client) DeleteDNSResolverConfigFile(ctx context.Context, name string) error {
+	err := pf.deleteDNSResolverConfigFile(ctx, name)
 	if err != nil {
 		return fmt.Errorf("%w config file, %w", ErrDeleteOperationFailed, err)
 	}
 
 	return nil
 }
+
+// pruneNames returns the previously written config file names that are no longer requested.
+func (cfs ConfigFiles) pruneNames(prevNames []string) ([]string, error) {
+	names := []string{}
+	for _, name := range prevNames {
+		var cf ConfigFile
+		if err := cf.SetName(name); err != nil {
+			return nil, err
+		}
+
+		if _, err := cfs.GetByName(name); err == nil {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+// ReplaceDNSResolverConfigFiles writes the requested config files and removes the previously written files that are no
+// longer requested. Other files in the config file directory are left unchanged.
+func (pf *Client) ReplaceDNSResolverConfigFiles(ctx context.Context, configFilesReq ConfigFiles, prevNames []string) (*ConfigFiles, error) {
+	pruneNames, err := configFilesReq.pruneNames(prevNames)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	configFiles, err := pf.getDNSResolverConfigFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	for _, name := range pruneNames {
+		if _, err := configFiles.GetByName(name); err != nil {
+			continue
+		}
+
+		err = pf.deleteDNSResolverConfigFile(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+		}
+	}
+
+	for _, configFileReq := range configFilesReq {
+		if configFile, err := configFiles.GetByName(configFileReq.Name); err == nil && configFile.Content == configFileReq.Content {
+			continue
+		}
+
+		_, err = pf.createOrUpdateDNSResolverConfigFile(ctx, configFileReq)
+		if err != nil {
+			return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+		}
+	}
+
+	configFiles, err = pf.getDNSResolverConfigFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return configFiles, nil
+}
+
+// ReplaceDNSResolverConfigFilesValidated writes the requested config files and removes the previously written files that
+// are no longer requested in a single step, then validates the combined resolver config. On failure the affected config
+// files are restored.
+func (pf *Client) ReplaceDNSResolverConfigFilesValidated(ctx context.Context, configFilesReq ConfigFiles, prevNames []string) (*ConfigFiles, error) {
+	pruneNames, err := configFilesReq.pruneNames(prevNames)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	req := []configFileResponse{}
+	for _, configFileReq := range configFilesReq {
+		req = append(req, configFileResponse{Name: configFileReq.Name, Content: configFileReq.Content})
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	pruneJSON, err := json.Marshal(pruneNames)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	command := fmt.Sprintf("$req = json_decode(base64_decode('%s'), true);", base64.StdEncoding.EncodeToString(reqJSON)) +
+		fmt.Sprintf("$prune = json_decode(base64_decode('%s'), true);", base64.StdEncoding.EncodeToString(pruneJSON)) +
+		fmt.Sprintf("$path = function ($name) { return '%s/' . $name . '.%s'; };", dnsResolverConfigFileDir, dnsResolverConfigFileExt) +
+		"$backup = array();" +
+		"foreach (array_merge(array_column($req, 'name'), $prune) as $n) { if (file_exists($path($n))) { $backup[$path($n)] = file_get_contents($path($n)); } }" +
+		"foreach ($prune as $n) { @unlink($path($n)); }" +
+		"foreach ($req as $cf) { file_put_contents($path($cf['name']), $cf['content']); }" +
+		fmt.Sprintf("exec('%s %s 2>&1', $output, $rc);", dnsResolverCheckConfPath, dnsResolverConfigPath) +
+		"if ($rc !== 0) {" +
+		"foreach ($req as $cf) { @unlink($path($cf['name'])); }" +
+		"foreach ($backup as $f => $c) { file_put_contents($f, $c); }" +
+		"}" +
+		"print_r(json_encode(array('valid' => $rc === 0, 'output' => implode(\"\\n\", $output))));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	var resp struct {
+		Valid  bool   `json:"valid"`
+		Output string `json:"output"`
+	}
+
+	err = json.Unmarshal(b, &resp)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w, %w", ErrUpdateOperationFailed, ErrUnableToParse, err)
+	}
+
+	if !resp.Valid {
+		return nil, fmt.Errorf("%w config files, %w '%s'", ErrUpdateOperationFailed, ErrServerValidation, strings.TrimSpace(resp.Output))
+	}
+
+	configFiles, err := pf.getDNSResolverConfigFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w config files, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return configFiles, nil
+}
+
+func (pf *Client) DeleteDNSResolverConfigFiles(ctx context.Context, names []string) error {
+	for _, name := range names {
+		err := pf.deleteDNSResolverConfigFile(ctx, name)
+		if err != nil {
+			return fmt.Errorf("%w config files, %w", ErrDeleteOperationFailed, err)
+		}
+	}
+
+	return nil
+}
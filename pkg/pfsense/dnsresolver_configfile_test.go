@@ -0,0 +1,254 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConfigFilesPruneNames(t *testing.T) {
+	configFiles := ConfigFiles{{Name: "kept"}, {Name: "added"}}
+
+	tests := []struct {
+		prevNames []string
+		want      []string
+		valid     bool
+	}{
+		{nil, []string{}, true},
+		{[]string{"kept"}, []string{}, true},
+		{[]string{"kept", "removed"}, []string{"removed"}, true},
+		{[]string{"removed", "other-removed"}, []string{"removed", "other-removed"}, true},
+		{[]string{"../unbound"}, nil, false},
+	}
+
+	for _, tt := range tests {
+		got, err := configFiles.pruneNames(tt.prevNames)
+		if tt.valid && err != nil {
+			t.Errorf("pruneNames(%v) unexpected error, %s", tt.prevNames, err)
+		}
+
+		if !tt.valid && err == nil {
+			t.Errorf("pruneNames(%v) expected error", tt.prevNames)
+		}
+
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("pruneNames(%v) = %v, want %v", tt.prevNames, got, tt.want)
+		}
+	}
+}
+
+// testConfigFileServer serves a config file directory, files are read with a PHP command, written with diag_edit.php
+// and removed with a shell command.
+func testConfigFileServer(t *testing.T, files map[string]string) *httptest.Server {
+	t.Helper()
+
+	var mutex sync.Mutex
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/diag_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		content, err := base64.StdEncoding.DecodeString(r.PostFormValue("data"))
+		if err != nil {
+			t.Errorf("unable to decode file content, %s", err)
+		}
+
+		mutex.Lock()
+		files[strings.TrimSuffix(path.Base(r.PostFormValue("file")), ".conf")] = string(content)
+		mutex.Unlock()
+
+		fmt.Fprint(w, "|0|File successfully saved|")
+	})
+	mux.HandleFunc("/diag_command.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if command := r.PostFormValue("txtCommand"); command != "" {
+			delete(files, strings.TrimSuffix(path.Base(strings.TrimPrefix(command, "rm ")), ".conf"))
+			fmt.Fprint(w, testDashboardPage)
+
+			return
+		}
+
+		resp := []configFileResponse{}
+		for name, content := range files {
+			resp = append(resp, configFileResponse{Name: name, Content: content})
+		}
+
+		b, _ := json.Marshal(resp)
+		fmt.Fprintf(w, "<html><body><pre>%s</pre></body></html>", b)
+	})
+
+	return httptest.NewServer(testPfSenseHandler(mux))
+}
+
+func TestReplaceDNSResolverConfigFiles(t *testing.T) {
+	files := map[string]string{
+		"removed":   "server:\n",
+		"changed":   "server:\n",
+		"unchanged": "server:\n",
+		"unmanaged": "server:\n",
+	}
+
+	server := testConfigFileServer(t, files)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	configFilesReq := ConfigFiles{
+		{Name: "changed", Content: "server:\n  verbosity: 2\n"},
+		{Name: "unchanged", Content: "server:\n"},
+		{Name: "added", Content: "server:\n"},
+	}
+
+	configFiles, err := pf.ReplaceDNSResolverConfigFiles(context.Background(), configFilesReq, []string{"removed", "changed", "unchanged"})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if _, err := configFiles.GetByName("removed"); err == nil {
+		t.Error("expected previously written file no longer requested to be removed")
+	}
+
+	// files not written by this resource, such as those of the singular resource, are kept
+	if _, err := configFiles.GetByName("unmanaged"); err != nil {
+		t.Errorf("expected unmanaged file to be kept, %s", err)
+	}
+
+	for _, configFileReq := range configFilesReq {
+		configFile, err := configFiles.GetByName(configFileReq.Name)
+		if err != nil {
+			t.Errorf("expected file '%s' to be written, %s", configFileReq.Name, err)
+			continue
+		}
+
+		if configFile.Content != configFileReq.Content {
+			t.Errorf("file '%s' content = %q, want %q", configFileReq.Name, configFile.Content, configFileReq.Content)
+		}
+	}
+
+	if len(files) != 4 {
+		t.Errorf("expected 4 files, got %v", files)
+	}
+}
+
+// testValidatedConfigFileServer runs the validated replace command against a config file directory, the combined
+// config is rejected by unbound-checkconf when any file contains 'invalid'.
+func testValidatedConfigFileServer(t *testing.T, files map[string]string) *httptest.Server {
+	t.Helper()
+
+	var mutex sync.Mutex
+	encoded := regexp.MustCompile(`base64_decode\('([^']*)'\)`)
+
+	return httptest.NewServer(testPfSenseHandler(testPHPCommandHandler(func(command string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if !strings.Contains(command, dnsResolverCheckConfPath) {
+			resp := []configFileResponse{}
+			for name, content := range files {
+				resp = append(resp, configFileResponse{Name: name, Content: content})
+			}
+
+			b, _ := json.Marshal(resp)
+			return string(b)
+		}
+
+		var req []configFileResponse
+		var prune []string
+		matches := encoded.FindAllStringSubmatch(command, -1)
+		if len(matches) != 2 {
+			t.Errorf("expected request and prune list in command, got %q", command)
+			return ""
+		}
+
+		for i, v := range []any{&req, &prune} {
+			b, _ := base64.StdEncoding.DecodeString(matches[i][1])
+			if err := json.Unmarshal(b, v); err != nil {
+				t.Errorf("unable to decode command, %s", err)
+				return ""
+			}
+		}
+
+		backup := maps.Clone(files)
+		for _, name := range prune {
+			delete(files, name)
+		}
+
+		for _, cf := range req {
+			files[cf.Name] = cf.Content
+		}
+
+		for name, content := range files {
+			if strings.Contains(content, "invalid") {
+				maps.DeleteFunc(files, func(string, string) bool { return true })
+				maps.Copy(files, backup)
+
+				return fmt.Sprintf(`{"valid":false,"output":"%s.conf:2: error: syntax error\nunbound-checkconf: config file %s contains errors\n"}`, name, dnsResolverConfigPath)
+			}
+		}
+
+		return `{"valid":true,"output":"unbound-checkconf: no errors in ` + dnsResolverConfigPath + `"}`
+	})))
+}
+
+func TestReplaceDNSResolverConfigFilesValidated(t *testing.T) {
+	files := map[string]string{
+		"first":     "server:\n",
+		"removed":   "server:\n",
+		"unmanaged": "server:\n",
+	}
+
+	server := testValidatedConfigFileServer(t, files)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// one of the files breaks the combined config, every file is restored
+	configFilesReq := ConfigFiles{
+		{Name: "first", Content: "server:\n  verbosity: 2\n"},
+		{Name: "second", Content: "server:\n  invalid-directive: yes\n"},
+	}
+
+	_, err = pf.ReplaceDNSResolverConfigFilesValidated(context.Background(), configFilesReq, []string{"first", "removed"})
+	if !errors.Is(err, ErrServerValidation) || !strings.Contains(err.Error(), "second.conf:2") {
+		t.Fatalf("expected server validation error naming the broken file, got %v", err)
+	}
+
+	want := map[string]string{"first": "server:\n", "removed": "server:\n", "unmanaged": "server:\n"}
+	if !maps.Equal(files, want) {
+		t.Errorf("expected config files to be restored, got %v", files)
+	}
+
+	configFilesReq[1].Content = "server:\n  verbosity: 1\n"
+
+	configFiles, err := pf.ReplaceDNSResolverConfigFilesValidated(context.Background(), configFilesReq, []string{"first", "removed"})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(*configFiles) != 3 {
+		t.Errorf("expected 3 config files, got %+v", *configFiles)
+	}
+
+	for _, configFileReq := range configFilesReq {
+		if configFile, err := configFiles.GetByName(configFileReq.Name); err != nil || configFile.Content != configFileReq.Content {
+			t.Errorf("expected file '%s' to be written, got %+v, %v", configFileReq.Name, configFile, err)
+		}
+	}
+}
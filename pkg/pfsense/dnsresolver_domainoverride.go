@@ -4,15 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"net/netip"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
-// TODO pfSense allows for more than one domain override entry with the same domain
-
 const (
 	DefaultDNSPort    = 53
 	DefaultTLSDNSPort = 853
@@ -46,8 +46,20 @@ func (do *DomainOverride) SetDomain(domain string) error {
 	return nil
 }
 
-// TODO support address without port specified (default to 53/853)
+// SetIPAddress accepts an address with or without a port, the port defaults to 53 (853 when TLS queries are enabled).
+// Set TLS queries first for the default port to apply.
 func (do *DomainOverride) SetIPAddress(ipAddress string) error {
+	if addr, err := netip.ParseAddr(ipAddress); err == nil {
+		port := DefaultDNSPort
+		if do.TLSQueries {
+			port = DefaultTLSDNSPort
+		}
+
+		do.IPAddress = netip.AddrPortFrom(addr, uint16(port))
+
+		return nil
+	}
+
 	addr, err := netip.ParseAddrPort(ipAddress)
 	if err != nil {
 		return err
@@ -70,12 +82,63 @@ func (do *DomainOverride) SetDescription(description string) error {
 	return nil
 }
 
+// validateTLSHostname checks the hostname is a fully qualified domain name suitable for TLS SNI (RFC 6066), IP
+// addresses are not permitted.
+func validateTLSHostname(hostname string) error {
+	var isValidLabel = regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]{0,61}[a-zA-Z0-9])?$`).MatchString
+
+	if _, err := netip.ParseAddr(hostname); err == nil {
+		return fmt.Errorf("%w, TLS hostname must be a fully qualified domain name, not an IP address", ErrClientValidation)
+	}
+
+	labels := strings.Split(hostname, ".")
+	if len(hostname) > 253 || len(labels) < 2 {
+		return fmt.Errorf("%w, TLS hostname must be a fully qualified domain name (e.g. 'dns.example.com')", ErrClientValidation)
+	}
+
+	for _, label := range labels {
+		if !isValidLabel(label) {
+			return fmt.Errorf("%w, TLS hostname label '%s' must be 1-63 letters, digits or hyphens and not start or end with a hyphen", ErrClientValidation, label)
+		}
+	}
+
+	return nil
+}
+
 func (do *DomainOverride) SetTLSHostname(hostname string) error {
+	if hostname != "" {
+		err := validateTLSHostname(hostname)
+		if err != nil {
+			return err
+		}
+	}
+
 	do.TLSHostname = hostname
 
 	return nil
 }
 
+// Validate checks the domain override client-side, allowing a group of overrides to be rejected before any are
+// changed.
+func (do DomainOverride) Validate() error {
+	if do.Domain == "" {
+		return fmt.Errorf("%w, domain required", ErrClientValidation)
+	}
+
+	if !do.IPAddress.IsValid() || do.IPAddress.Port() == 0 {
+		return fmt.Errorf("%w, domain override '%s' requires a valid IP address and port", ErrClientValidation, do.Domain)
+	}
+
+	if do.TLSHostname != "" {
+		err := validateTLSHostname(do.TLSHostname)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type DomainOverrides []DomainOverride
 
 func (dos DomainOverrides) GetByDomain(domain string) (*DomainOverride, error) {
@@ -87,13 +150,19 @@ func (dos DomainOverrides) GetByDomain(domain string) (*DomainOverride, error) {
 	return nil, fmt.Errorf("domain override %w with domain '%s'", ErrNotFound, domain)
 }
 
-func (dos DomainOverrides) GetControlIDByDomain(domain string) (*int, error) {
-	for i, do := range dos {
+func (dos DomainOverrides) GetAllByDomain(domain string) (*DomainOverrides, error) {
+	var domainOverrides DomainOverrides
+	for _, do := range dos {
 		if do.Domain == domain {
-			return &i, nil
+			domainOverrides = append(domainOverrides, do)
 		}
 	}
-	return nil, fmt.Errorf("domain override %w with domain '%s'", ErrNotFound, domain)
+
+	if len(domainOverrides) == 0 {
+		return nil, fmt.Errorf("domain override %w with domain '%s'", ErrNotFound, domain)
+	}
+
+	return &domainOverrides, nil
 }
 
 func (pf *Client) getDNSResolverDomainOverrides(ctx context.Context) (*DomainOverrides, error) {
@@ -102,8 +171,43 @@ func (pf *Client) getDNSResolverDomainOverrides(ctx context.Context) (*DomainOve
 		return nil, err
 	}
 
+	return parseDomainOverridesResponse(b)
+}
+
+func (dos DomainOverrides) sharedDomain() (string, error) {
+	if len(dos) == 0 {
+		return "", fmt.Errorf("%w, at least one domain override required", ErrClientValidation)
+	}
+
+	for _, do := range dos {
+		if do.Domain != dos[0].Domain {
+			return "", fmt.Errorf("%w, domain overrides must share the same domain", ErrClientValidation)
+		}
+	}
+
+	return dos[0].Domain, nil
+}
+
+func (dos DomainOverrides) GetControlIDByDomain(domain string) (*int, error) {
+	return getControlID(dos, func(do DomainOverride) bool { return do.Domain == domain },
+		positionalControlID, "domain override", fmt.Sprintf("domain '%s'", domain))
+}
+
+// GetControlIDsByDomain returns the control IDs of every domain override of the domain, in ascending order.
+func (dos DomainOverrides) GetControlIDsByDomain(domain string) []int {
+	var controlIDs []int
+	for i, do := range dos {
+		if do.Domain == domain {
+			controlIDs = append(controlIDs, positionalControlID(i, do))
+		}
+	}
+
+	return controlIDs
+}
+
+func parseDomainOverridesResponse(b []byte) (*DomainOverrides, error) {
 	var doResp []domainOverrideResponse
-	err = json.Unmarshal(b, &doResp)
+	err := json.Unmarshal(b, &doResp)
 	if err != nil {
 		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
 	}
@@ -118,34 +222,26 @@ func (pf *Client) getDNSResolverDomainOverrides(ctx context.Context) (*DomainOve
 			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
 		}
 
-		addr := resp.IPAddress
-		port := strconv.Itoa(DefaultDNSPort)
 		if resp.TLSQueries != nil {
-			port = strconv.Itoa(DefaultTLSDNSPort)
+			err = domainOverride.SetTLSQueries(true)
+			if err != nil {
+				return nil, err
+			}
 		}
 
+		ipAddress := resp.IPAddress
 		index := strings.LastIndex(resp.IPAddress, "@")
 		if index != -1 {
-			addr = resp.IPAddress[:index]
-			port = resp.IPAddress[index+1:]
+			ipAddress = net.JoinHostPort(resp.IPAddress[:index], resp.IPAddress[index+1:])
 		}
 
-		err = domainOverride.SetIPAddress(strings.Join([]string{addr, port}, ":"))
+		err = domainOverride.SetIPAddress(ipAddress)
 		if err != nil {
 			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
 		}
 
-		if resp.TLSQueries != nil {
-			err = domainOverride.SetTLSQueries(true)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		err = domainOverride.SetTLSHostname(resp.TLSHostname)
-		if err != nil {
-			return nil, fmt.Errorf("%w domain override response, %w", ErrUnableToParse, err)
-		}
+		// overrides may be added outside of Terraform, the TLS hostname is only validated on write
+		domainOverride.TLSHostname = resp.TLSHostname
 
 		err = domainOverride.SetDescription(resp.Description)
 		if err != nil {
@@ -182,7 +278,7 @@ func (pf *Client) GetDNSResolverDomainOverride(ctx context.Context, domain strin
 	return domainOverrides.GetByDomain(domain)
 }
 
-func (pf *Client) createOrUpdateDNSResolverDomainOverride(ctx context.Context, domainOverrideReq DomainOverride, controlID *int) (*DomainOverride, error) {
+func (pf *Client) submitDNSResolverDomainOverride(ctx context.Context, domainOverrideReq DomainOverride, controlID *int) error {
 	u := url.URL{Path: "services_unbound_domainoverride_edit.php"}
 	v := url.Values{
 		"domain":       {domainOverrideReq.Domain},
@@ -204,10 +300,14 @@ func (pf *Client) createOrUpdateDNSResolverDomainOverride(ctx context.Context, d
 
 	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = scrapeHTMLValidationErrors(doc)
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) createOrUpdateDNSResolverDomainOverride(ctx context.Context, domainOverrideReq DomainOverride, controlID *int) (*DomainOverride, error) {
+	err := pf.submitDNSResolverDomainOverride(ctx, domainOverrideReq, controlID)
 	if err != nil {
 		return nil, err
 	}
@@ -273,16 +373,161 @@ func (pf *Client) DeleteDNSResolverDomainOverride(ctx context.Context, domain st
 		return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
 	}
 
+	err = pf.deleteDNSResolverDomainOverride(ctx, *controlID)
+	if err != nil {
+		return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) deleteDNSResolverDomainOverride(ctx context.Context, controlID int) error {
 	u := url.URL{Path: "services_unbound.php"}
 	v := url.Values{
 		"type": {"doverride"},
 		"act":  {"del"},
-		"id":   {strconv.Itoa(*controlID)},
+		"id":   {strconv.Itoa(controlID)},
 	}
 
-	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	_, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+
+	return err
+}
+
+// deleteDNSResolverDomainOverridesByDomain deletes the domain overrides of the domain beyond the first keep overrides.
+// Overrides are deleted last to first so the remaining control IDs do not shift.
+func (pf *Client) deleteDNSResolverDomainOverridesByDomain(ctx context.Context, domain string, keep int) error {
+	domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
 	if err != nil {
-		return fmt.Errorf("%w domain override, %w", ErrDeleteOperationFailed, err)
+		return err
+	}
+
+	controlIDs := domainOverrides.GetControlIDsByDomain(domain)
+
+	for i := len(controlIDs) - 1; i >= keep; i-- {
+		err = pf.deleteDNSResolverDomainOverride(ctx, controlIDs[i])
+		if err != nil {
+			return err
+		}
+
+		domainOverrides, err = pf.getDNSResolverDomainOverrides(ctx)
+		if err != nil {
+			return err
+		}
+
+		// a delete that silently did nothing (e.g. a stale control ID) would otherwise go unnoticed
+		if remaining := len(domainOverrides.GetControlIDsByDomain(domain)); remaining != i {
+			return fmt.Errorf("%w, domain '%s' has %d domain override(s) after delete, expected %d", ErrResultMismatch, domain, remaining, i)
+		}
+	}
+
+	return nil
+}
+
+// replaceDNSResolverDomainOverridesByDomain validates every override before changing anything, then updates existing
+// overrides of the domain in place and only adds or deletes the difference.
+func (pf *Client) replaceDNSResolverDomainOverridesByDomain(ctx context.Context, domain string, domainOverridesReq DomainOverrides) (*DomainOverrides, error) {
+	for _, domainOverrideReq := range domainOverridesReq {
+		err := domainOverrideReq.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	controlIDs := domainOverrides.GetControlIDsByDomain(domain)
+
+	for i, domainOverrideReq := range domainOverridesReq {
+		var controlID *int
+		if i < len(controlIDs) {
+			controlID = &controlIDs[i]
+		}
+
+		err = pf.submitDNSResolverDomainOverride(ctx, domainOverrideReq, controlID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = pf.deleteDNSResolverDomainOverridesByDomain(ctx, domain, len(domainOverridesReq))
+	if err != nil {
+		return nil, err
+	}
+
+	domainOverrides, err = pf.getDNSResolverDomainOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return domainOverrides.GetAllByDomain(domain)
+}
+
+func (pf *Client) GetDNSResolverDomainOverrideGroup(ctx context.Context, domain string) (*DomainOverrides, error) {
+	pf.mutexes.DNSResolverDomainOverride.Lock()
+	defer pf.mutexes.DNSResolverDomainOverride.Unlock()
+
+	domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override group (domain '%s'), %w", ErrGetOperationFailed, domain, err)
+	}
+
+	return domainOverrides.GetAllByDomain(domain)
+}
+
+func (pf *Client) CreateDNSResolverDomainOverrideGroup(ctx context.Context, domainOverridesReq DomainOverrides) (*DomainOverrides, error) {
+	pf.mutexes.DNSResolverDomainOverride.Lock()
+	defer pf.mutexes.DNSResolverDomainOverride.Unlock()
+
+	domain, err := domainOverridesReq.sharedDomain()
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override group, %w", ErrCreateOperationFailed, err)
+	}
+
+	domainOverrides, err := pf.getDNSResolverDomainOverrides(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override group, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := domainOverrides.GetAllByDomain(domain); err == nil {
+		return nil, fmt.Errorf("%w domain override group, domain '%s' already has domain override(s)", ErrCreateOperationFailed, domain)
+	}
+
+	group, err := pf.replaceDNSResolverDomainOverridesByDomain(ctx, domain, domainOverridesReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override group, %w", ErrCreateOperationFailed, err)
+	}
+
+	return group, nil
+}
+
+func (pf *Client) UpdateDNSResolverDomainOverrideGroup(ctx context.Context, domainOverridesReq DomainOverrides) (*DomainOverrides, error) {
+	pf.mutexes.DNSResolverDomainOverride.Lock()
+	defer pf.mutexes.DNSResolverDomainOverride.Unlock()
+
+	domain, err := domainOverridesReq.sharedDomain()
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override group, %w", ErrUpdateOperationFailed, err)
+	}
+
+	group, err := pf.replaceDNSResolverDomainOverridesByDomain(ctx, domain, domainOverridesReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w domain override group, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return group, nil
+}
+
+func (pf *Client) DeleteDNSResolverDomainOverrideGroup(ctx context.Context, domain string) error {
+	pf.mutexes.DNSResolverDomainOverride.Lock()
+	defer pf.mutexes.DNSResolverDomainOverride.Unlock()
+
+	err := pf.deleteDNSResolverDomainOverridesByDomain(ctx, domain, 0)
+	if err != nil {
+		return fmt.Errorf("%w domain override group, %w", ErrDeleteOperationFailed, err)
 	}
 
 	return nil
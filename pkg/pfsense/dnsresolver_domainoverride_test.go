@@ -0,0 +1,58 @@
+package pfsense
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDomainOverridesResponseUnmanagedTLSHostname(t *testing.T) {
+	b := []byte(`[
+		{"domain": "example.com", "ip": "10.0.0.1@853", "forward_tls_upstream": "", "tls_hostname": "dns.example.com", "descr": ""},
+		{"domain": "lan", "ip": "10.0.0.2", "tls_hostname": "dns", "descr": "short hostname"},
+		{"domain": "corp", "ip": "10.0.0.3@853", "forward_tls_upstream": "", "tls_hostname": "1.1.1.1", "descr": "IP hostname"}
+	]`)
+
+	domainOverrides, err := parseDomainOverridesResponse(b)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(*domainOverrides) != 3 {
+		t.Fatalf("expected 3 domain overrides, got %d", len(*domainOverrides))
+	}
+
+	for i, want := range []string{"dns.example.com", "dns", "1.1.1.1"} {
+		if got := (*domainOverrides)[i].TLSHostname; got != want {
+			t.Errorf("domain override %d TLS hostname = '%s', want '%s'", i, got, want)
+		}
+	}
+}
+
+func TestDomainOverrideSetTLSHostname(t *testing.T) {
+	tests := []struct {
+		hostname string
+		valid    bool
+	}{
+		{"", true},
+		{"dns.example.com", true},
+		{"cloudflare-dns.com", true},
+		{"dns", false},
+		{"1.1.1.1", false},
+		{"::1", false},
+		{"dns.example.com.", false},
+		{"-dns.example.com", false},
+	}
+
+	for _, tt := range tests {
+		var do DomainOverride
+
+		err := do.SetTLSHostname(tt.hostname)
+		if tt.valid && err != nil {
+			t.Errorf("SetTLSHostname(%q) unexpected error, %s", tt.hostname, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetTLSHostname(%q) expected client validation error, got %v", tt.hostname, err)
+		}
+	}
+}
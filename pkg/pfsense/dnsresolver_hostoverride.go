@@ -3,6 +3,7 @@ package pfsense
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/netip"
@@ -68,7 +69,60 @@ func (ho HostOverride) FQDN() string {
 }
 
 func (hoa HostOverrideAlias) FQDN() string {
-	return strings.Join([]string{hoa.Host, hoa.Domain}, ".")
+	return strings.Join(removeEmptyStrings([]string{hoa.Host, hoa.Domain}), ".")
+}
+
+// FQDNs returns the FQDN of the host override followed by the FQDNs of its aliases.
+func (ho HostOverride) FQDNs() []string {
+	fqdns := []string{ho.FQDN()}
+	for _, alias := range ho.Aliases {
+		fqdns = append(fqdns, alias.FQDN())
+	}
+	return fqdns
+}
+
+func (ho HostOverride) hasAlias(fqdn string) bool {
+	for _, alias := range ho.Aliases {
+		if alias.FQDN() == fqdn {
+			return true
+		}
+	}
+	return false
+}
+
+// Differences describes how the actual host override differs from this (desired) host override.
+func (ho HostOverride) Differences(actual HostOverride) []string {
+	var differences []string
+
+	if ho.FQDN() != actual.FQDN() {
+		differences = append(differences, fmt.Sprintf("FQDN is '%s', expected '%s'", actual.FQDN(), ho.FQDN()))
+	}
+
+	if ho.formatIPAddresses() != actual.formatIPAddresses() {
+		differences = append(differences, fmt.Sprintf("IP addresses are '%s', expected '%s'", actual.formatIPAddresses(), ho.formatIPAddresses()))
+	}
+
+	if ho.Description != actual.Description {
+		differences = append(differences, fmt.Sprintf("description is '%s', expected '%s'", actual.Description, ho.Description))
+	}
+
+	if len(ho.Aliases) != len(actual.Aliases) {
+		differences = append(differences, fmt.Sprintf("has %d aliases, expected %d", len(actual.Aliases), len(ho.Aliases)))
+	}
+
+	for i := 0; i < len(ho.Aliases) && i < len(actual.Aliases); i++ {
+		desired, current := ho.Aliases[i], actual.Aliases[i]
+
+		if desired.FQDN() != current.FQDN() {
+			differences = append(differences, fmt.Sprintf("alias %d FQDN is '%s', expected '%s'", i, current.FQDN(), desired.FQDN()))
+		}
+
+		if desired.Description != current.Description {
+			differences = append(differences, fmt.Sprintf("alias %d description is '%s', expected '%s'", i, current.Description, desired.Description))
+		}
+	}
+
+	return differences
 }
 
 func (ho *HostOverride) SetHost(host string) error {
@@ -77,22 +131,30 @@ func (ho *HostOverride) SetHost(host string) error {
 	return nil
 }
 
+// SetDomain accepts single-label domains (e.g. 'lan'), a leading dot or empty label would be doubled when joined to
+// the host in the FQDN.
 func (ho *HostOverride) SetDomain(domain string) error {
+	if strings.HasPrefix(domain, ".") || strings.Contains(domain, "..") {
+		return fmt.Errorf("%w, domain must not start with a dot or contain empty labels", ErrClientValidation)
+	}
+
 	ho.Domain = domain
 
 	return nil
 }
 
 func (ho *HostOverride) SetIPAddresses(ipAddresses []string) error {
+	var errs []error
 	for _, ipAddress := range ipAddresses {
 		addr, err := netip.ParseAddr(ipAddress)
 		if err != nil {
-			return err
+			errs = append(errs, err)
+			continue
 		}
 		ho.IPAddresses = append(ho.IPAddresses, addr)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 func (ho *HostOverride) SetDescription(description string) error {
@@ -108,6 +170,10 @@ func (hoa *HostOverrideAlias) SetHost(host string) error {
 }
 
 func (hoa *HostOverrideAlias) SetDomain(domain string) error {
+	if strings.HasPrefix(domain, ".") || strings.Contains(domain, "..") {
+		return fmt.Errorf("%w, domain must not start with a dot or contain empty labels", ErrClientValidation)
+	}
+
 	hoa.Domain = domain
 
 	return nil
@@ -131,22 +197,14 @@ func (hos HostOverrides) GetByFQDN(fqdn string) (*HostOverride, error) {
 }
 
 func (hos HostOverrides) GetControlIDByFQDN(fqdn string) (*int, error) {
-	for i, ho := range hos {
-		if ho.FQDN() == fqdn {
-			return &i, nil
-		}
-	}
-	return nil, fmt.Errorf("host override %w with FQDN '%s'", ErrNotFound, fqdn)
+	return getControlID(hos, func(ho HostOverride) bool { return ho.FQDN() == fqdn },
+		positionalControlID, "host override", fmt.Sprintf("FQDN '%s'", fqdn))
 }
 
-func (pf *Client) getDNSResolverHostOverrides(ctx context.Context) (*HostOverrides, error) {
-	b, err := pf.getConfigJSON(ctx, "['unbound']['hosts']")
-	if err != nil {
-		return nil, err
-	}
-
+// parseHostOverridesResponse parses host overrides of the DNS resolver or DNS forwarder, both share the same config structure.
+func parseHostOverridesResponse(b []byte) (*HostOverrides, error) {
 	var hoResp []hostOverrideResponse
-	err = json.Unmarshal(b, &hoResp)
+	err := json.Unmarshal(b, &hoResp)
 	if err != nil {
 		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
 	}
@@ -204,6 +262,15 @@ func (pf *Client) getDNSResolverHostOverrides(ctx context.Context) (*HostOverrid
 	return &hostOverrides, nil
 }
 
+func (pf *Client) getDNSResolverHostOverrides(ctx context.Context) (*HostOverrides, error) {
+	b, err := pf.getConfigJSON(ctx, "['unbound']['hosts']")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseHostOverridesResponse(b)
+}
+
 func (pf *Client) GetDNSResolverHostOverrides(ctx context.Context) (*HostOverrides, error) {
 	pf.mutexes.DNSResolverHostOverride.Lock()
 	defer pf.mutexes.DNSResolverHostOverride.Unlock()
@@ -270,6 +337,13 @@ func (pf *Client) createOrUpdateDNSResolverHostOverride(ctx context.Context, hos
 		return nil, err
 	}
 
+	// aliases with an empty host (apex) or wildcard host are easy to lose, surface any that were not saved
+	for _, aliasReq := range hostOverrideReq.Aliases {
+		if !hostOverride.hasAlias(aliasReq.FQDN()) {
+			return nil, fmt.Errorf("%w, alias '%s' not saved", ErrResultMismatch, aliasReq.FQDN())
+		}
+	}
+
 	return hostOverride, nil
 }
 
@@ -304,6 +378,11 @@ func (pf *Client) UpdateDNSResolverHostOverride(ctx context.Context, hostOverrid
 		return nil, fmt.Errorf("%w host override, %w", ErrUpdateOperationFailed, err)
 	}
 
+	err = checkDifferences(hostOverrideReq.Differences(*hostOverride))
+	if err != nil {
+		return nil, fmt.Errorf("%w host override, %w", ErrUpdateOperationFailed, err)
+	}
+
 	return hostOverride, nil
 }
 
@@ -0,0 +1,254 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestHostOverrideDifferences(t *testing.T) {
+	desired := HostOverride{
+		Host:        "www",
+		Domain:      "example.com",
+		IPAddresses: []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("fd00::1")},
+		Description: "web",
+		Aliases: []HostOverrideAlias{
+			{Host: "", Domain: "example.com", Description: "apex"},
+			{Host: "*", Domain: "example.com"},
+		},
+	}
+
+	if differences := desired.Differences(desired); len(differences) != 0 {
+		t.Errorf("expected no differences, got %v", differences)
+	}
+
+	actual := HostOverride{
+		Host:        "www",
+		Domain:      "example.org",
+		IPAddresses: []netip.Addr{netip.MustParseAddr("10.0.0.1")},
+		Description: "web",
+		Aliases: []HostOverrideAlias{
+			{Host: "www2", Domain: "example.com"},
+		},
+	}
+
+	want := []string{
+		"FQDN is 'www.example.org', expected 'www.example.com'",
+		"IP addresses are '10.0.0.1', expected '10.0.0.1,fd00::1'",
+		"has 1 aliases, expected 2",
+		"alias 0 FQDN is 'www2.example.com', expected 'example.com'",
+		"alias 0 description is '', expected 'apex'",
+	}
+
+	if differences := desired.Differences(actual); !slices.Equal(differences, want) {
+		t.Errorf("Differences() = %v, want %v", differences, want)
+	}
+}
+
+func TestHostOverrideSetDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		valid  bool
+	}{
+		{"lan", true},
+		{"local", true},
+		{"home.lan", true},
+		{"example.com", true},
+		{".lan", false},
+		{"home..lan", false},
+	}
+
+	for _, tt := range tests {
+		var hostOverride HostOverride
+		err := hostOverride.SetDomain(tt.domain)
+		if tt.valid && (err != nil || hostOverride.Domain != tt.domain) {
+			t.Errorf("SetDomain(%q) = %q, %v", tt.domain, hostOverride.Domain, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetDomain(%q) expected validation error, got %v", tt.domain, err)
+		}
+
+		var alias HostOverrideAlias
+		if err := alias.SetDomain(tt.domain); tt.valid != (err == nil) {
+			t.Errorf("alias SetDomain(%q) valid = %t, got %v", tt.domain, tt.valid, err)
+		}
+	}
+}
+
+func TestHostOverrideSingleLabelDomainRoundTrip(t *testing.T) {
+	tests := []struct {
+		host string
+		fqdn string
+	}{
+		{"host", "host.lan"},
+		{"", "lan"},
+	}
+
+	for _, tt := range tests {
+		var stored []map[string]any
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/services_unbound_host_edit.php", func(w http.ResponseWriter, r *http.Request) {
+			stored = append(stored, map[string]any{
+				"host":   r.PostFormValue("host"),
+				"domain": r.PostFormValue("domain"),
+				"ip":     r.PostFormValue("ip"),
+				"descr":  r.PostFormValue("descr"),
+				"aliases": map[string]any{"item": []map[string]string{{
+					"host":        r.PostFormValue("aliashost0"),
+					"domain":      r.PostFormValue("aliasdomain0"),
+					"description": r.PostFormValue("aliasdescription0"),
+				}}},
+			})
+			fmt.Fprint(w, testDashboardPage)
+		})
+		mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+			b, _ := json.Marshal(stored)
+			return string(b)
+		}))
+
+		server := httptest.NewServer(testPfSenseHandler(mux))
+
+		pf, err := newTestClient(t, server.URL, Options{})
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		var hostOverrideReq HostOverride
+		_ = hostOverrideReq.SetHost(tt.host)
+		if err := hostOverrideReq.SetDomain("lan"); err != nil {
+			t.Fatalf("%s: unexpected error, %s", tt.fqdn, err)
+		}
+		_ = hostOverrideReq.SetIPAddresses([]string{"192.168.1.10"})
+
+		var alias HostOverrideAlias
+		_ = alias.SetHost("alias")
+		_ = alias.SetDomain("lan")
+		hostOverrideReq.Aliases = []HostOverrideAlias{alias}
+
+		if got := hostOverrideReq.FQDN(); got != tt.fqdn {
+			t.Errorf("FQDN() = %q, want %q", got, tt.fqdn)
+		}
+
+		hostOverride, err := pf.CreateDNSResolverHostOverride(context.Background(), hostOverrideReq)
+		if err != nil {
+			t.Fatalf("%s: unexpected error, %s", tt.fqdn, err)
+		}
+
+		if hostOverride.Host != tt.host || hostOverride.Domain != "lan" || hostOverride.FQDN() != tt.fqdn {
+			t.Errorf("%s: round trip returned host '%s', domain '%s', FQDN '%s'", tt.fqdn, hostOverride.Host, hostOverride.Domain, hostOverride.FQDN())
+		}
+
+		if differences := hostOverrideReq.Differences(*hostOverride); len(differences) != 0 {
+			t.Errorf("%s: expected no differences, got %v", tt.fqdn, differences)
+		}
+
+		if !slices.Equal(hostOverride.FQDNs(), []string{tt.fqdn, "alias.lan"}) {
+			t.Errorf("%s: FQDNs() = %v", tt.fqdn, hostOverride.FQDNs())
+		}
+
+		if _, err := pf.GetDNSResolverHostOverride(context.Background(), tt.fqdn); err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.fqdn, err)
+		}
+
+		server.Close()
+	}
+}
+
+func TestUpdateDNSResolverHostOverrideDroppedDescription(t *testing.T) {
+	stored := []map[string]any{{"host": "www", "domain": "example.com", "ip": "10.0.0.1", "descr": ""}}
+
+	// the server saves the update but silently drops the description
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services_unbound_host_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		stored[0] = map[string]any{"host": r.PostFormValue("host"), "domain": r.PostFormValue("domain"), "ip": r.PostFormValue("ip"), "descr": ""}
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var hostOverrideReq HostOverride
+	_ = hostOverrideReq.SetHost("www")
+	_ = hostOverrideReq.SetDomain("example.com")
+	_ = hostOverrideReq.SetIPAddresses([]string{"10.0.0.2"})
+	_ = hostOverrideReq.SetDescription("web")
+
+	_, err = pf.UpdateDNSResolverHostOverride(context.Background(), hostOverrideReq)
+	if !errors.Is(err, ErrUpdateOperationFailed) || !errors.Is(err, ErrResultMismatch) {
+		t.Fatalf("expected update operation failed with result mismatch, got %v", err)
+	}
+
+	if want := "description is '', expected 'web'"; !strings.Contains(err.Error(), want) {
+		t.Errorf("expected error to contain %q, got %q", want, err)
+	}
+
+	if strings.Contains(err.Error(), "IP addresses") {
+		t.Errorf("expected only the description to be reported, got %q", err)
+	}
+}
+
+func TestGetDNSResolverHostOverride(t *testing.T) {
+	stored := []map[string]any{
+		{"host": "db", "domain": "example.com", "ip": "10.0.0.5", "descr": ""},
+		{"host": "www", "domain": "example.com", "ip": "10.0.0.1,fd00::1", "descr": "web", "aliases": map[string]any{"item": []map[string]string{
+			{"host": "", "domain": "example.com", "description": "apex"},
+			{"host": "static", "domain": "example.com", "description": ""},
+		}}},
+	}
+
+	server := httptest.NewServer(testPfSenseHandler(testPHPCommandHandler(func(string) string {
+		b, _ := json.Marshal(stored)
+		return string(b)
+	})))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	hostOverride, err := pf.GetDNSResolverHostOverride(context.Background(), "www.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	want := HostOverride{
+		Host:        "www",
+		Domain:      "example.com",
+		IPAddresses: []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("fd00::1")},
+		Description: "web",
+		Aliases: []HostOverrideAlias{
+			{Host: "", Domain: "example.com", Description: "apex"},
+			{Host: "static", Domain: "example.com"},
+		},
+	}
+
+	if differences := want.Differences(*hostOverride); len(differences) != 0 {
+		t.Errorf("unexpected host override, %v", differences)
+	}
+
+	// aliases are not host overrides of their own
+	for _, fqdn := range []string{"unknown.example.com", "static.example.com"} {
+		if _, err := pf.GetDNSResolverHostOverride(context.Background(), fqdn); !errors.Is(err, ErrNotFound) {
+			t.Errorf("%s: expected not found error, got %v", fqdn, err)
+		}
+	}
+}
@@ -0,0 +1,255 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const dnsResolverAllInterfaces = "all"
+
+var dnsResolverIncludeTopLevel = fmt.Sprintf("include-toplevel: %s/*", dnsResolverConfigFileDir)
+
+type dnsResolverSettingsResponse struct {
+	Enable             *string `json:"enable"`
+	ListenInterfaces   string  `json:"active_interface"`
+	OutgoingInterfaces string  `json:"outgoing_interface"`
+	DNSSEC             *string `json:"dnssec"`
+	CustomOptions      string  `json:"custom_options"`
+}
+
+type DNSResolverSettings struct {
+	Enable             bool
+	ListenInterfaces   []string
+	OutgoingInterfaces []string
+	DNSSEC             bool
+	CustomOptions      string
+}
+
+func (s *DNSResolverSettings) SetEnable(enable bool) error {
+	s.Enable = enable
+
+	return nil
+}
+
+func validateDNSResolverInterfaces(ifaces []string) error {
+	var isValidInterface = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	var errs []error
+	for _, iface := range ifaces {
+		if !isValidInterface(iface) || iface == dnsResolverAllInterfaces {
+			errs = append(errs, fmt.Errorf("%w, interface '%s' must be an interface name (e.g. 'lan', 'opt1', 'lo0'), omit all interfaces to use all", ErrClientValidation, iface))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetListenInterfaces sets the interfaces the resolver answers queries on, none for all interfaces.
+func (s *DNSResolverSettings) SetListenInterfaces(ifaces []string) error {
+	err := validateDNSResolverInterfaces(ifaces)
+	if err != nil {
+		return err
+	}
+
+	s.ListenInterfaces = ifaces
+
+	return nil
+}
+
+// SetOutgoingInterfaces sets the interfaces the resolver sends queries from, none for all interfaces.
+func (s *DNSResolverSettings) SetOutgoingInterfaces(ifaces []string) error {
+	err := validateDNSResolverInterfaces(ifaces)
+	if err != nil {
+		return err
+	}
+
+	s.OutgoingInterfaces = ifaces
+
+	return nil
+}
+
+func (s *DNSResolverSettings) SetDNSSEC(dnssec bool) error {
+	s.DNSSEC = dnssec
+
+	return nil
+}
+
+func (s *DNSResolverSettings) SetCustomOptions(customOptions string) error {
+	s.CustomOptions = strings.ReplaceAll(customOptions, "\r\n", "\n")
+
+	return nil
+}
+
+// HasIncludeTopLevel reports whether the custom options include the config file directory.
+func (s DNSResolverSettings) HasIncludeTopLevel() bool {
+	for _, line := range strings.Split(s.CustomOptions, "\n") {
+		if strings.TrimSpace(line) == dnsResolverIncludeTopLevel {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EnsureIncludeTopLevel appends the directive required by config files to the custom options, unless already present.
+func (s *DNSResolverSettings) EnsureIncludeTopLevel() {
+	if s.HasIncludeTopLevel() {
+		return
+	}
+
+	switch {
+	case s.CustomOptions == "":
+		s.CustomOptions = dnsResolverIncludeTopLevel
+	case strings.HasSuffix(s.CustomOptions, "\n"):
+		s.CustomOptions += dnsResolverIncludeTopLevel + "\n"
+	default:
+		s.CustomOptions += "\n" + dnsResolverIncludeTopLevel
+	}
+}
+
+// RemoveIncludeTopLevel removes the directive required by config files from the custom options, reversing
+// EnsureIncludeTopLevel.
+func (s *DNSResolverSettings) RemoveIncludeTopLevel() {
+	if !s.HasIncludeTopLevel() {
+		return
+	}
+
+	var lines []string
+	for _, line := range strings.Split(s.CustomOptions, "\n") {
+		if strings.TrimSpace(line) != dnsResolverIncludeTopLevel {
+			lines = append(lines, line)
+		}
+	}
+
+	s.CustomOptions = strings.Join(lines, "\n")
+}
+
+func parseDNSResolverInterfaces(ifaces string) []string {
+	if ifaces == dnsResolverAllInterfaces {
+		return []string{}
+	}
+
+	return removeEmptyStrings(strings.Split(ifaces, ","))
+}
+
+func formatDNSResolverInterfaces(ifaces []string) []string {
+	if len(ifaces) == 0 {
+		return []string{dnsResolverAllInterfaces}
+	}
+
+	return ifaces
+}
+
+func (pf *Client) getDNSResolverSettings(ctx context.Context) (*DNSResolverSettings, error) {
+	b, err := pf.getConfigJSON(ctx, "['unbound']")
+	if err != nil {
+		return nil, err
+	}
+
+	var settingsResp dnsResolverSettingsResponse
+	err = json.Unmarshal(b, &settingsResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var settings DNSResolverSettings
+
+	err = settings.SetEnable(settingsResp.Enable != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings response, %w", ErrUnableToParse, err)
+	}
+
+	err = settings.SetListenInterfaces(parseDNSResolverInterfaces(settingsResp.ListenInterfaces))
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings response, %w", ErrUnableToParse, err)
+	}
+
+	err = settings.SetOutgoingInterfaces(parseDNSResolverInterfaces(settingsResp.OutgoingInterfaces))
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings response, %w", ErrUnableToParse, err)
+	}
+
+	err = settings.SetDNSSEC(settingsResp.DNSSEC != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings response, %w", ErrUnableToParse, err)
+	}
+
+	// custom options are stored base64 encoded
+	customOptions, err := base64.StdEncoding.DecodeString(settingsResp.CustomOptions)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings response, %w", ErrUnableToParse, err)
+	}
+
+	err = settings.SetCustomOptions(string(customOptions))
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings response, %w", ErrUnableToParse, err)
+	}
+
+	return &settings, nil
+}
+
+func (pf *Client) GetDNSResolverSettings(ctx context.Context) (*DNSResolverSettings, error) {
+	pf.mutexes.DNSResolverSettings.Lock()
+	defer pf.mutexes.DNSResolverSettings.Unlock()
+
+	settings, err := pf.getDNSResolverSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return settings, nil
+}
+
+func (pf *Client) UpdateDNSResolverSettings(ctx context.Context, settingsReq DNSResolverSettings) (*DNSResolverSettings, error) {
+	pf.mutexes.DNSResolverSettings.Lock()
+	defer pf.mutexes.DNSResolverSettings.Unlock()
+
+	u := url.URL{Path: "services_unbound.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v["active_interface[]"] = formatDNSResolverInterfaces(settingsReq.ListenInterfaces)
+	v["outgoing_interface[]"] = formatDNSResolverInterfaces(settingsReq.OutgoingInterfaces)
+	v.Set("custom_options", settingsReq.CustomOptions)
+	v.Set("save", "Save")
+
+	if settingsReq.Enable {
+		v.Set("enable", "yes")
+	} else {
+		v.Del("enable")
+	}
+
+	if settingsReq.DNSSEC {
+		v.Set("dnssec", "yes")
+	} else {
+		v.Del("dnssec")
+	}
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	settings, err := pf.getDNSResolverSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w DNS resolver settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,143 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDNSResolverSettingsEnsureIncludeTopLevel(t *testing.T) {
+	tests := []struct {
+		customOptions string
+		want          string
+	}{
+		{"", dnsResolverIncludeTopLevel},
+		{"server:", "server:\n" + dnsResolverIncludeTopLevel},
+		{"server:\n", "server:\n" + dnsResolverIncludeTopLevel + "\n"},
+		{dnsResolverIncludeTopLevel, dnsResolverIncludeTopLevel},
+		{"server:\n  " + dnsResolverIncludeTopLevel + "  \n", "server:\n  " + dnsResolverIncludeTopLevel + "  \n"},
+	}
+
+	for _, tt := range tests {
+		settings := DNSResolverSettings{CustomOptions: tt.customOptions}
+
+		// the directive is only added once
+		settings.EnsureIncludeTopLevel()
+		settings.EnsureIncludeTopLevel()
+
+		if settings.CustomOptions != tt.want {
+			t.Errorf("EnsureIncludeTopLevel(%q) = %q, want %q", tt.customOptions, settings.CustomOptions, tt.want)
+		}
+
+		if !settings.HasIncludeTopLevel() {
+			t.Errorf("EnsureIncludeTopLevel(%q) expected directive", tt.customOptions)
+		}
+
+		settings.RemoveIncludeTopLevel()
+		if settings.HasIncludeTopLevel() || strings.Contains(settings.CustomOptions, "include-toplevel") {
+			t.Errorf("RemoveIncludeTopLevel(%q) = %q, expected directive removed", tt.want, settings.CustomOptions)
+		}
+	}
+}
+
+func TestUpdateDNSResolverSettings(t *testing.T) {
+	stored := map[string]any{"enable": "", "active_interface": "all", "outgoing_interface": "all", "custom_options": "", "port": "53"}
+	var posted [][]string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services_unbound.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+			posted = append(posted, r.PostForm["custom_options"])
+			stored["active_interface"] = strings.Join(r.PostForm["active_interface[]"], ",")
+			stored["outgoing_interface"] = strings.Join(r.PostForm["outgoing_interface[]"], ",")
+			stored["custom_options"] = base64.StdEncoding.EncodeToString([]byte(r.PostFormValue("custom_options")))
+			stored["port"] = r.PostFormValue("port")
+
+			for _, key := range []string{"enable", "dnssec"} {
+				delete(stored, key)
+				if r.PostForm.Has(key) {
+					stored[key] = ""
+				}
+			}
+		}
+
+		customOptions, _ := base64.StdEncoding.DecodeString(stored["custom_options"].(string))
+		fmt.Fprintf(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="enable" type="checkbox" value="yes" checked="checked" />
+<input name="dnssec" type="checkbox" value="yes" />
+<input name="port" type="text" value="%s" />
+<textarea name="custom_options">%s</textarea>
+</form></body></html>`, stored["port"], html.EscapeString(string(customOptions)))
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var settingsReq DNSResolverSettings
+	_ = settingsReq.SetEnable(true)
+	_ = settingsReq.SetDNSSEC(true)
+	_ = settingsReq.SetListenInterfaces([]string{"lan", "lo0"})
+	_ = settingsReq.SetCustomOptions("server:\r\n  verbosity: 2\r\n")
+	settingsReq.EnsureIncludeTopLevel()
+
+	for range 2 {
+		settings, err := pf.UpdateDNSResolverSettings(context.Background(), settingsReq)
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		if !settings.Enable || !settings.DNSSEC {
+			t.Errorf("expected resolver and DNSSEC enabled, got %+v", settings)
+		}
+
+		if !slices.Equal(settings.ListenInterfaces, []string{"lan", "lo0"}) || len(settings.OutgoingInterfaces) != 0 {
+			t.Errorf("expected listen interfaces 'lan', 'lo0' and all outgoing interfaces, got %v and %v", settings.ListenInterfaces, settings.OutgoingInterfaces)
+		}
+
+		if want := "server:\n  verbosity: 2\n" + dnsResolverIncludeTopLevel + "\n"; settings.CustomOptions != want {
+			t.Errorf("custom options = %q, want %q", settings.CustomOptions, want)
+		}
+
+		// the next update starts from the settings read back, the directive must not be added again
+		settings.EnsureIncludeTopLevel()
+		settingsReq = *settings
+	}
+
+	if len(posted) != 2 || strings.Count(posted[1][0], "include-toplevel") != 1 {
+		t.Errorf("expected directive submitted once per update, got %q", posted)
+	}
+
+	if stored["port"] != "53" {
+		t.Errorf("expected unmanaged port setting to be kept, got '%s'", stored["port"])
+	}
+
+	// DNSSEC is turned off by omitting the checkbox
+	_ = settingsReq.SetDNSSEC(false)
+
+	settings, err := pf.UpdateDNSResolverSettings(context.Background(), settingsReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if settings.DNSSEC {
+		t.Error("expected DNSSEC disabled")
+	}
+}
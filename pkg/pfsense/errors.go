@@ -2,6 +2,8 @@ package pfsense
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var (
@@ -9,6 +11,7 @@ var (
 	ErrHTTPStatus            = errors.New("HTTP status")
 	ErrLoginFailed           = errors.New("login failed")
 	ErrNotFound              = errors.New("not found")
+	ErrAmbiguous             = errors.New("ambiguous")
 	ErrUnableToParse         = errors.New("unable to parse")
 	ErrUnableToScrapeHTML    = errors.New("unable to scrape HTML")
 	ErrClientValidation      = errors.New("client validation")
@@ -17,4 +20,28 @@ var (
 	ErrCreateOperationFailed = errors.New("failed to create")
 	ErrUpdateOperationFailed = errors.New("failed to update")
 	ErrDeleteOperationFailed = errors.New("failed to delete")
+	ErrResultMismatch        = errors.New("result does not match request")
 )
+
+// checkDifferences reports the differences between a request and the re-read result, pfSense can silently ignore or
+// normalize submitted fields.
+func checkDifferences(differences []string) error {
+	if len(differences) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w, %s", ErrResultMismatch, strings.Join(differences, ", "))
+}
+
+// ServerValidationError contains the individual input errors reported by pfSense.
+type ServerValidationError struct {
+	Messages []string
+}
+
+func (e *ServerValidationError) Error() string {
+	return fmt.Sprintf("%s, '%s'", ErrServerValidation, strings.Join(e.Messages, ", "))
+}
+
+func (e *ServerValidationError) Unwrap() error {
+	return ErrServerValidation
+}
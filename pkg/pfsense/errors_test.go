@@ -0,0 +1,22 @@
+package pfsense
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCheckDifferences(t *testing.T) {
+	if err := checkDifferences(nil); err != nil {
+		t.Errorf("expected no error without differences, got %s", err)
+	}
+
+	err := checkDifferences([]string{"description is 'b', expected 'a'", "has 1 entries, expected 2"})
+	if !errors.Is(err, ErrResultMismatch) {
+		t.Fatalf("expected result mismatch, got %v", err)
+	}
+
+	if !strings.HasSuffix(err.Error(), "description is 'b', expected 'a', has 1 entries, expected 2") {
+		t.Errorf("expected all differences in error, got '%s'", err)
+	}
+}
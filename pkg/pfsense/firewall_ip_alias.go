@@ -3,13 +3,44 @@ package pfsense
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+const (
+	firewallIPAliasEntriesChunkSize = 1000
+	// pfSense sets PHP max_input_vars to 5000, each entry is two form fields (address and detail) and a few are
+	// reserved for the remaining fields, larger submissions are silently truncated by PHP
+	firewallIPAliasMaxEntries     = 2490
+	DefaultIPv4EntryWarningPrefix = 8
+	DefaultIPv6EntryWarningPrefix = 16
+)
+
+// errTruncatedResponse signals a response to read again in smaller parts.
+var errTruncatedResponse = fmt.Errorf("%w, truncated response", ErrUnableToParse)
+
+// nestedAliasName matches nested alias names (letters, digits and underscores only), which are case-sensitive.
+var nestedAliasName = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// bogonPrefixes are reserved networks not covered by the netip.Addr classification methods.
+var bogonPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
 type firewallIPAliasResponse struct {
 	Name        string `json:"name"`
 	Description string `json:"descr"`
@@ -51,6 +82,16 @@ func (ipAlias *FirewallIPAlias) SetType(t string) error {
 }
 
 func (entry *FirewallIPAliasEntry) SetAddress(addr string) error {
+	// pfSense stores single host networks (/32 and /128) as addresses
+	if prefix, err := netip.ParsePrefix(addr); err == nil && prefix.IsSingleIP() {
+		addr = prefix.Addr().String()
+	}
+
+	// FQDNs are case-insensitive, nested alias names are not
+	if !nestedAliasName.MatchString(addr) {
+		addr = strings.ToLower(addr)
+	}
+
 	entry.Address = addr
 
 	return nil
@@ -62,6 +103,145 @@ func (entry *FirewallIPAliasEntry) SetDescription(description string) error {
 	return nil
 }
 
+// ValidateEntryAddress checks an entry address as configured (before normalization) against the alias type. Host
+// aliases do not accept networks and network aliases require single hosts to be written as a /32 or /128 network.
+func (ipAlias FirewallIPAlias) ValidateEntryAddress(addr string) error {
+	switch ipAlias.Type {
+	case "host":
+		if prefix, err := netip.ParsePrefix(addr); err == nil && !prefix.IsSingleIP() {
+			return fmt.Errorf("%w, host alias entry '%s' is a network, use a network alias instead", ErrClientValidation, addr)
+		}
+	case "network":
+		if ip, err := netip.ParseAddr(addr); err == nil {
+			return fmt.Errorf("%w, network alias entry '%s' is a single host, use '%s' instead", ErrClientValidation, addr, netip.PrefixFrom(ip, ip.BitLen()))
+		}
+	}
+
+	return nil
+}
+
+// Networks returns the normalized (masked) networks of a network alias, entries that are not an IP address or CIDR
+// (e.g. FQDNs or nested aliases) are skipped.
+func (ipAlias FirewallIPAlias) Networks() []netip.Prefix {
+	var prefixes []netip.Prefix
+	if ipAlias.Type != "network" {
+		return prefixes
+	}
+
+	for _, entry := range ipAlias.Entries {
+		if prefix, err := netip.ParsePrefix(entry.Address); err == nil {
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+
+		if addr, err := netip.ParseAddr(entry.Address); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+
+	return prefixes
+}
+
+// EntryIndex returns the index of the entry referenced by a server validation message, pfSense identifies rejected
+// entries by address rather than position.
+func (ipAlias FirewallIPAlias) EntryIndex(message string) (int, bool) {
+	for _, field := range strings.Fields(message) {
+		field = strings.Trim(field, "'\",.")
+		for i, entry := range ipAlias.Entries {
+			if entry.Address == field {
+				return i, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// FirewallIPAliasEntryChecks configures which entries are reported by EntryWarnings.
+type FirewallIPAliasEntryChecks struct {
+	IPv4PrefixLength int  // networks shorter than this are reported (e.g. 0.0.0.0/0)
+	IPv6PrefixLength int  // networks shorter than this are reported (e.g. ::/0)
+	Bogons           bool // private, reserved, and other non-public addresses are reported
+}
+
+func isBogon(addr netip.Addr) bool {
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return true
+	}
+
+	for _, prefix := range bogonPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EntryWarnings returns likely mistakes keyed by entry index, entries that are not an IP address or CIDR are skipped.
+func (ipAlias FirewallIPAlias) EntryWarnings(checks FirewallIPAliasEntryChecks) map[int]string {
+	warnings := map[int]string{}
+
+	for i, entry := range ipAlias.Entries {
+		prefix, err := netip.ParsePrefix(entry.Address)
+		if err != nil {
+			addr, err := netip.ParseAddr(entry.Address)
+			if err != nil {
+				continue
+			}
+
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+
+		minBits := checks.IPv4PrefixLength
+		if prefix.Addr().Is6() {
+			minBits = checks.IPv6PrefixLength
+		}
+
+		switch {
+		case prefix.Addr().IsUnspecified() && prefix.IsSingleIP():
+			warnings[i] = fmt.Sprintf("entry '%s' is the unspecified address", entry.Address)
+		case prefix.Bits() < minBits:
+			warnings[i] = fmt.Sprintf("entry '%s' is a network broader than /%d, it may match far more than intended", entry.Address, minBits)
+		case checks.Bogons && isBogon(prefix.Addr()):
+			warnings[i] = fmt.Sprintf("entry '%s' is a private or reserved (bogon) address", entry.Address)
+		}
+	}
+
+	return warnings
+}
+
+// Differences describes how the actual alias differs from this (desired) alias, entries are compared in order.
+func (ipAlias FirewallIPAlias) Differences(actual FirewallIPAlias) []string {
+	var differences []string
+
+	if ipAlias.Type != actual.Type {
+		differences = append(differences, fmt.Sprintf("type is '%s', expected '%s'", actual.Type, ipAlias.Type))
+	}
+
+	if ipAlias.Description != actual.Description {
+		differences = append(differences, fmt.Sprintf("description is '%s', expected '%s'", actual.Description, ipAlias.Description))
+	}
+
+	if len(ipAlias.Entries) != len(actual.Entries) {
+		differences = append(differences, fmt.Sprintf("has %d entries, expected %d", len(actual.Entries), len(ipAlias.Entries)))
+	}
+
+	for i := 0; i < len(ipAlias.Entries) && i < len(actual.Entries); i++ {
+		desired, current := ipAlias.Entries[i], actual.Entries[i]
+
+		if desired.Address != current.Address {
+			differences = append(differences, fmt.Sprintf("entry %d address is '%s', expected '%s'", i, current.Address, desired.Address))
+		}
+
+		if desired.Description != current.Description {
+			differences = append(differences, fmt.Sprintf("entry %d description is '%s', expected '%s'", i, current.Description, desired.Description))
+		}
+	}
+
+	return differences
+}
+
 type FirewallIPAliases []FirewallIPAlias
 
 func (ipAliases FirewallIPAliases) GetByName(name string) (*FirewallIPAlias, error) {
@@ -74,12 +254,60 @@ func (ipAliases FirewallIPAliases) GetByName(name string) (*FirewallIPAlias, err
 }
 
 func (ipAliases FirewallIPAliases) GetControlIDByName(name string) (*int, error) {
-	for _, ipAlias := range ipAliases {
-		if ipAlias.Name == name {
-			return &ipAlias.controlID, nil
+	return getControlID(ipAliases, func(ipAlias FirewallIPAlias) bool { return ipAlias.Name == name },
+		func(_ int, ipAlias FirewallIPAlias) int { return ipAlias.controlID }, "firewall IP alias", fmt.Sprintf("name '%s'", name))
+}
+
+func parseFirewallIPAliasResponse(resp firewallIPAliasResponse) (*FirewallIPAlias, error) {
+	var ipAlias FirewallIPAlias
+	var err error
+
+	err = ipAlias.SetName(resp.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+	}
+
+	err = ipAlias.SetDescription(resp.Description)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+	}
+
+	err = ipAlias.SetType(resp.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+	}
+
+	ipAlias.controlID = resp.ControlID
+
+	if resp.Addresses == "" {
+		return &ipAlias, nil
+	}
+
+	addresses := strings.Split(resp.Addresses, " ")
+	details := strings.Split(resp.Details, "||")
+
+	if len(addresses) != len(details) {
+		return nil, fmt.Errorf("%w firewall IP alias response, addresses and descriptions do not match", ErrUnableToParse)
+	}
+
+	for i := range addresses {
+		var entry FirewallIPAliasEntry
+		var err error
+
+		err = entry.SetAddress(addresses[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+		}
+
+		err = entry.SetDescription(details[i])
+		if err != nil {
+			return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
 		}
+
+		ipAlias.Entries = append(ipAlias.Entries, entry)
 	}
-	return nil, fmt.Errorf("firewall IP alias %w with name '%s'", ErrNotFound, name)
+
+	return &ipAlias, nil
 }
 
 func (pf *Client) getFirewallIPAliases(ctx context.Context) (*FirewallIPAliases, error) {
@@ -95,64 +323,132 @@ func (pf *Client) getFirewallIPAliases(ctx context.Context) (*FirewallIPAliases,
 		return nil, err
 	}
 
+	ipAliases, err := parseFirewallIPAliasesResponse(b)
+	if errors.Is(err, errTruncatedResponse) {
+		return pf.getFirewallIPAliasesChunked(ctx)
+	}
+
+	return ipAliases, err
+}
+
+// parseFirewallIPAliasesResponse returns errTruncatedResponse when the response is not complete JSON, large aliases can
+// exceed PHP memory or output limits.
+func parseFirewallIPAliasesResponse(b []byte) (*FirewallIPAliases, error) {
+	if !json.Valid(b) {
+		return nil, errTruncatedResponse
+	}
+
 	var ipAliasResp []firewallIPAliasResponse
-	err = json.Unmarshal(b, &ipAliasResp)
+	err := json.Unmarshal(b, &ipAliasResp)
 	if err != nil {
 		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
 	}
 
 	var ipAliases FirewallIPAliases
 	for _, resp := range ipAliasResp {
-		var ipAlias FirewallIPAlias
-		var err error
-
-		err = ipAlias.SetName(resp.Name)
+		ipAlias, err := parseFirewallIPAliasResponse(resp)
 		if err != nil {
-			return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+			return nil, err
 		}
 
-		err = ipAlias.SetDescription(resp.Description)
-		if err != nil {
-			return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
-		}
+		ipAliases = append(ipAliases, *ipAlias)
+	}
 
-		err = ipAlias.SetType(resp.Type)
-		if err != nil {
-			return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
-		}
+	return &ipAliases, nil
+}
 
-		ipAlias.controlID = resp.ControlID
+// mergeFirewallIPAliasEntryChunks joins the entries of a large alias read in ranges, every chunk must belong to the
+// alias and together they must contain the number of entries counted before reading.
+func mergeFirewallIPAliasEntryChunks(resp firewallIPAliasResponse, entryCount int, chunks []firewallIPAliasResponse) (firewallIPAliasResponse, error) {
+	if entryCount == 0 {
+		return resp, nil
+	}
 
-		if resp.Addresses == "" {
-			ipAliases = append(ipAliases, ipAlias)
-			continue
+	var addresses []string
+	var details []string
+	for _, chunk := range chunks {
+		if chunk.Name != resp.Name {
+			return resp, fmt.Errorf("%w firewall IP alias response, alias '%s' changed while reading entries", ErrUnableToParse, resp.Name)
 		}
 
-		addresses := strings.Split(resp.Addresses, " ")
-		details := strings.Split(resp.Details, "||")
+		addresses = append(addresses, chunk.Addresses)
+		details = append(details, chunk.Details)
+	}
 
-		if len(addresses) != len(details) {
-			return nil, fmt.Errorf("%w firewall IP alias response, addresses and descriptions do not match", ErrUnableToParse)
-		}
+	resp.Addresses = strings.Join(addresses, " ")
+	resp.Details = strings.Join(details, "||")
+
+	if count := len(strings.Split(resp.Addresses, " ")); count != entryCount {
+		return resp, fmt.Errorf("%w firewall IP alias response, alias '%s' has %d entries, expected %d", ErrUnableToParse, resp.Name, count, entryCount)
+	}
+
+	return resp, nil
+}
+
+// getFirewallIPAliasesChunked reads aliases without the entries of large aliases, then reads those entries in ranges.
+func (pf *Client) getFirewallIPAliasesChunked(ctx context.Context) (*FirewallIPAliases, error) {
+	type chunkedFirewallIPAliasResponse struct {
+		firewallIPAliasResponse
+		EntryCount int `json:"entryCount"`
+	}
+
+	command := "$output = array();" +
+		"array_walk($config['aliases']['alias'], function(&$v, $k) use (&$output) {" +
+		"if (in_array($v['type'], array('host', 'network'))) {" +
+		"$v['controlID'] = $k; $v['entryCount'] = 0;" +
+		"$count = empty($v['address']) ? 0 : substr_count($v['address'], ' ') + 1;" +
+		fmt.Sprintf("if ($count > %d) {", firewallIPAliasEntriesChunkSize) +
+		"$v['entryCount'] = $count; unset($v['address']); unset($v['detail']);" +
+		"}" +
+		"array_push($output, $v);" +
+		"}});" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var ipAliasResp []chunkedFirewallIPAliasResponse
+	err = json.Unmarshal(b, &ipAliasResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var ipAliases FirewallIPAliases
+	for _, resp := range ipAliasResp {
+		var chunks []firewallIPAliasResponse
 
-		for i := range addresses {
-			var entry FirewallIPAliasEntry
-			var err error
+		for offset := 0; offset < resp.EntryCount; offset += firewallIPAliasEntriesChunkSize {
+			command := fmt.Sprintf("$v = $config['aliases']['alias'][%d];", resp.ControlID) +
+				fmt.Sprintf("print_r(json_encode(array('name' => $v['name'], 'address' => implode(' ', array_slice(explode(' ', $v['address']), %d, %d)), 'detail' => implode('||', array_slice(explode('||', $v['detail']), %d, %d)))));",
+					offset, firewallIPAliasEntriesChunkSize, offset, firewallIPAliasEntriesChunkSize)
 
-			err = entry.SetAddress(addresses[i])
+			b, err := pf.runPHPCommand(ctx, command)
 			if err != nil {
-				return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+				return nil, err
 			}
 
-			err = entry.SetDescription(details[i])
+			var chunkResp firewallIPAliasResponse
+			err = json.Unmarshal(b, &chunkResp)
 			if err != nil {
-				return nil, fmt.Errorf("%w firewall IP alias response, %w", ErrUnableToParse, err)
+				return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
 			}
 
-			ipAlias.Entries = append(ipAlias.Entries, entry)
+			chunks = append(chunks, chunkResp)
 		}
 
-		ipAliases = append(ipAliases, ipAlias)
+		mergedResp, err := mergeFirewallIPAliasEntryChunks(resp.firewallIPAliasResponse, resp.EntryCount, chunks)
+		if err != nil {
+			return nil, err
+		}
+
+		ipAlias, err := parseFirewallIPAliasResponse(mergedResp)
+		if err != nil {
+			return nil, err
+		}
+
+		ipAliases = append(ipAliases, *ipAlias)
 	}
 
 	return &ipAliases, nil
@@ -182,7 +478,49 @@ func (pf *Client) GetFirewallIPAlias(ctx context.Context, name string) (*Firewal
 	return ipAliases.GetByName(name)
 }
 
+// GetFirewallIPAliasEntryCount returns the number of entries of an alias without reading the entries themselves.
+func (pf *Client) GetFirewallIPAliasEntryCount(ctx context.Context, name string) (int, error) {
+	pf.mutexes.FirewallAlias.Lock()
+	defer pf.mutexes.FirewallAlias.Unlock()
+
+	type entryCountResponse struct {
+		Name       string `json:"name"`
+		EntryCount int    `json:"entryCount"`
+	}
+
+	command := "$output = array();" +
+		"array_walk($config['aliases']['alias'], function(&$v, $k) use (&$output) {" +
+		"if (in_array($v['type'], array('host', 'network'))) {" +
+		"$count = empty($v['address']) ? 0 : substr_count($v['address'], ' ') + 1;" +
+		"array_push($output, array('name' => $v['name'], 'entryCount' => $count));" +
+		"}});" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return 0, fmt.Errorf("%w firewall IP alias entry count (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	var countResp []entryCountResponse
+	err = json.Unmarshal(b, &countResp)
+	if err != nil {
+		return 0, fmt.Errorf("%w firewall IP alias entry count (name '%s'), %w, %w", ErrGetOperationFailed, name, ErrUnableToParse, err)
+	}
+
+	for _, resp := range countResp {
+		if resp.Name == name {
+			return resp.EntryCount, nil
+		}
+	}
+
+	return 0, fmt.Errorf("firewall IP alias %w with name '%s'", ErrNotFound, name)
+}
+
 func (pf *Client) createOrUpdateFirewallIPAlias(ctx context.Context, ipAliasReq FirewallIPAlias, controlID *int) (*FirewallIPAlias, error) {
+	if len(ipAliasReq.Entries) > firewallIPAliasMaxEntries {
+		return nil, fmt.Errorf("%w, %d entries exceeds the maximum of %d that can be submitted at once, split the entries across multiple aliases and nest them", ErrClientValidation, len(ipAliasReq.Entries), firewallIPAliasMaxEntries)
+	}
+
 	u := url.URL{Path: "firewall_aliases_edit.php"}
 	v := url.Values{
 		"name":  {ipAliasReq.Name},
@@ -222,6 +560,11 @@ func (pf *Client) createOrUpdateFirewallIPAlias(ctx context.Context, ipAliasReq
 		return nil, err
 	}
 
+	// PHP drops form fields beyond its input limits without an error, detect entries lost to truncation
+	if len(ipAlias.Entries) < len(ipAliasReq.Entries) {
+		return nil, fmt.Errorf("%w, only %d of %d entries were saved, the submission was likely truncated by pfSense", ErrResultMismatch, len(ipAlias.Entries), len(ipAliasReq.Entries))
+	}
+
 	return ipAlias, nil
 }
 
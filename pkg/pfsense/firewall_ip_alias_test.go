@@ -0,0 +1,649 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestFirewallIPAliasDifferences(t *testing.T) {
+	desired := FirewallIPAlias{
+		Name:        "servers",
+		Description: "web servers",
+		Type:        "host",
+		Entries: []FirewallIPAliasEntry{
+			{Address: "10.0.0.1", Description: "web1"},
+			{Address: "10.0.0.2", Description: "web2"},
+		},
+	}
+
+	if differences := desired.Differences(desired); len(differences) != 0 {
+		t.Errorf("expected no differences, got %v", differences)
+	}
+
+	actual := FirewallIPAlias{
+		Name:        "servers",
+		Description: "web",
+		Type:        "network",
+		Entries: []FirewallIPAliasEntry{
+			{Address: "10.0.0.1", Description: ""},
+		},
+	}
+
+	want := []string{
+		"type is 'network', expected 'host'",
+		"description is 'web', expected 'web servers'",
+		"has 1 entries, expected 2",
+		"entry 0 description is '', expected 'web1'",
+	}
+
+	if differences := desired.Differences(actual); !slices.Equal(differences, want) {
+		t.Errorf("Differences() = %v, want %v", differences, want)
+	}
+
+	// entries are compared in order
+	reordered := desired
+	reordered.Entries = []FirewallIPAliasEntry{desired.Entries[1], desired.Entries[0]}
+
+	want = []string{
+		"entry 0 address is '10.0.0.2', expected '10.0.0.1'",
+		"entry 0 description is 'web2', expected 'web1'",
+		"entry 1 address is '10.0.0.1', expected '10.0.0.2'",
+		"entry 1 description is 'web1', expected 'web2'",
+	}
+
+	if differences := desired.Differences(reordered); !slices.Equal(differences, want) {
+		t.Errorf("Differences() = %v, want %v", differences, want)
+	}
+}
+
+func TestFirewallIPAliasEntrySetAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want string
+	}{
+		{"10.0.0.1", "10.0.0.1"},
+		{"10.0.0.1/32", "10.0.0.1"},
+		{"10.0.0.0/24", "10.0.0.0/24"},
+		{"10.0.0.1/24", "10.0.0.1/24"},
+		{"10.0.0.0/31", "10.0.0.0/31"},
+		{"fd00::1", "fd00::1"},
+		{"fd00::1/128", "fd00::1"},
+		{"FD00::1/128", "fd00::1"},
+		{"fd00::/64", "fd00::/64"},
+		{"FD00::/64", "fd00::/64"},
+		{"Host.Example.COM", "host.example.com"},
+		{"host.example.com", "host.example.com"},
+		{"Web_Servers", "Web_Servers"},
+		{"servers2", "servers2"},
+		{"10.0.0.1-10.0.0.10", "10.0.0.1-10.0.0.10"},
+	}
+
+	for _, tt := range tests {
+		var entry FirewallIPAliasEntry
+		if err := entry.SetAddress(tt.addr); err != nil {
+			t.Errorf("SetAddress(%q) unexpected error, %s", tt.addr, err)
+			continue
+		}
+
+		if entry.Address != tt.want {
+			t.Errorf("SetAddress(%q) = %q, want %q", tt.addr, entry.Address, tt.want)
+		}
+	}
+}
+
+func TestFirewallIPAliasValidateEntryAddress(t *testing.T) {
+	tests := []struct {
+		aliasType string
+		addr      string
+		valid     bool
+	}{
+		{"host", "10.0.0.1", true},
+		{"host", "10.0.0.1/32", true},
+		{"host", "fd00::1/128", true},
+		{"host", "10.0.0.0/24", false},
+		{"host", "fd00::/64", false},
+		{"host", "host.example.com", true},
+		{"host", "servers", true},
+		{"network", "10.0.0.0/24", true},
+		{"network", "10.0.0.1/32", true},
+		{"network", "fd00::/64", true},
+		{"network", "10.0.0.1", false},
+		{"network", "fd00::1", false},
+		{"network", "host.example.com", true},
+		{"network", "servers", true},
+	}
+
+	for _, tt := range tests {
+		err := FirewallIPAlias{Type: tt.aliasType}.ValidateEntryAddress(tt.addr)
+		if tt.valid && err != nil {
+			t.Errorf("%s alias entry '%s' unexpected error, %s", tt.aliasType, tt.addr, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s alias entry '%s' expected validation error, got %v", tt.aliasType, tt.addr, err)
+		}
+	}
+}
+
+func testFirewallIPAliasAddresses(count int) []string {
+	addresses := make([]string, count)
+	for i := range addresses {
+		addresses[i] = netip.AddrFrom4([4]byte{10, byte(i >> 16), byte(i >> 8), byte(i)}).String()
+	}
+
+	return addresses
+}
+
+func TestFirewallIPAliasEntryWarnings(t *testing.T) {
+	defaults := FirewallIPAliasEntryChecks{
+		IPv4PrefixLength: DefaultIPv4EntryWarningPrefix,
+		IPv6PrefixLength: DefaultIPv6EntryWarningPrefix,
+		Bogons:           true,
+	}
+	disabled := FirewallIPAliasEntryChecks{}
+
+	tests := []struct {
+		address string
+		checks  FirewallIPAliasEntryChecks
+		want    string
+	}{
+		{"0.0.0.0/0", defaults, "broader than /8"},
+		{"::/0", defaults, "broader than /16"},
+		{"0.0.0.0", defaults, "unspecified address"},
+		{"::", defaults, "unspecified address"},
+		{"1.0.0.0/7", defaults, "broader than /8"},
+		{"10.0.0.0/8", defaults, "bogon"},
+		{"10.0.0.1", defaults, "bogon"},
+		{"172.16.5.0/24", defaults, "bogon"},
+		{"192.168.1.10/32", defaults, "bogon"},
+		{"127.0.0.1", defaults, "bogon"},
+		{"169.254.1.1", defaults, "bogon"},
+		{"224.0.0.1", defaults, "bogon"},
+		{"100.64.0.1", defaults, "bogon"},
+		{"192.0.2.0/24", defaults, "bogon"},
+		{"198.18.0.1", defaults, "bogon"},
+		{"203.0.113.5", defaults, "bogon"},
+		{"240.0.0.1", defaults, "bogon"},
+		{"fd00::1", defaults, "bogon"},
+		{"fe80::1", defaults, "bogon"},
+		{"2001:db8::/48", defaults, "bogon"},
+		{"8.8.8.8", defaults, ""},
+		{"100.128.0.1", defaults, ""},
+		{"198.20.0.1", defaults, ""},
+		{"2001:4860::/32", defaults, ""},
+		{"example.com", defaults, ""},
+		{"0.0.0.0/0", FirewallIPAliasEntryChecks{IPv4PrefixLength: 0, Bogons: true}, "bogon"},
+		{"0.0.0.0/0", disabled, ""},
+		{"0.0.0.0", disabled, "unspecified address"},
+		{"10.0.0.1", disabled, ""},
+		{"10.0.0.0/7", FirewallIPAliasEntryChecks{IPv4PrefixLength: 8}, "broader than /8"},
+		{"10.0.0.0/12", FirewallIPAliasEntryChecks{IPv4PrefixLength: 16, Bogons: true}, "broader than /16"},
+	}
+
+	for _, tt := range tests {
+		ipAlias := FirewallIPAlias{Entries: []FirewallIPAliasEntry{{Address: tt.address}}}
+		warnings := ipAlias.EntryWarnings(tt.checks)
+
+		if tt.want == "" {
+			if len(warnings) != 0 {
+				t.Errorf("EntryWarnings(%q, %+v) = %v, want none", tt.address, tt.checks, warnings)
+			}
+
+			continue
+		}
+
+		if len(warnings) != 1 || !strings.Contains(warnings[0], tt.want) {
+			t.Errorf("EntryWarnings(%q, %+v) = %v, want warning containing %q", tt.address, tt.checks, warnings, tt.want)
+		}
+	}
+}
+
+func TestFirewallIPAliasEntryWarningsIndex(t *testing.T) {
+	ipAlias := FirewallIPAlias{Entries: []FirewallIPAliasEntry{
+		{Address: "8.8.8.8"},
+		{Address: "0.0.0.0/0"},
+		{Address: "host.example.com"},
+		{Address: "10.0.0.1"},
+	}}
+
+	warnings := ipAlias.EntryWarnings(FirewallIPAliasEntryChecks{IPv4PrefixLength: DefaultIPv4EntryWarningPrefix, Bogons: true})
+
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %v", warnings)
+	}
+
+	for _, i := range []int{1, 3} {
+		if _, ok := warnings[i]; !ok {
+			t.Errorf("expected warning for entry %d, got %v", i, warnings)
+		}
+	}
+}
+
+func TestParseFirewallIPAliasesResponse(t *testing.T) {
+	b := []byte(`[{"name": "servers", "type": "host", "address": "10.0.0.1 10.0.0.2", "detail": "web1||web2", "controlID": 0}]`)
+
+	ipAliases, err := parseFirewallIPAliasesResponse(b)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(*ipAliases) != 1 || len((*ipAliases)[0].Entries) != 2 {
+		t.Errorf("unexpected aliases %+v", ipAliases)
+	}
+
+	for _, truncated := range [][]byte{b[:len(b)/2], b[:len(b)-1], {}} {
+		if _, err := parseFirewallIPAliasesResponse(truncated); !errors.Is(err, errTruncatedResponse) {
+			t.Errorf("expected truncated response for %q, got %v", truncated, err)
+		}
+	}
+}
+
+func TestParseFirewallIPAliasesResponseMixedCaseFQDN(t *testing.T) {
+	b := []byte(`[{"name": "servers", "type": "host", "address": "Host.Example.COM Web_Servers", "detail": "||", "controlID": 0}]`)
+
+	ipAliases, err := parseFirewallIPAliasesResponse(b)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var got []string
+	for _, entry := range (*ipAliases)[0].Entries {
+		got = append(got, entry.Address)
+	}
+
+	if want := []string{"host.example.com", "Web_Servers"}; !slices.Equal(got, want) {
+		t.Errorf("entry addresses = %v, want %v", got, want)
+	}
+}
+
+func TestMergeFirewallIPAliasEntryChunks(t *testing.T) {
+	resp := firewallIPAliasResponse{Name: "servers", Type: "host"}
+	chunks := []firewallIPAliasResponse{
+		{Name: "servers", Addresses: "10.0.0.1 10.0.0.2", Details: "web1||web2"},
+		{Name: "servers", Addresses: "10.0.0.3", Details: ""},
+	}
+
+	merged, err := mergeFirewallIPAliasEntryChunks(resp, 3, chunks)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if merged.Addresses != "10.0.0.1 10.0.0.2 10.0.0.3" || merged.Details != "web1||web2||" {
+		t.Errorf("unexpected merged response %+v", merged)
+	}
+
+	// small aliases are read in full
+	small := firewallIPAliasResponse{Name: "small", Addresses: "10.0.0.1", Details: "web1"}
+	if merged, err := mergeFirewallIPAliasEntryChunks(small, 0, nil); err != nil || merged != small {
+		t.Errorf("expected small alias unchanged, got %+v (%v)", merged, err)
+	}
+
+	if _, err := mergeFirewallIPAliasEntryChunks(resp, 4, chunks); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected entry count mismatch, got %v", err)
+	}
+
+	renamed := append(slices.Clone(chunks), firewallIPAliasResponse{Name: "other", Addresses: "10.0.0.4"})
+	if _, err := mergeFirewallIPAliasEntryChunks(resp, 4, renamed); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected changed alias error, got %v", err)
+	}
+}
+
+func TestGetFirewallIPAliasesTruncatedResponse(t *testing.T) {
+	addresses := testFirewallIPAliasAddresses(2500)
+	details := make([]string, len(addresses))
+	for i := range details {
+		details[i] = fmt.Sprintf("entry %d", i)
+	}
+
+	chunkRange := regexp.MustCompile(`array_slice\(explode\(' ', \$v\['address'\]\), (\d+), (\d+)\)`)
+	var chunked atomic.Int32
+
+	server := httptest.NewServer(testPfSenseHandler(testPHPCommandHandler(func(command string) string {
+		switch {
+		case strings.Contains(command, "array_slice"):
+			chunked.Add(1)
+			m := chunkRange.FindStringSubmatch(command)
+			offset, _ := strconv.Atoi(m[1])
+			length, _ := strconv.Atoi(m[2])
+			end := min(offset+length, len(addresses))
+
+			b, _ := json.Marshal(map[string]string{
+				"name":    "blocklist",
+				"address": strings.Join(addresses[offset:end], " "),
+				"detail":  strings.Join(details[offset:end], "||"),
+			})
+
+			return string(b)
+		case strings.Contains(command, "entryCount"):
+			return fmt.Sprintf(`[{"name": "servers", "type": "host", "address": "10.0.0.1", "detail": "web1", "controlID": 0, "entryCount": 0},`+
+				`{"name": "blocklist", "type": "network", "controlID": 1, "entryCount": %d}]`, len(addresses))
+		default:
+			// the full response exceeds PHP limits
+			return `[{"name": "servers", "type": "host", "address": "10.0.0.1", "detail": "web1", "controlID": 0},{"name": "blocklist", "type": "network", "address": "10.0.0.0 10.0.`
+		}
+	})))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	ipAliases, err := pf.GetFirewallIPAliases(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if got := chunked.Load(); got != 3 {
+		t.Errorf("expected 3 chunks, got %d", got)
+	}
+
+	ipAlias, err := ipAliases.GetByName("blocklist")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(ipAlias.Entries) != len(addresses) {
+		t.Fatalf("expected %d entries, got %d", len(addresses), len(ipAlias.Entries))
+	}
+
+	for i, entry := range ipAlias.Entries {
+		if entry.Address != addresses[i] || entry.Description != details[i] {
+			t.Errorf("entry %d = %+v, want '%s' '%s'", i, entry, addresses[i], details[i])
+			break
+		}
+	}
+
+	if servers, err := ipAliases.GetByName("servers"); err != nil || len(servers.Entries) != 1 {
+		t.Errorf("expected small alias read in full, got %+v (%v)", servers, err)
+	}
+}
+
+// testFirewallIPAliasServer stores aliases submitted to firewall_aliases_edit.php, saving at most saveLimit entries
+// to simulate a server that drops part of a large submission. Aliases are addressed by config index, like pfSense
+// the indexes of later aliases shift when one is deleted through firewall_aliases.php.
+func testFirewallIPAliasServer(t *testing.T, saveLimit int, posts *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	var mutex sync.Mutex
+	var aliases []map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/firewall_aliases_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("unable to parse form, %s", err)
+		}
+
+		// PHP drops form fields beyond max_input_vars
+		var fields int
+		for _, values := range r.PostForm {
+			fields += len(values)
+		}
+
+		if fields > 5000 {
+			t.Errorf("submitted %d form fields, more than max_input_vars allows", fields)
+		}
+
+		var addresses, details []string
+		for i := 0; i < saveLimit && r.PostForm.Has(fmt.Sprintf("address%d", i)); i++ {
+			addresses = append(addresses, r.PostFormValue(fmt.Sprintf("address%d", i)))
+			details = append(details, r.PostFormValue(fmt.Sprintf("detail%d", i)))
+		}
+
+		alias := map[string]any{
+			"name":    r.PostFormValue("name"),
+			"descr":   r.PostFormValue("descr"),
+			"type":    r.PostFormValue("type"),
+			"address": strings.Join(addresses, " "),
+			"detail":  strings.Join(details, "||"),
+		}
+
+		mutex.Lock()
+		if id, err := strconv.Atoi(r.URL.Query().Get("id")); err == nil && id < len(aliases) {
+			aliases[id] = alias
+		} else {
+			aliases = append(aliases, alias)
+		}
+		mutex.Unlock()
+
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.HandleFunc("/firewall_aliases.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		if id, err := strconv.Atoi(r.PostFormValue("id")); err == nil && r.PostFormValue("act") == "del" && id < len(aliases) {
+			aliases = slices.Delete(aliases, id, id+1)
+		}
+		mutex.Unlock()
+
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(command string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if strings.Contains(command, "'entryCount' => $count") {
+			var counts []map[string]any
+			for _, alias := range aliases {
+				counts = append(counts, map[string]any{"name": alias["name"], "entryCount": len(strings.Fields(alias["address"].(string)))})
+			}
+
+			b, _ := json.Marshal(counts)
+			return string(b)
+		}
+
+		var resp []map[string]any
+		for i, alias := range aliases {
+			resp = append(resp, map[string]any{"controlID": i})
+			maps.Copy(resp[i], alias)
+		}
+
+		b, _ := json.Marshal(resp)
+		return string(b)
+	}))
+
+	return httptest.NewServer(testPfSenseHandler(mux))
+}
+
+func testFirewallIPAliasWithEntries(name string, count int) FirewallIPAlias {
+	ipAlias := FirewallIPAlias{Name: name, Type: "host"}
+	for i, address := range testFirewallIPAliasAddresses(count) {
+		ipAlias.Entries = append(ipAlias.Entries, FirewallIPAliasEntry{Address: address, Description: fmt.Sprintf("entry %d", i)})
+	}
+
+	return ipAlias
+}
+
+func TestCreateFirewallIPAliasLargeAlias(t *testing.T) {
+	var posts atomic.Int32
+	server := testFirewallIPAliasServer(t, 5000, &posts)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	ipAliasReq := testFirewallIPAliasWithEntries("blocklist", 2000)
+	ipAlias, err := pf.CreateFirewallIPAlias(context.Background(), ipAliasReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if !slices.Equal(ipAlias.Entries, ipAliasReq.Entries) {
+		t.Errorf("expected all %d entries to persist, got %d", len(ipAliasReq.Entries), len(ipAlias.Entries))
+	}
+
+	count, err := pf.GetFirewallIPAliasEntryCount(context.Background(), "blocklist")
+	if err != nil || count != 2000 {
+		t.Errorf("expected entry count of 2000, got %d (%v)", count, err)
+	}
+
+	if _, err := pf.GetFirewallIPAliasEntryCount(context.Background(), "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestCreateFirewallIPAliasTruncatedSubmission(t *testing.T) {
+	var posts atomic.Int32
+	server := testFirewallIPAliasServer(t, 1500, &posts)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	_, err = pf.CreateFirewallIPAlias(context.Background(), testFirewallIPAliasWithEntries("blocklist", 2000))
+	if !errors.Is(err, ErrResultMismatch) || !strings.Contains(err.Error(), "only 1500 of 2000 entries") {
+		t.Errorf("expected truncation error with entry counts, got %v", err)
+	}
+
+	// submissions beyond the form limits are rejected before anything is sent
+	posts.Store(0)
+	_, err = pf.CreateFirewallIPAlias(context.Background(), testFirewallIPAliasWithEntries("toolarge", firewallIPAliasMaxEntries+1))
+	if !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected validation error, got %v", err)
+	}
+
+	if got := posts.Load(); got != 0 {
+		t.Errorf("expected no submissions, got %d", got)
+	}
+}
+
+func TestCreateFirewallIPAliasEntryLimit(t *testing.T) {
+	var posts atomic.Int32
+	server := testFirewallIPAliasServer(t, 5000, &posts)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// an alias at the limit is submitted in full, one more entry is rejected
+	for _, count := range []int{firewallIPAliasMaxEntries - 1, firewallIPAliasMaxEntries} {
+		ipAliasReq := testFirewallIPAliasWithEntries(fmt.Sprintf("limit%d", count), count)
+
+		ipAlias, err := pf.CreateFirewallIPAlias(context.Background(), ipAliasReq)
+		if err != nil {
+			t.Fatalf("%d entries: unexpected error, %s", count, err)
+		}
+
+		if len(ipAlias.Entries) != count {
+			t.Errorf("%d entries: expected all entries to persist, got %d", count, len(ipAlias.Entries))
+		}
+	}
+
+	_, err = pf.CreateFirewallIPAlias(context.Background(), testFirewallIPAliasWithEntries("overlimit", firewallIPAliasMaxEntries+1))
+	if !errors.Is(err, ErrClientValidation) || !strings.Contains(err.Error(), fmt.Sprintf("maximum of %d", firewallIPAliasMaxEntries)) {
+		t.Errorf("expected validation error naming the limit, got %v", err)
+	}
+
+	if got := posts.Load(); got != 2 {
+		t.Errorf("expected 2 submissions, got %d", got)
+	}
+}
+
+func TestUpdateFirewallIPAliasAfterDelete(t *testing.T) {
+	var posts atomic.Int32
+	server := testFirewallIPAliasServer(t, 5000, &posts)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	for _, name := range []string{"first", "middle", "last"} {
+		if _, err := pf.CreateFirewallIPAlias(context.Background(), testFirewallIPAliasWithEntries(name, 1)); err != nil {
+			t.Fatalf("%s: unexpected error, %s", name, err)
+		}
+	}
+
+	if err := pf.DeleteFirewallIPAlias(context.Background(), "middle"); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// the last alias moved to the index of the deleted alias, the update must not land on a neighbor
+	ipAliasReq := testFirewallIPAliasWithEntries("last", 3)
+	ipAliasReq.Description = "updated"
+
+	ipAlias, err := pf.UpdateFirewallIPAlias(context.Background(), ipAliasReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if ipAlias.Description != "updated" || len(ipAlias.Entries) != 3 {
+		t.Errorf("expected last alias updated, got %+v", ipAlias)
+	}
+
+	ipAliases, err := pf.GetFirewallIPAliases(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var names []string
+	for _, ipAlias := range *ipAliases {
+		names = append(names, ipAlias.Name)
+	}
+
+	if !slices.Equal(names, []string{"first", "last"}) {
+		t.Fatalf("expected aliases 'first' and 'last', got %v", names)
+	}
+
+	if first, _ := ipAliases.GetByName("first"); first.Description != "" || len(first.Entries) != 1 {
+		t.Errorf("expected first alias unchanged, got %+v", first)
+	}
+
+	if _, err := pf.GetFirewallIPAlias(context.Background(), "middle"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected deleted alias not found, got %v", err)
+	}
+}
+
+func TestGetFirewallIPAliasEntryOrder(t *testing.T) {
+	var posts atomic.Int32
+	server := testFirewallIPAliasServer(t, 5000, &posts)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	addresses := []string{"10.0.0.3", "host.example.com", "10.0.0.1", "10.0.0.2"}
+	ipAliasReq := FirewallIPAlias{Name: "unsorted", Type: "host"}
+	for _, address := range addresses {
+		ipAliasReq.Entries = append(ipAliasReq.Entries, FirewallIPAliasEntry{Address: address, Description: "entry " + address})
+	}
+
+	if _, err := pf.CreateFirewallIPAlias(context.Background(), ipAliasReq); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	ipAlias, err := pf.GetFirewallIPAlias(context.Background(), "unsorted")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if !slices.Equal(ipAlias.Entries, ipAliasReq.Entries) {
+		t.Errorf("entries = %+v, want %+v", ipAlias.Entries, ipAliasReq.Entries)
+	}
+
+	if _, err := pf.GetFirewallIPAlias(context.Background(), "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
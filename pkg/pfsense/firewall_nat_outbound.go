@@ -0,0 +1,655 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+const (
+	outboundNATAddressAny   = "any"
+	outboundNATTargetSubnet = "other-subnet"
+)
+
+// outboundNATModeValues maps outbound NAT modes to the values stored by pfSense, manual mode is stored as 'advanced'.
+var outboundNATModeValues = map[string]string{
+	"automatic": "automatic",
+	"hybrid":    "hybrid",
+	"manual":    "advanced",
+	"disabled":  "disabled",
+}
+
+func OutboundNATModes() []string {
+	return []string{"automatic", "hybrid", "manual", "disabled"}
+}
+
+func OutboundNATProtocols() []string {
+	return []string{"any", "tcp", "udp", "tcp/udp", "icmp"}
+}
+
+type outboundNATEndpointResponse struct {
+	Any     *string `json:"any"`
+	Network string  `json:"network"`
+	Address string  `json:"address"`
+	Not     *string `json:"not"`
+}
+
+type outboundNATRuleResponse struct {
+	Interface       string                      `json:"interface"`
+	Protocol        string                      `json:"protocol"`
+	Source          outboundNATEndpointResponse `json:"source"`
+	SourcePort      string                      `json:"sourceport"`
+	Destination     outboundNATEndpointResponse `json:"destination"`
+	DestinationPort string                      `json:"dstport"`
+	Target          string                      `json:"target"`
+	TargetIP        string                      `json:"targetip"`
+	TargetIPSubnet  string                      `json:"targetip_subnet"`
+	NATPort         string                      `json:"natport"`
+	StaticNATPort   *string                     `json:"staticnatport"`
+	Disabled        *string                     `json:"disabled"`
+	Description     string                      `json:"descr"`
+}
+
+type OutboundNATRule struct {
+	Interface          string
+	Protocol           string
+	Source             string
+	SourcePort         string
+	Destination        string
+	DestinationPort    string
+	DestinationInvert  bool
+	TranslationAddress string
+	TranslationPort    string
+	StaticPort         bool
+	Disabled           bool
+	Description        string
+	// parseErr is set for rules that do not pass validation (e.g. rules not managed by Terraform using other
+	// protocols), they are kept to preserve control IDs but cannot be returned.
+	parseErr error
+}
+
+// validateOutboundNATAddress accepts 'any', a network in CIDR notation, or an alias.
+func validateOutboundNATAddress(address string) error {
+	if address == outboundNATAddressAny {
+		return nil
+	}
+
+	if _, err := netip.ParsePrefix(address); err == nil {
+		return nil
+	}
+
+	var isAliasName = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	if !isAliasName(address) {
+		return fmt.Errorf("%w, address must be 'any', a network in CIDR notation, or an alias", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func validateOutboundNATPort(port string) error {
+	var endpoint FirewallRuleEndpoint
+
+	return endpoint.SetPort(port)
+}
+
+func (rule *OutboundNATRule) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, outbound NAT rule interface required", ErrClientValidation)
+	}
+
+	rule.Interface = iface
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetProtocol(protocol string) error {
+	if !slices.Contains(OutboundNATProtocols(), protocol) {
+		return fmt.Errorf("%w, outbound NAT rule protocol must be one of %v", ErrClientValidation, OutboundNATProtocols())
+	}
+
+	rule.Protocol = protocol
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetSource(source string) error {
+	err := validateOutboundNATAddress(source)
+	if err != nil {
+		return err
+	}
+
+	rule.Source = source
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetSourcePort(port string) error {
+	err := validateOutboundNATPort(port)
+	if err != nil {
+		return err
+	}
+
+	rule.SourcePort = port
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetDestination(destination string) error {
+	err := validateOutboundNATAddress(destination)
+	if err != nil {
+		return err
+	}
+
+	rule.Destination = destination
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetDestinationPort(port string) error {
+	err := validateOutboundNATPort(port)
+	if err != nil {
+		return err
+	}
+
+	rule.DestinationPort = port
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetDestinationInvert(invert bool) error {
+	rule.DestinationInvert = invert
+
+	return nil
+}
+
+// SetTranslationAddress accepts an address, a network in CIDR notation, or an alias. An empty address translates to
+// the address of the interface.
+func (rule *OutboundNATRule) SetTranslationAddress(address string) error {
+	if address != "" {
+		if _, err := netip.ParseAddr(address); err != nil {
+			err := validateOutboundNATAddress(address)
+			if err != nil || address == outboundNATAddressAny {
+				return fmt.Errorf("%w, translation address must be an address, a network in CIDR notation, or an alias", ErrClientValidation)
+			}
+		}
+	}
+
+	rule.TranslationAddress = address
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetTranslationPort(port string) error {
+	err := validateOutboundNATPort(port)
+	if err != nil {
+		return err
+	}
+
+	rule.TranslationPort = port
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetStaticPort(staticPort bool) error {
+	rule.StaticPort = staticPort
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetDisabled(disabled bool) error {
+	rule.Disabled = disabled
+
+	return nil
+}
+
+func (rule *OutboundNATRule) SetDescription(description string) error {
+	if description == "" {
+		return fmt.Errorf("%w, outbound NAT rule description required", ErrClientValidation)
+	}
+
+	rule.Description = description
+
+	return nil
+}
+
+// Validate checks that a static port is not combined with a translation port.
+func (rule OutboundNATRule) Validate() error {
+	if rule.StaticPort && rule.TranslationPort != "" {
+		return fmt.Errorf("%w, static port and translation port are mutually exclusive", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func outboundNATAddressFormValues(prefix string, address string, v *url.Values) {
+	if address == outboundNATAddressAny {
+		v.Set(fmt.Sprintf("%s_type", prefix), "any")
+
+		return
+	}
+
+	v.Set(fmt.Sprintf("%s_type", prefix), "network")
+
+	if network, err := netip.ParsePrefix(address); err == nil {
+		v.Set(prefix, network.Addr().String())
+		v.Set(fmt.Sprintf("%s_subnet", prefix), strconv.Itoa(network.Bits()))
+	} else {
+		v.Set(prefix, address)
+	}
+}
+
+func (rule OutboundNATRule) formValues(v *url.Values) {
+	outboundNATAddressFormValues("source", rule.Source, v)
+	outboundNATAddressFormValues("destination", rule.Destination, v)
+
+	v.Set("sourceport", rule.SourcePort)
+	v.Set("dstport", rule.DestinationPort)
+
+	if rule.DestinationInvert {
+		v.Set("destination_not", "yes")
+	}
+
+	// addresses and networks are translated with the 'other subnet' target, aliases are selected by name
+	switch {
+	case rule.TranslationAddress == "":
+		v.Set("target", "")
+	case isOutboundNATTargetSubnet(rule.TranslationAddress):
+		network, _ := netip.ParsePrefix(rule.TranslationAddress)
+		if addr, err := netip.ParseAddr(rule.TranslationAddress); err == nil {
+			network = netip.PrefixFrom(addr, addr.BitLen())
+		}
+
+		v.Set("target", outboundNATTargetSubnet)
+		v.Set("targetip", network.Addr().String())
+		v.Set("targetip_subnet", strconv.Itoa(network.Bits()))
+	default:
+		v.Set("target", rule.TranslationAddress)
+	}
+
+	v.Set("natport", rule.TranslationPort)
+
+	if rule.StaticPort {
+		v.Set("staticnatport", "yes")
+	}
+}
+
+func isOutboundNATTargetSubnet(address string) bool {
+	if _, err := netip.ParseAddr(address); err == nil {
+		return true
+	}
+
+	_, err := netip.ParsePrefix(address)
+
+	return err == nil
+}
+
+type OutboundNATRules []OutboundNATRule
+
+func (rules OutboundNATRules) GetByDescription(description string) (*OutboundNATRule, error) {
+	for _, rule := range rules {
+		if rule.Description == description {
+			if rule.parseErr != nil {
+				return nil, fmt.Errorf("%w outbound NAT rule response (description '%s'), %w", ErrUnableToParse, description, rule.parseErr)
+			}
+
+			return &rule, nil
+		}
+	}
+	return nil, fmt.Errorf("outbound NAT rule %w with description '%s'", ErrNotFound, description)
+}
+
+func (rules OutboundNATRules) hasDescription(description string) bool {
+	return slices.ContainsFunc(rules, func(rule OutboundNATRule) bool { return rule.Description == description })
+}
+
+func (rules OutboundNATRules) GetControlIDByDescription(description string) (*int, error) {
+	return getControlID(rules, func(rule OutboundNATRule) bool { return rule.Description == description },
+		positionalControlID, "outbound NAT rule", fmt.Sprintf("description '%s'", description))
+}
+
+func parseOutboundNATEndpointResponse(resp outboundNATEndpointResponse) string {
+	switch {
+	case resp.Any != nil:
+		return outboundNATAddressAny
+	case resp.Network != "":
+		return resp.Network
+	case resp.Address != "":
+		return resp.Address
+	default:
+		return outboundNATAddressAny
+	}
+}
+
+func parseOutboundNATRuleResponse(resp outboundNATRuleResponse) (*OutboundNATRule, error) {
+	var rule OutboundNATRule
+	var err error
+
+	err = rule.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := resp.Protocol
+	if protocol == "" {
+		protocol = "any"
+	}
+
+	err = rule.SetProtocol(protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetSource(parseOutboundNATEndpointResponse(resp.Source))
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetSourcePort(resp.SourcePort)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDestination(parseOutboundNATEndpointResponse(resp.Destination))
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDestinationPort(resp.DestinationPort)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDestinationInvert(resp.Destination.Not != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	translationAddress := resp.Target
+	if resp.Target == outboundNATTargetSubnet {
+		translationAddress = fmt.Sprintf("%s/%s", resp.TargetIP, resp.TargetIPSubnet)
+
+		// single addresses are written without a prefix length
+		if network, err := netip.ParsePrefix(translationAddress); err == nil && network.IsSingleIP() {
+			translationAddress = network.Addr().String()
+		}
+	}
+
+	err = rule.SetTranslationAddress(translationAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetTranslationPort(resp.NATPort)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetStaticPort(resp.StaticNATPort != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDisabled(resp.Disabled != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDescription(resp.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func parseOutboundNATRulesResponse(b []byte) (*OutboundNATRules, error) {
+	var ruleResp []outboundNATRuleResponse
+	err := json.Unmarshal(b, &ruleResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var rules OutboundNATRules
+	for _, resp := range ruleResp {
+		rule, err := parseOutboundNATRuleResponse(resp)
+		if err != nil {
+			// keep the fields needed to identify the rule as-is
+			rules = append(rules, OutboundNATRule{
+				Interface:   resp.Interface,
+				Protocol:    resp.Protocol,
+				Description: resp.Description,
+				parseErr:    err,
+			})
+
+			continue
+		}
+
+		rules = append(rules, *rule)
+	}
+
+	return &rules, nil
+}
+
+func (pf *Client) getOutboundNATRules(ctx context.Context) (*OutboundNATRules, error) {
+	b, err := pf.getConfigJSON(ctx, "['nat']['outbound']['rule']")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseOutboundNATRulesResponse(b)
+}
+
+func (pf *Client) GetOutboundNATRules(ctx context.Context) (*OutboundNATRules, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	rules, err := pf.getOutboundNATRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rules, %w", ErrGetOperationFailed, err)
+	}
+
+	return rules, nil
+}
+
+func (pf *Client) GetOutboundNATRule(ctx context.Context, description string) (*OutboundNATRule, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	rules, err := pf.getOutboundNATRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rule (description '%s'), %w", ErrGetOperationFailed, description, err)
+	}
+
+	return rules.GetByDescription(description)
+}
+
+func (pf *Client) createOrUpdateOutboundNATRule(ctx context.Context, ruleReq OutboundNATRule, controlID *int) (*OutboundNATRule, error) {
+	err := ruleReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Path: "firewall_nat_out_edit.php"}
+	v := url.Values{
+		"interface": {ruleReq.Interface},
+		"protocol":  {ruleReq.Protocol},
+		"descr":     {ruleReq.Description},
+		"save":      {"Save"},
+	}
+
+	ruleReq.formValues(&v)
+
+	if ruleReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := pf.getOutboundNATRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.GetByDescription(ruleReq.Description)
+}
+
+func (pf *Client) CreateOutboundNATRule(ctx context.Context, ruleReq OutboundNATRule) (*OutboundNATRule, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	rules, err := pf.getOutboundNATRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rule, %w", ErrCreateOperationFailed, err)
+	}
+
+	// the description identifies the outbound NAT rule, it must be unique
+	if rules.hasDescription(ruleReq.Description) {
+		return nil, fmt.Errorf("%w outbound NAT rule, %w, description '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, ruleReq.Description)
+	}
+
+	rule, err := pf.createOrUpdateOutboundNATRule(ctx, ruleReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rule, %w", ErrCreateOperationFailed, err)
+	}
+
+	return rule, nil
+}
+
+func (pf *Client) UpdateOutboundNATRule(ctx context.Context, ruleReq OutboundNATRule) (*OutboundNATRule, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	rules, err := pf.getOutboundNATRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := rules.GetControlIDByDescription(ruleReq.Description)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	rule, err := pf.createOrUpdateOutboundNATRule(ctx, ruleReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w outbound NAT rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return rule, nil
+}
+
+func (pf *Client) DeleteOutboundNATRule(ctx context.Context, description string) error {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	rules, err := pf.getOutboundNATRules(ctx)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT rule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := rules.GetControlIDByDescription(description)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT rule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat_out.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w outbound NAT rule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) getOutboundNATMode(ctx context.Context) (string, error) {
+	b, err := pf.getConfigJSON(ctx, "['nat']['outbound']['mode']")
+	if err != nil {
+		return "", err
+	}
+
+	var value *string
+	err = json.Unmarshal(b, &value)
+	if err != nil {
+		return "", fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	// outbound NAT defaults to automatic mode when unset
+	if value == nil || *value == "" {
+		return "automatic", nil
+	}
+
+	for mode, v := range outboundNATModeValues {
+		if v == *value {
+			return mode, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w, unknown outbound NAT mode '%s'", ErrUnableToParse, *value)
+}
+
+func (pf *Client) GetOutboundNATMode(ctx context.Context) (string, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	mode, err := pf.getOutboundNATMode(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w outbound NAT mode, %w", ErrGetOperationFailed, err)
+	}
+
+	return mode, nil
+}
+
+func (pf *Client) UpdateOutboundNATMode(ctx context.Context, mode string) (string, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	value, ok := outboundNATModeValues[mode]
+	if !ok {
+		return "", fmt.Errorf("%w outbound NAT mode, %w, mode must be one of %v", ErrUpdateOperationFailed, ErrClientValidation, OutboundNATModes())
+	}
+
+	u := url.URL{Path: "firewall_nat_out.php"}
+	v := url.Values{
+		"mode": {value},
+		"save": {"Save"},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return "", fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return "", fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	mode, err = pf.getOutboundNATMode(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w outbound NAT mode, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return mode, nil
+}
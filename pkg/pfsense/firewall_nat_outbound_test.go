@@ -0,0 +1,54 @@
+package pfsense
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseOutboundNATRulesResponseUnmanagedRules(t *testing.T) {
+	b := []byte(`[
+		{"interface": "wan", "protocol": "esp", "source": {"network": "lan"}, "destination": {"any": ""},
+		 "target": "", "descr": "IPsec"},
+		{"interface": "wan", "protocol": "tcp", "source": {"network": "10.0.0.0/24"}, "destination": {"any": ""},
+		 "target": "", "natport": "", "staticnatport": "", "descr": "static port"},
+		{"interface": "wan", "source": {"network": "10.0.1.0/24"}, "destination": {"any": ""},
+		 "target": "", "descr": ""},
+		{"interface": "wan", "protocol": "gre", "source": {"network": "lan"}, "destination": {"any": ""},
+		 "target": "", "disabled": "", "descr": "GRE"}
+	]`)
+
+	rules, err := parseOutboundNATRulesResponse(b)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(*rules) != 4 {
+		t.Fatalf("expected 4 outbound NAT rules, got %d", len(*rules))
+	}
+
+	rule, err := rules.GetByDescription("static port")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if rule.Protocol != "tcp" || rule.Source != "10.0.0.0/24" || !rule.StaticPort {
+		t.Errorf("unexpected outbound NAT rule %+v", rule)
+	}
+
+	controlID, err := rules.GetControlIDByDescription("static port")
+	if err != nil || *controlID != 1 {
+		t.Errorf("expected control ID 1, got %v (%v)", controlID, err)
+	}
+
+	if _, err := rules.GetByDescription("IPsec"); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected parse error only when requested, got %v", err)
+	}
+
+	if _, err := rules.GetByDescription("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if !rules.hasDescription("GRE") {
+		t.Error("expected unmanaged outbound NAT rule description to be reserved")
+	}
+}
@@ -0,0 +1,490 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	portForwardFilterRuleNone       = "none"
+	portForwardFilterRulePass       = "pass"
+	portForwardFilterRuleAssociated = "associated"
+)
+
+func PortForwardProtocols() []string {
+	return []string{"tcp", "udp", "tcp/udp"}
+}
+
+func PortForwardNATReflections() []string {
+	return []string{"default", "enable", "purenat", "disable"}
+}
+
+func PortForwardFilterRules() []string {
+	return []string{portForwardFilterRuleNone, portForwardFilterRulePass, portForwardFilterRuleAssociated}
+}
+
+type portForwardResponse struct {
+	Interface        string                       `json:"interface"`
+	IPProtocol       string                       `json:"ipprotocol"`
+	Protocol         string                       `json:"protocol"`
+	Source           firewallRuleEndpointResponse `json:"source"`
+	Destination      firewallRuleEndpointResponse `json:"destination"`
+	Target           string                       `json:"target"`
+	TargetPort       string                       `json:"local-port"`
+	NATReflection    string                       `json:"natreflection"`
+	AssociatedRuleID string                       `json:"associated-rule-id"`
+	Disabled         *string                      `json:"disabled"`
+	Description      string                       `json:"descr"`
+}
+
+type PortForward struct {
+	Interface        string
+	IPProtocol       string
+	Protocol         string
+	Source           FirewallRuleEndpoint
+	Destination      FirewallRuleEndpoint
+	Target           string
+	TargetPort       string
+	NATReflection    string
+	FilterRule       string
+	Disabled         bool
+	Description      string
+	associatedRuleID string
+	// parseErr is set for port forwards that do not pass validation (e.g. port forwards not managed by Terraform using
+	// other protocols), they are kept to preserve control IDs but cannot be returned.
+	parseErr error
+}
+
+func (pfwd *PortForward) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, port forward interface required", ErrClientValidation)
+	}
+
+	pfwd.Interface = iface
+
+	return nil
+}
+
+func (pfwd *PortForward) SetIPProtocol(ipProtocol string) error {
+	if !slices.Contains([]string{"inet", "inet6"}, ipProtocol) {
+		return fmt.Errorf("%w, port forward IP protocol must be one of [inet inet6]", ErrClientValidation)
+	}
+
+	pfwd.IPProtocol = ipProtocol
+
+	return nil
+}
+
+func (pfwd *PortForward) SetProtocol(protocol string) error {
+	if !slices.Contains(PortForwardProtocols(), protocol) {
+		return fmt.Errorf("%w, port forward protocol must be one of %v", ErrClientValidation, PortForwardProtocols())
+	}
+
+	pfwd.Protocol = protocol
+
+	return nil
+}
+
+func (pfwd *PortForward) SetTarget(target string) error {
+	if target == "" {
+		return fmt.Errorf("%w, port forward target required", ErrClientValidation)
+	}
+
+	pfwd.Target = target
+
+	return nil
+}
+
+func (pfwd *PortForward) SetTargetPort(port string) error {
+	var endpoint FirewallRuleEndpoint
+	err := endpoint.SetPort(port)
+	if err != nil {
+		return err
+	}
+
+	if strings.Contains(port, "-") {
+		return fmt.Errorf("%w, port forward target port must be a single port or alias", ErrClientValidation)
+	}
+
+	pfwd.TargetPort = port
+
+	return nil
+}
+
+func (pfwd *PortForward) SetNATReflection(natReflection string) error {
+	if !slices.Contains(PortForwardNATReflections(), natReflection) {
+		return fmt.Errorf("%w, port forward NAT reflection must be one of %v", ErrClientValidation, PortForwardNATReflections())
+	}
+
+	pfwd.NATReflection = natReflection
+
+	return nil
+}
+
+func (pfwd *PortForward) SetFilterRule(filterRule string) error {
+	if !slices.Contains(PortForwardFilterRules(), filterRule) {
+		return fmt.Errorf("%w, port forward filter rule association must be one of %v", ErrClientValidation, PortForwardFilterRules())
+	}
+
+	pfwd.FilterRule = filterRule
+
+	return nil
+}
+
+func (pfwd *PortForward) SetDisabled(disabled bool) error {
+	pfwd.Disabled = disabled
+
+	return nil
+}
+
+func (pfwd *PortForward) SetDescription(description string) error {
+	if description == "" {
+		return fmt.Errorf("%w, port forward description required", ErrClientValidation)
+	}
+
+	pfwd.Description = description
+
+	return nil
+}
+
+func (pfwd PortForward) formatFilterRule() string {
+	switch pfwd.FilterRule {
+	case portForwardFilterRulePass:
+		return "pass"
+	case portForwardFilterRuleAssociated:
+		if pfwd.associatedRuleID != "" {
+			return pfwd.associatedRuleID
+		}
+
+		return "add-associated"
+	default:
+		return ""
+	}
+}
+
+type PortForwards []PortForward
+
+func (pfwds PortForwards) GetByDescription(description string) (*PortForward, error) {
+	for _, pfwd := range pfwds {
+		if pfwd.Description == description {
+			if pfwd.parseErr != nil {
+				return nil, fmt.Errorf("%w port forward response (description '%s'), %w", ErrUnableToParse, description, pfwd.parseErr)
+			}
+
+			return &pfwd, nil
+		}
+	}
+	return nil, fmt.Errorf("port forward %w with description '%s'", ErrNotFound, description)
+}
+
+func (pfwds PortForwards) GetByControlID(controlID int) (*PortForward, error) {
+	if controlID < 0 || controlID >= len(pfwds) {
+		return nil, fmt.Errorf("port forward %w with control ID %d", ErrNotFound, controlID)
+	}
+
+	pfwd := pfwds[controlID]
+	if pfwd.parseErr != nil {
+		return nil, fmt.Errorf("%w port forward response (control ID %d), %w", ErrUnableToParse, controlID, pfwd.parseErr)
+	}
+
+	return &pfwd, nil
+}
+
+func (pfwds PortForwards) hasDescription(description string) bool {
+	return slices.ContainsFunc(pfwds, func(pfwd PortForward) bool { return pfwd.Description == description })
+}
+
+func (pfwds PortForwards) GetControlIDByDescription(description string) (*int, error) {
+	return getControlID(pfwds, func(pfwd PortForward) bool { return pfwd.Description == description },
+		positionalControlID, "port forward", fmt.Sprintf("description '%s'", description))
+}
+
+func parsePortForwardResponse(resp portForwardResponse) (*PortForward, error) {
+	var pfwd PortForward
+	var err error
+
+	err = pfwd.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	ipProtocol := resp.IPProtocol
+	if ipProtocol == "" {
+		ipProtocol = "inet"
+	}
+
+	err = pfwd.SetIPProtocol(ipProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pfwd.SetProtocol(resp.Protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := parseFirewallRuleEndpointResponse(resp.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	pfwd.Source = *source
+
+	destination, err := parseFirewallRuleEndpointResponse(resp.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	pfwd.Destination = *destination
+
+	err = pfwd.SetTarget(resp.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pfwd.SetTargetPort(resp.TargetPort)
+	if err != nil {
+		return nil, err
+	}
+
+	natReflection := resp.NATReflection
+	if natReflection == "" {
+		natReflection = "default"
+	}
+
+	err = pfwd.SetNATReflection(natReflection)
+	if err != nil {
+		return nil, err
+	}
+
+	filterRule := portForwardFilterRuleNone
+	switch resp.AssociatedRuleID {
+	case "":
+	case "pass":
+		filterRule = portForwardFilterRulePass
+	default:
+		filterRule = portForwardFilterRuleAssociated
+		pfwd.associatedRuleID = resp.AssociatedRuleID
+	}
+
+	err = pfwd.SetFilterRule(filterRule)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pfwd.SetDisabled(resp.Disabled != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pfwd.SetDescription(resp.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pfwd, nil
+}
+
+func parsePortForwardsResponse(b []byte) (*PortForwards, error) {
+	var pfwdResp []portForwardResponse
+	err := json.Unmarshal(b, &pfwdResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var pfwds PortForwards
+	for _, resp := range pfwdResp {
+		pfwd, err := parsePortForwardResponse(resp)
+		if err != nil {
+			// keep the fields needed to identify the port forward as-is
+			pfwds = append(pfwds, PortForward{
+				Interface:   resp.Interface,
+				Protocol:    resp.Protocol,
+				Target:      resp.Target,
+				Description: resp.Description,
+				parseErr:    err,
+			})
+
+			continue
+		}
+
+		pfwds = append(pfwds, *pfwd)
+	}
+
+	return &pfwds, nil
+}
+
+func (pf *Client) getPortForwards(ctx context.Context) (*PortForwards, error) {
+	b, err := pf.getConfigJSON(ctx, "['nat']['rule']")
+	if err != nil {
+		return nil, err
+	}
+
+	return parsePortForwardsResponse(b)
+}
+
+func (pf *Client) GetPortForwards(ctx context.Context) (*PortForwards, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forwards, %w", ErrGetOperationFailed, err)
+	}
+
+	return pfwds, nil
+}
+
+func (pf *Client) GetPortForward(ctx context.Context, description string) (*PortForward, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward (description '%s'), %w", ErrGetOperationFailed, description, err)
+	}
+
+	return pfwds.GetByDescription(description)
+}
+
+// GetPortForwardByControlID returns the port forward at the given position, port forwards have no tracker ID.
+func (pf *Client) GetPortForwardByControlID(ctx context.Context, controlID int) (*PortForward, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward (control ID %d), %w", ErrGetOperationFailed, controlID, err)
+	}
+
+	return pfwds.GetByControlID(controlID)
+}
+
+func (pf *Client) createOrUpdatePortForward(ctx context.Context, pfwdReq PortForward, controlID *int) (*PortForward, error) {
+	u := url.URL{Path: "firewall_nat_edit.php"}
+	v := url.Values{
+		"interface":           {pfwdReq.Interface},
+		"ipprotocol":          {pfwdReq.IPProtocol},
+		"proto":               {pfwdReq.Protocol},
+		"localip":             {pfwdReq.Target},
+		"localbeginport":      {""},
+		"localbeginport_cust": {pfwdReq.TargetPort},
+		"natreflection":       {pfwdReq.NATReflection},
+		"associated-rule-id":  {pfwdReq.formatFilterRule()},
+		"descr":               {pfwdReq.Description},
+		"save":                {"Save"},
+	}
+
+	pfwdReq.Source.formValues("src", &v)
+	pfwdReq.Destination.formValues("dst", &v)
+
+	if pfwdReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pfwd, err := pfwds.GetByDescription(pfwdReq.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return pfwd, nil
+}
+
+func (pf *Client) CreatePortForward(ctx context.Context, pfwdReq PortForward) (*PortForward, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward, %w", ErrCreateOperationFailed, err)
+	}
+
+	// the description identifies the port forward, it must be unique
+	if pfwds.hasDescription(pfwdReq.Description) {
+		return nil, fmt.Errorf("%w port forward, %w, description '%s' already exists", ErrCreateOperationFailed, ErrClientValidation, pfwdReq.Description)
+	}
+
+	pfwd, err := pf.createOrUpdatePortForward(ctx, pfwdReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward, %w", ErrCreateOperationFailed, err)
+	}
+
+	return pfwd, nil
+}
+
+func (pf *Client) UpdatePortForward(ctx context.Context, pfwdReq PortForward) (*PortForward, error) {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := pfwds.GetControlIDByDescription(pfwdReq.Description)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward, %w", ErrUpdateOperationFailed, err)
+	}
+
+	pfwdReq.associatedRuleID = (*pfwds)[*controlID].associatedRuleID
+
+	pfwd, err := pf.createOrUpdatePortForward(ctx, pfwdReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w port forward, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return pfwd, nil
+}
+
+func (pf *Client) DeletePortForward(ctx context.Context, description string) error {
+	pf.mutexes.FirewallNAT.Lock()
+	defer pf.mutexes.FirewallNAT.Unlock()
+
+	pfwds, err := pf.getPortForwards(ctx)
+	if err != nil {
+		return fmt.Errorf("%w port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := pfwds.GetControlIDByDescription(description)
+	if err != nil {
+		return fmt.Errorf("%w port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_nat.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w port forward, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package pfsense
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePortForwardsResponseUnmanagedPortForwards(t *testing.T) {
+	b := []byte(`[
+		{"interface": "wan", "protocol": "esp", "source": {"any": ""}, "destination": {"network": "wanip"},
+		 "target": "10.0.0.5", "descr": "IPsec passthrough"},
+		{"interface": "wan", "protocol": "tcp", "source": {"any": ""}, "destination": {"network": "wanip", "port": "443"},
+		 "target": "10.0.0.10", "local-port": "8443", "associated-rule-id": "nat_1", "descr": "web server"},
+		{"interface": "wan", "protocol": "tcp/udp", "source": {"any": ""}, "destination": {"network": "wanip", "port": "53"},
+		 "target": "10.0.0.53", "local-port": "53", "descr": ""},
+		{"interface": "wan", "protocol": "gre", "source": {"any": ""}, "destination": {"network": "wanip"},
+		 "target": "10.0.0.6", "descr": "GRE"}
+	]`)
+
+	pfwds, err := parsePortForwardsResponse(b)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if len(*pfwds) != 4 {
+		t.Fatalf("expected 4 port forwards, got %d", len(*pfwds))
+	}
+
+	pfwd, err := pfwds.GetByDescription("web server")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if pfwd.Target != "10.0.0.10" || pfwd.TargetPort != "8443" || pfwd.FilterRule != portForwardFilterRuleAssociated {
+		t.Errorf("unexpected port forward %+v", pfwd)
+	}
+
+	controlID, err := pfwds.GetControlIDByDescription("web server")
+	if err != nil || *controlID != 1 {
+		t.Errorf("expected control ID 1, got %v (%v)", controlID, err)
+	}
+
+	pfwd, err = pfwds.GetByControlID(1)
+	if err != nil || pfwd.Description != "web server" {
+		t.Errorf("expected port forward 'web server' at control ID 1, got %v (%v)", pfwd, err)
+	}
+
+	if _, err := pfwds.GetByDescription("IPsec passthrough"); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected parse error only when requested, got %v", err)
+	}
+
+	if _, err := pfwds.GetByControlID(3); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected parse error only when requested, got %v", err)
+	}
+
+	if _, err := pfwds.GetByControlID(4); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	if !pfwds.hasDescription("GRE") {
+		t.Error("expected unmanaged port forward description to be reserved")
+	}
+}
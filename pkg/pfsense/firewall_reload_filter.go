@@ -2,17 +2,53 @@ package pfsense
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
 var (
 	ErrReloadFirewallFilter = errors.New("failed to reload firewall filter")
 )
 
+type firewallFilterReloadStatusResponse struct {
+	RuleCount int      `json:"rule_count"`
+	Errors    []string `json:"errors"`
+}
+
+type FirewallFilterReloadStatus struct {
+	Timestamp time.Time
+	RuleCount int
+	Errors    []string
+}
+
+func parseFirewallFilterReloadStatusResponse(b []byte) (*FirewallFilterReloadStatus, error) {
+	var statusResp firewallFilterReloadStatusResponse
+	err := json.Unmarshal(b, &statusResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall filter reload status response, %w", ErrUnableToParse, err)
+	}
+
+	status := FirewallFilterReloadStatus{
+		Timestamp: time.Now(),
+		RuleCount: statusResp.RuleCount,
+		Errors:    []string{},
+	}
+
+	for _, line := range statusResp.Errors {
+		if line = strings.TrimSpace(line); line != "" {
+			status.Errors = append(status.Errors, line)
+		}
+	}
+
+	return &status, nil
+}
+
 func (pf *Client) ReloadFirewallFilter(ctx context.Context) error {
 	u := url.URL{Path: "status_filter_reload.php"}
 	v := url.Values{
@@ -21,7 +57,7 @@ func (pf *Client) ReloadFirewallFilter(ctx context.Context) error {
 
 	resp, err := pf.call(ctx, http.MethodPost, u, &v)
 	if err != nil {
-		return fmt.Errorf("%w, %w", ErrApplyDNSResolverChange, err)
+		return fmt.Errorf("%w, %w", ErrReloadFirewallFilter, err)
 	}
 
 	defer resp.Body.Close()
@@ -29,3 +65,28 @@ func (pf *Client) ReloadFirewallFilter(ctx context.Context) error {
 
 	return nil
 }
+
+// ReloadFirewallFilterWithStatus reloads the firewall filter, then reports the number of loaded rules and any errors
+// pf finds in the generated ruleset. The reload is asynchronous, the rule count reflects the ruleset loaded when read.
+func (pf *Client) ReloadFirewallFilterWithStatus(ctx context.Context) (*FirewallFilterReloadStatus, error) {
+	err := pf.ReloadFirewallFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	command := "exec('/sbin/pfctl -sr 2>/dev/null', $rules);" +
+		"exec('/sbin/pfctl -nf /tmp/rules.debug 2>&1', $errors);" +
+		"print_r(json_encode(array('rule_count' => count($rules), 'errors' => $errors)));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrReloadFirewallFilter, err)
+	}
+
+	status, err := parseFirewallFilterReloadStatusResponse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrReloadFirewallFilter, err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,61 @@
+package pfsense
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParseFirewallFilterReloadStatusResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		resp      string
+		ruleCount int
+		errors    []string
+	}{
+		{
+			name:      "loaded",
+			resp:      `{"rule_count": 87, "errors": []}`,
+			ruleCount: 87,
+			errors:    []string{},
+		},
+		{
+			name: "config error",
+			resp: `{"rule_count": 0, "errors": ["/tmp/rules.debug:42: syntax error", "  ", "pfctl: Syntax error in config file: pf rules not loaded "]}`,
+			errors: []string{
+				"/tmp/rules.debug:42: syntax error",
+				"pfctl: Syntax error in config file: pf rules not loaded",
+			},
+		},
+		{
+			name:      "no errors reported",
+			resp:      `{"rule_count": 12}`,
+			ruleCount: 12,
+			errors:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		status, err := parseFirewallFilterReloadStatusResponse([]byte(tt.resp))
+		if err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+			continue
+		}
+
+		if status.RuleCount != tt.ruleCount {
+			t.Errorf("%s: rule count = %d, want %d", tt.name, status.RuleCount, tt.ruleCount)
+		}
+
+		if !slices.Equal(status.Errors, tt.errors) {
+			t.Errorf("%s: errors = %q, want %q", tt.name, status.Errors, tt.errors)
+		}
+
+		if status.Timestamp.IsZero() {
+			t.Errorf("%s: expected timestamp to be set", tt.name)
+		}
+	}
+
+	if _, err := parseFirewallFilterReloadStatusResponse([]byte(`{"rule_count": 87, "err`)); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+}
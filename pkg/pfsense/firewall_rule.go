@@ -0,0 +1,606 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+func FirewallRuleActions() []string {
+	return []string{"pass", "block", "reject"}
+}
+
+func FirewallRuleIPProtocols() []string {
+	return []string{"inet", "inet6", "inet46"}
+}
+
+func FirewallRuleProtocols() []string {
+	return []string{"any", "tcp", "udp", "tcp/udp", "icmp"}
+}
+
+func FirewallRuleDirections() []string {
+	return []string{"any", "in", "out"}
+}
+
+type firewallRuleEndpointResponse struct {
+	Any     *string `json:"any"`
+	Address string  `json:"address"`
+	Network string  `json:"network"`
+	Port    string  `json:"port"`
+	Not     *string `json:"not"`
+}
+
+type firewallRuleResponse struct {
+	Tracker     string                       `json:"tracker"`
+	Type        string                       `json:"type"`
+	Interface   string                       `json:"interface"`
+	IPProtocol  string                       `json:"ipprotocol"`
+	Protocol    string                       `json:"protocol"`
+	Source      firewallRuleEndpointResponse `json:"source"`
+	Destination firewallRuleEndpointResponse `json:"destination"`
+	Floating    string                       `json:"floating"`
+	Direction   string                       `json:"direction"`
+	Log         *string                      `json:"log"`
+	Disabled    *string                      `json:"disabled"`
+	Description string                       `json:"descr"`
+}
+
+// FirewallRuleEndpoint is the source or destination of a rule. Address (IP, CIDR, or alias) and network (interface
+// macro, e.g. 'lan', 'lanip', or '(self)') are mutually exclusive, neither matches any.
+type FirewallRuleEndpoint struct {
+	Address string
+	Network string
+	Port    string
+	Invert  bool
+}
+
+type FirewallRule struct {
+	Tracker     string
+	Interface   string
+	Action      string
+	IPProtocol  string
+	Protocol    string
+	Source      FirewallRuleEndpoint
+	Destination FirewallRuleEndpoint
+	Direction   string
+	Log         bool
+	Disabled    bool
+	Description string
+	// parseErr is set for rules that do not pass validation (e.g. rules not managed by Terraform using other actions
+	// or protocols), they are kept to preserve control IDs but cannot be returned.
+	parseErr error
+}
+
+func (rule *FirewallRule) SetTracker(tracker string) error {
+	var isValidTracker = regexp.MustCompile(`^[0-9]+$`).MatchString
+	if !isValidTracker(tracker) {
+		return fmt.Errorf("%w, rule tracker ID must be numeric", ErrClientValidation)
+	}
+
+	rule.Tracker = tracker
+
+	return nil
+}
+
+func (rule *FirewallRule) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, rule interface required", ErrClientValidation)
+	}
+
+	rule.Interface = iface
+
+	return nil
+}
+
+func (rule *FirewallRule) SetAction(action string) error {
+	if !slices.Contains(FirewallRuleActions(), action) {
+		return fmt.Errorf("%w, rule action must be one of %v", ErrClientValidation, FirewallRuleActions())
+	}
+
+	rule.Action = action
+
+	return nil
+}
+
+func (rule *FirewallRule) SetIPProtocol(ipProtocol string) error {
+	if !slices.Contains(FirewallRuleIPProtocols(), ipProtocol) {
+		return fmt.Errorf("%w, rule IP protocol must be one of %v", ErrClientValidation, FirewallRuleIPProtocols())
+	}
+
+	rule.IPProtocol = ipProtocol
+
+	return nil
+}
+
+func (rule *FirewallRule) SetProtocol(protocol string) error {
+	if !slices.Contains(FirewallRuleProtocols(), protocol) {
+		return fmt.Errorf("%w, rule protocol must be one of %v", ErrClientValidation, FirewallRuleProtocols())
+	}
+
+	rule.Protocol = protocol
+
+	return nil
+}
+
+func (rule *FirewallRule) SetDirection(direction string) error {
+	if direction != "" && !slices.Contains(FirewallRuleDirections(), direction) {
+		return fmt.Errorf("%w, rule direction must be one of %v", ErrClientValidation, FirewallRuleDirections())
+	}
+
+	rule.Direction = direction
+
+	return nil
+}
+
+func (rule *FirewallRule) SetLog(log bool) error {
+	rule.Log = log
+
+	return nil
+}
+
+func (rule *FirewallRule) SetDisabled(disabled bool) error {
+	rule.Disabled = disabled
+
+	return nil
+}
+
+func (rule *FirewallRule) SetDescription(description string) error {
+	rule.Description = description
+
+	return nil
+}
+
+func (endpoint *FirewallRuleEndpoint) SetAddress(address string) error {
+	if address != "" && endpoint.Network != "" {
+		return fmt.Errorf("%w, address and network are mutually exclusive", ErrClientValidation)
+	}
+
+	endpoint.Address = address
+
+	return nil
+}
+
+func (endpoint *FirewallRuleEndpoint) SetNetwork(network string) error {
+	if network != "" && endpoint.Address != "" {
+		return fmt.Errorf("%w, address and network are mutually exclusive", ErrClientValidation)
+	}
+
+	endpoint.Network = network
+
+	return nil
+}
+
+// SetPort accepts a port, a port range (e.g. '1000-2000'), or a port alias.
+func (endpoint *FirewallRuleEndpoint) SetPort(port string) error {
+	if port != "" {
+		var isValidPortAlias = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+		begin, end, isRange := strings.Cut(port, "-")
+		if isRange {
+			err := validatePortString(begin)
+			if err != nil {
+				return err
+			}
+
+			err = validatePortString(end)
+			if err != nil {
+				return err
+			}
+		} else if _, err := strconv.Atoi(port); err == nil {
+			err = validatePortString(port)
+			if err != nil {
+				return err
+			}
+		} else if !isValidPortAlias(port) {
+			return fmt.Errorf("%w, port must be a port, port range, or alias", ErrClientValidation)
+		}
+	}
+
+	endpoint.Port = port
+
+	return nil
+}
+
+func (endpoint *FirewallRuleEndpoint) SetInvert(invert bool) error {
+	endpoint.Invert = invert
+
+	return nil
+}
+
+func validatePortString(s string) error {
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("%w, port must be numeric", ErrClientValidation)
+	}
+
+	return validatePort(port)
+}
+
+func (endpoint FirewallRuleEndpoint) formValues(prefix string, v *url.Values) {
+	switch {
+	case endpoint.Network != "":
+		v.Set(fmt.Sprintf("%stype", prefix), endpoint.Network)
+	case endpoint.Address == "":
+		v.Set(fmt.Sprintf("%stype", prefix), "any")
+	default:
+		if network, err := netip.ParsePrefix(endpoint.Address); err == nil {
+			v.Set(fmt.Sprintf("%stype", prefix), "network")
+			v.Set(prefix, network.Addr().String())
+			v.Set(fmt.Sprintf("%smask", prefix), strconv.Itoa(network.Bits()))
+		} else {
+			v.Set(fmt.Sprintf("%stype", prefix), "single")
+			v.Set(prefix, endpoint.Address)
+		}
+	}
+
+	if endpoint.Invert {
+		v.Set(fmt.Sprintf("%snot", prefix), "yes")
+	}
+
+	if endpoint.Port != "" {
+		begin, end, isRange := strings.Cut(endpoint.Port, "-")
+		if !isRange {
+			end = begin
+		}
+
+		v.Set(fmt.Sprintf("%sbeginport", prefix), "")
+		v.Set(fmt.Sprintf("%sbeginport_cust", prefix), begin)
+		v.Set(fmt.Sprintf("%sendport", prefix), "")
+		v.Set(fmt.Sprintf("%sendport_cust", prefix), end)
+	}
+}
+
+type FirewallRules []FirewallRule
+
+func (rules FirewallRules) GetByTracker(tracker string) (*FirewallRule, error) {
+	for _, rule := range rules {
+		if rule.Tracker == tracker {
+			if rule.parseErr != nil {
+				return nil, fmt.Errorf("%w firewall rule response (tracker ID '%s'), %w", ErrUnableToParse, tracker, rule.parseErr)
+			}
+
+			return &rule, nil
+		}
+	}
+	return nil, fmt.Errorf("firewall rule %w with tracker ID '%s'", ErrNotFound, tracker)
+}
+
+func (rules FirewallRules) GetControlIDByTracker(tracker string) (*int, error) {
+	return getControlID(rules, func(rule FirewallRule) bool { return rule.Tracker == tracker },
+		positionalControlID, "firewall rule", fmt.Sprintf("tracker ID '%s'", tracker))
+}
+
+func parseFirewallRuleEndpointResponse(resp firewallRuleEndpointResponse) (*FirewallRuleEndpoint, error) {
+	var endpoint FirewallRuleEndpoint
+	var err error
+
+	err = endpoint.SetAddress(resp.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.SetNetwork(resp.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.SetPort(resp.Port)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.SetInvert(resp.Not != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpoint, nil
+}
+
+func parseFirewallRuleResponse(resp firewallRuleResponse) (*FirewallRule, error) {
+	var rule FirewallRule
+	var err error
+
+	err = rule.SetTracker(resp.Tracker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetAction(resp.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetIPProtocol(resp.IPProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	protocol := resp.Protocol
+	if protocol == "" {
+		protocol = "any"
+	}
+
+	err = rule.SetProtocol(protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := parseFirewallRuleEndpointResponse(resp.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Source = *source
+
+	destination, err := parseFirewallRuleEndpointResponse(resp.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Destination = *destination
+
+	if resp.Floating == "yes" {
+		direction := resp.Direction
+		if direction == "" {
+			direction = "any"
+		}
+
+		err = rule.SetDirection(direction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = rule.SetLog(resp.Log != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDisabled(resp.Disabled != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = rule.SetDescription(resp.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// parseUnmanagedFirewallRuleResponse keeps the fields of a rule that failed validation as-is.
+func parseUnmanagedFirewallRuleResponse(resp firewallRuleResponse, parseErr error) FirewallRule {
+	rule := FirewallRule{
+		Tracker:     resp.Tracker,
+		Interface:   resp.Interface,
+		Action:      resp.Type,
+		IPProtocol:  resp.IPProtocol,
+		Protocol:    resp.Protocol,
+		Log:         resp.Log != nil,
+		Disabled:    resp.Disabled != nil,
+		Description: resp.Description,
+		parseErr:    parseErr,
+	}
+
+	if resp.Floating == "yes" {
+		rule.Direction = resp.Direction
+		if rule.Direction == "" {
+			rule.Direction = "any"
+		}
+	}
+
+	return rule
+}
+
+func parseFirewallRulesResponse(b []byte) (*FirewallRules, error) {
+	var ruleResp []firewallRuleResponse
+	err := json.Unmarshal(b, &ruleResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var rules FirewallRules
+	for _, resp := range ruleResp {
+		rule, err := parseFirewallRuleResponse(resp)
+		if err != nil {
+			rules = append(rules, parseUnmanagedFirewallRuleResponse(resp, err))
+			continue
+		}
+
+		rules = append(rules, *rule)
+	}
+
+	return &rules, nil
+}
+
+func (pf *Client) getFirewallRules(ctx context.Context) (*FirewallRules, error) {
+	b, err := pf.getConfigJSON(ctx, "['filter']['rule']")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFirewallRulesResponse(b)
+}
+
+func (pf *Client) GetFirewallRules(ctx context.Context) (*FirewallRules, error) {
+	pf.mutexes.FirewallRule.Lock()
+	defer pf.mutexes.FirewallRule.Unlock()
+
+	rules, err := pf.getFirewallRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rules, %w", ErrGetOperationFailed, err)
+	}
+
+	return rules, nil
+}
+
+func (pf *Client) GetFirewallRule(ctx context.Context, tracker string) (*FirewallRule, error) {
+	pf.mutexes.FirewallRule.Lock()
+	defer pf.mutexes.FirewallRule.Unlock()
+
+	rules, err := pf.getFirewallRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule (tracker ID '%s'), %w", ErrGetOperationFailed, tracker, err)
+	}
+
+	return rules.GetByTracker(tracker)
+}
+
+func (pf *Client) createOrUpdateFirewallRule(ctx context.Context, ruleReq FirewallRule, controlID *int) error {
+	u := url.URL{Path: "firewall_rules_edit.php"}
+	v := url.Values{
+		"type":       {ruleReq.Action},
+		"interface":  {ruleReq.Interface},
+		"ipprotocol": {ruleReq.IPProtocol},
+		"proto":      {ruleReq.Protocol},
+		"descr":      {ruleReq.Description},
+		"save":       {"Save"},
+	}
+
+	if ruleReq.Protocol == "icmp" {
+		v.Set("icmptype[]", "any")
+	}
+
+	ruleReq.Source.formValues("src", &v)
+	ruleReq.Destination.formValues("dst", &v)
+
+	if ruleReq.Direction != "" {
+		v.Set("floating", "yes")
+		v.Set("direction", ruleReq.Direction)
+		v.Del("interface")
+		v.Set("interface[]", ruleReq.Interface)
+	}
+
+	if ruleReq.Log {
+		v.Set("log", "yes")
+	}
+
+	if ruleReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) CreateFirewallRule(ctx context.Context, ruleReq FirewallRule) (*FirewallRule, error) {
+	pf.mutexes.FirewallRule.Lock()
+	defer pf.mutexes.FirewallRule.Unlock()
+
+	prevRules, err := pf.getFirewallRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrCreateOperationFailed, err)
+	}
+
+	err = pf.createOrUpdateFirewallRule(ctx, ruleReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrCreateOperationFailed, err)
+	}
+
+	rules, err := pf.getFirewallRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrCreateOperationFailed, err)
+	}
+
+	// the tracker ID is assigned by pfSense, the new rule is the one not present before the create
+	for _, rule := range *rules {
+		if !slices.ContainsFunc(*prevRules, func(prevRule FirewallRule) bool { return prevRule.Tracker == rule.Tracker }) {
+			created, err := rules.GetByTracker(rule.Tracker)
+			if err != nil {
+				return nil, fmt.Errorf("%w firewall rule, %w", ErrCreateOperationFailed, err)
+			}
+
+			return created, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w firewall rule, created rule %w", ErrCreateOperationFailed, ErrNotFound)
+}
+
+func (pf *Client) UpdateFirewallRule(ctx context.Context, ruleReq FirewallRule) (*FirewallRule, error) {
+	pf.mutexes.FirewallRule.Lock()
+	defer pf.mutexes.FirewallRule.Unlock()
+
+	rules, err := pf.getFirewallRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := rules.GetControlIDByTracker(ruleReq.Tracker)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = pf.createOrUpdateFirewallRule(ctx, ruleReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	rules, err = pf.getFirewallRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	rule, err := rules.GetByTracker(ruleReq.Tracker)
+	if err != nil {
+		return nil, fmt.Errorf("%w firewall rule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return rule, nil
+}
+
+func (pf *Client) DeleteFirewallRule(ctx context.Context, tracker string) error {
+	pf.mutexes.FirewallRule.Lock()
+	defer pf.mutexes.FirewallRule.Unlock()
+
+	rules, err := pf.getFirewallRules(ctx)
+	if err != nil {
+		return fmt.Errorf("%w firewall rule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := rules.GetControlIDByTracker(tracker)
+	if err != nil {
+		return fmt.Errorf("%w firewall rule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	rule := (*rules)[*controlID]
+	iface := rule.Interface
+	if rule.Direction != "" {
+		iface = "FloatingRules"
+	}
+
+	u := url.URL{Path: "firewall_rules.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+		"if":  {iface},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w firewall rule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
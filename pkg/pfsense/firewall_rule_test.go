@@ -0,0 +1,57 @@
+package pfsense
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseFirewallRulesResponseUnmanagedRules(t *testing.T) {
+	b := []byte(`[
+		{"tracker": "100", "type": "pass", "interface": "lan", "ipprotocol": "inet", "protocol": "tcp",
+		 "source": {"network": "lan"}, "destination": {"address": "10.0.0.10", "port": "443"}, "descr": "managed"},
+		{"tracker": "101", "type": "match", "interface": "wan", "ipprotocol": "inet", "floating": "yes",
+		 "source": {"any": ""}, "destination": {"any": ""}, "descr": "floating match"},
+		{"tracker": "102", "type": "pass", "interface": "wan", "ipprotocol": "inet", "protocol": "esp",
+		 "source": {"any": ""}, "destination": {"any": ""}, "descr": "IPsec"},
+		{"tracker": "103", "type": "pass", "interface": "opt1", "ipprotocol": "inet", "protocol": "carp",
+		 "source": {"any": ""}, "destination": {"any": ""}, "descr": "CARP"},
+		{"tracker": "", "type": "pass", "interface": "lan", "ipprotocol": "inet",
+		 "source": {"any": ""}, "destination": {"any": ""}, "descr": "legacy rule without tracker"},
+		{"tracker": "104", "type": "block", "interface": "lan", "ipprotocol": "inet46", "protocol": "udp",
+		 "source": {"any": ""}, "destination": {"network": "lanip", "port": "53"}, "descr": "managed after unmanaged"}
+	]`)
+
+	rules, err := parseFirewallRulesResponse(b)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	// unmanaged rules are kept so control IDs stay positional
+	if len(*rules) != 6 {
+		t.Fatalf("expected 6 rules, got %d", len(*rules))
+	}
+
+	rule, err := rules.GetByTracker("104")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if rule.Action != "block" || rule.Destination.Network != "lanip" || rule.Destination.Port != "53" {
+		t.Errorf("unexpected rule %+v", rule)
+	}
+
+	controlID, err := rules.GetControlIDByTracker("104")
+	if err != nil || *controlID != 5 {
+		t.Errorf("expected control ID 5, got %v (%v)", controlID, err)
+	}
+
+	for _, tracker := range []string{"101", "102", "103"} {
+		if _, err := rules.GetByTracker(tracker); !errors.Is(err, ErrUnableToParse) {
+			t.Errorf("tracker '%s', expected parse error only when requested, got %v", tracker, err)
+		}
+	}
+
+	if (*rules)[1].Direction != "any" {
+		t.Errorf("expected unmanaged floating rule to keep its direction, got '%s'", (*rules)[1].Direction)
+	}
+}
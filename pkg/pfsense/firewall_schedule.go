@@ -0,0 +1,516 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	scheduleMaxTimeRanges = 99
+	scheduleDateFormat    = "%02d-%02d"
+	scheduleTimeFormat    = "%02d:%02d"
+)
+
+type scheduleTimeRangeResponse struct {
+	Position    string `json:"position"`
+	Month       string `json:"month"`
+	Day         string `json:"day"`
+	Hour        string `json:"hour"`
+	Description string `json:"rangedescr"`
+}
+
+type scheduleResponse struct {
+	Name        string                      `json:"name"`
+	Description string                      `json:"descr"`
+	TimeRanges  []scheduleTimeRangeResponse `json:"timerange"`
+	ControlID   int                         `json:"controlID"`
+}
+
+type ScheduleTimeRange struct {
+	DaysOfWeek  []int
+	Dates       []string
+	StartTime   string
+	StopTime    string
+	Description string
+}
+
+type Schedule struct {
+	Name        string
+	Description string
+	TimeRanges  []ScheduleTimeRange
+	controlID   int
+}
+
+func parseScheduleTime(t string) (int, int, error) {
+	parts := strings.Split(t, ":")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%w, time must be in HH:MM format", ErrClientValidation)
+	}
+
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, 0, fmt.Errorf("%w, time must be in HH:MM format", ErrClientValidation)
+	}
+
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, 0, fmt.Errorf("%w, time must be in HH:MM format", ErrClientValidation)
+	}
+
+	return hour, minute, nil
+}
+
+func validateScheduleTime(t string) error {
+	var isValidTime = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`).MatchString
+	if !isValidTime(t) {
+		return fmt.Errorf("%w, time must be in HH:MM format (00:00 to 23:59)", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func parseScheduleDate(date string) (int, int, error) {
+	var isValidDate = regexp.MustCompile(`^[0-9]{2}-[0-9]{2}$`).MatchString
+	if !isValidDate(date) {
+		return 0, 0, fmt.Errorf("%w, date must be in MM-DD format", ErrClientValidation)
+	}
+
+	month, _ := strconv.Atoi(date[:2])
+	day, _ := strconv.Atoi(date[3:])
+
+	if month < 1 || month > 12 {
+		return 0, 0, fmt.Errorf("%w, date '%s' month must be between 01 and 12", ErrClientValidation, date)
+	}
+
+	if day < 1 || day > 31 {
+		return 0, 0, fmt.Errorf("%w, date '%s' day must be between 01 and 31", ErrClientValidation, date)
+	}
+
+	return month, day, nil
+}
+
+func (tr *ScheduleTimeRange) SetDaysOfWeek(days []int) error {
+	var errs []error
+	for _, day := range days {
+		if day < 1 || day > 7 {
+			errs = append(errs, fmt.Errorf("%w, day of week '%d' must be between 1 (Monday) and 7 (Sunday)", ErrClientValidation, day))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	tr.DaysOfWeek = days
+
+	return nil
+}
+
+func (tr *ScheduleTimeRange) SetDates(dates []string) error {
+	var errs []error
+	for _, date := range dates {
+		if _, _, err := parseScheduleDate(date); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	tr.Dates = dates
+
+	return nil
+}
+
+func (tr *ScheduleTimeRange) SetStartTime(startTime string) error {
+	err := validateScheduleTime(startTime)
+	if err != nil {
+		return err
+	}
+
+	tr.StartTime = startTime
+
+	return nil
+}
+
+func (tr *ScheduleTimeRange) SetStopTime(stopTime string) error {
+	err := validateScheduleTime(stopTime)
+	if err != nil {
+		return err
+	}
+
+	tr.StopTime = stopTime
+
+	return nil
+}
+
+func (tr *ScheduleTimeRange) SetDescription(description string) error {
+	tr.Description = description
+
+	return nil
+}
+
+// Validate checks the time range selects either days of the week or dates and that the start time is before the stop time.
+func (tr ScheduleTimeRange) Validate() error {
+	if (len(tr.DaysOfWeek) == 0) == (len(tr.Dates) == 0) {
+		return fmt.Errorf("%w, time range must select either days of the week or dates", ErrClientValidation)
+	}
+
+	// zero-padded HH:MM strings order the same as the times they represent
+	if tr.StartTime >= tr.StopTime {
+		return fmt.Errorf("%w, time range start time must be before stop time", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (tr ScheduleTimeRange) formatSchedule() string {
+	var selections []string
+
+	for _, day := range tr.DaysOfWeek {
+		selections = append(selections, strconv.Itoa(day))
+	}
+
+	// the page expects selected dates as calendar cell IDs ('w<week>p<position>-m<month>d<day>'), only the month and day are parsed
+	for _, date := range tr.Dates {
+		month, day, _ := parseScheduleDate(date)
+		selections = append(selections, fmt.Sprintf("w0p0-m%dd%d", month, day))
+	}
+
+	return strings.Join(selections, ",")
+}
+
+func (s *Schedule) SetName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9_]{1,31}$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, schedule name must be 1 to 31 alphanumeric characters (with underscores)", ErrClientValidation)
+	}
+
+	s.Name = name
+
+	return nil
+}
+
+func (s *Schedule) SetDescription(description string) error {
+	s.Description = description
+
+	return nil
+}
+
+func (s *Schedule) SetTimeRanges(timeRanges []ScheduleTimeRange) error {
+	if len(timeRanges) == 0 {
+		return fmt.Errorf("%w, schedule must have at least one time range", ErrClientValidation)
+	}
+
+	if len(timeRanges) > scheduleMaxTimeRanges {
+		return fmt.Errorf("%w, schedule cannot have more than %d time ranges", ErrClientValidation, scheduleMaxTimeRanges)
+	}
+
+	var errs []error
+	for i, timeRange := range timeRanges {
+		err := timeRange.Validate()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("time range %d, %w", i, err))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	s.TimeRanges = timeRanges
+
+	return nil
+}
+
+type Schedules []Schedule
+
+func (ss Schedules) GetByName(name string) (*Schedule, error) {
+	for _, s := range ss {
+		if s.Name == name {
+			return &s, nil
+		}
+	}
+	return nil, fmt.Errorf("schedule %w with name '%s'", ErrNotFound, name)
+}
+
+func (ss Schedules) GetControlIDByName(name string) (*int, error) {
+	return getControlID(ss, func(s Schedule) bool { return s.Name == name },
+		func(_ int, s Schedule) int { return s.controlID }, "schedule", fmt.Sprintf("name '%s'", name))
+}
+
+func parseScheduleTimeRangeResponse(resp scheduleTimeRangeResponse) (*ScheduleTimeRange, error) {
+	var timeRange ScheduleTimeRange
+	var err error
+
+	if resp.Position != "" {
+		var days []int
+		for _, p := range strings.Split(resp.Position, ",") {
+			day, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, err
+			}
+			days = append(days, day)
+		}
+
+		err = timeRange.SetDaysOfWeek(days)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if resp.Month != "" {
+		months, days := strings.Split(resp.Month, ","), strings.Split(resp.Day, ",")
+		if len(months) != len(days) {
+			return nil, fmt.Errorf("%w, months and days do not match", ErrUnableToParse)
+		}
+
+		var dates []string
+		for i := range months {
+			month, err := strconv.Atoi(months[i])
+			if err != nil {
+				return nil, err
+			}
+
+			day, err := strconv.Atoi(days[i])
+			if err != nil {
+				return nil, err
+			}
+
+			dates = append(dates, fmt.Sprintf(scheduleDateFormat, month, day))
+		}
+
+		err = timeRange.SetDates(dates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	startTime, stopTime, found := strings.Cut(resp.Hour, "-")
+	if !found {
+		return nil, fmt.Errorf("%w, hour '%s' must be a range", ErrUnableToParse, resp.Hour)
+	}
+
+	hour, minute, err := parseScheduleTime(startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = timeRange.SetStartTime(fmt.Sprintf(scheduleTimeFormat, hour, minute))
+	if err != nil {
+		return nil, err
+	}
+
+	hour, minute, err = parseScheduleTime(stopTime)
+	if err != nil {
+		return nil, err
+	}
+
+	err = timeRange.SetStopTime(fmt.Sprintf(scheduleTimeFormat, hour, minute))
+	if err != nil {
+		return nil, err
+	}
+
+	err = timeRange.SetDescription(html.UnescapeString(resp.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	return &timeRange, nil
+}
+
+func (pf *Client) getFirewallSchedules(ctx context.Context) (*Schedules, error) {
+	command := "$output = array();" +
+		"if (is_array($config['schedules']['schedule'])) {" +
+		"foreach ($config['schedules']['schedule'] as $k => $v) {" +
+		"$v['controlID'] = $k; array_push($output, $v);" +
+		"}}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var sResp []scheduleResponse
+	err = json.Unmarshal(b, &sResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var schedules Schedules
+	for _, resp := range sResp {
+		var schedule Schedule
+		var err error
+
+		err = schedule.SetName(resp.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w schedule response, %w", ErrUnableToParse, err)
+		}
+
+		err = schedule.SetDescription(html.UnescapeString(resp.Description))
+		if err != nil {
+			return nil, fmt.Errorf("%w schedule response, %w", ErrUnableToParse, err)
+		}
+
+		for _, trResp := range resp.TimeRanges {
+			timeRange, err := parseScheduleTimeRangeResponse(trResp)
+			if err != nil {
+				return nil, fmt.Errorf("%w schedule response, %w", ErrUnableToParse, err)
+			}
+
+			schedule.TimeRanges = append(schedule.TimeRanges, *timeRange)
+		}
+
+		schedule.controlID = resp.ControlID
+
+		schedules = append(schedules, schedule)
+	}
+
+	return &schedules, nil
+}
+
+func (pf *Client) GetFirewallSchedules(ctx context.Context) (*Schedules, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w schedules, %w", ErrGetOperationFailed, err)
+	}
+
+	return schedules, nil
+}
+
+func (pf *Client) GetFirewallSchedule(ctx context.Context, name string) (*Schedule, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w schedule (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return schedules.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateFirewallSchedule(ctx context.Context, scheduleReq Schedule, controlID *int) (*Schedule, error) {
+	u := url.URL{Path: "firewall_schedule_edit.php"}
+	v := url.Values{
+		"name":  {scheduleReq.Name},
+		"descr": {scheduleReq.Description},
+		"save":  {"Save"},
+	}
+
+	for i, timeRange := range scheduleReq.TimeRanges {
+		v.Set(fmt.Sprintf("schedule%d", i), timeRange.formatSchedule())
+		v.Set(fmt.Sprintf("starttime%d", i), timeRange.StartTime)
+		v.Set(fmt.Sprintf("stoptime%d", i), timeRange.StopTime)
+		v.Set(fmt.Sprintf("timedescr%d", i), timeRange.Description)
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules.GetByName(scheduleReq.Name)
+}
+
+func (pf *Client) CreateFirewallSchedule(ctx context.Context, scheduleReq Schedule) (*Schedule, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedule, err := pf.createOrUpdateFirewallSchedule(ctx, scheduleReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w schedule, %w", ErrCreateOperationFailed, err)
+	}
+
+	return schedule, nil
+}
+
+func (pf *Client) UpdateFirewallSchedule(ctx context.Context, scheduleReq Schedule) (*Schedule, error) {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w schedule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := schedules.GetControlIDByName(scheduleReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w schedule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	schedule, err := pf.createOrUpdateFirewallSchedule(ctx, scheduleReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w schedule, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return schedule, nil
+}
+
+func (pf *Client) DeleteFirewallSchedule(ctx context.Context, name string) error {
+	pf.mutexes.FirewallSchedule.Lock()
+	defer pf.mutexes.FirewallSchedule.Unlock()
+
+	schedules, err := pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("%w schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := schedules.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_schedule.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// schedules referenced by a firewall rule are not deleted, the page only displays a message
+	schedules, err = pf.getFirewallSchedules(ctx)
+	if err != nil {
+		return fmt.Errorf("%w schedule, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err := schedules.GetByName(name); err == nil {
+		return fmt.Errorf("%w schedule, %w, schedule '%s' still exists (it may be referenced by a firewall rule)", ErrDeleteOperationFailed, ErrServerValidation, name)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package pfsense
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestScheduleTimeRangeValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		timeRange ScheduleTimeRange
+		valid     bool
+	}{
+		{"weekdays", ScheduleTimeRange{DaysOfWeek: []int{1, 2, 3, 4, 5}, StartTime: "08:00", StopTime: "17:00"}, true},
+		{"dates", ScheduleTimeRange{Dates: []string{"12-24"}, StartTime: "08:00", StopTime: "12:00"}, true},
+		{"neither", ScheduleTimeRange{StartTime: "08:00", StopTime: "17:00"}, false},
+		{"both", ScheduleTimeRange{DaysOfWeek: []int{1}, Dates: []string{"12-24"}, StartTime: "08:00", StopTime: "17:00"}, false},
+		{"stop before start", ScheduleTimeRange{DaysOfWeek: []int{1}, StartTime: "17:00", StopTime: "08:00"}, false},
+		{"empty range", ScheduleTimeRange{DaysOfWeek: []int{1}, StartTime: "08:00", StopTime: "08:00"}, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.timeRange.Validate()
+
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s: expected client validation error, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestScheduleTimeRangeSetters(t *testing.T) {
+	var timeRange ScheduleTimeRange
+
+	for _, value := range []string{"8:00", "24:00", "12:60", "1200", ""} {
+		if err := timeRange.SetStartTime(value); !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetStartTime(%q) expected client validation error, got %v", value, err)
+		}
+	}
+
+	if err := timeRange.SetDaysOfWeek([]int{0, 1, 8}); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error for days of week, got %v", err)
+	}
+
+	if err := timeRange.SetDates([]string{"13-01", "02-32", "1-1"}); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error for dates, got %v", err)
+	}
+}
+
+func TestScheduleTimeRangeRoundTrip(t *testing.T) {
+	// time ranges are submitted as calendar selections and stored as positions or months and days
+	tests := []struct {
+		timeRange ScheduleTimeRange
+		schedule  string
+		resp      scheduleTimeRangeResponse
+	}{
+		{
+			ScheduleTimeRange{DaysOfWeek: []int{1, 2, 3, 4, 5}, StartTime: "08:00", StopTime: "17:00"},
+			"1,2,3,4,5",
+			scheduleTimeRangeResponse{Position: "1,2,3,4,5", Hour: "8:00-17:00"},
+		},
+		{
+			ScheduleTimeRange{Dates: []string{"12-24", "12-31"}, StartTime: "08:00", StopTime: "12:30", Description: "holiday eves & more"},
+			"w0p0-m12d24,w0p0-m12d31",
+			scheduleTimeRangeResponse{Month: "12,12", Day: "24,31", Hour: "8:00-12:30", Description: "holiday eves &amp; more"},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.timeRange.formatSchedule(); got != tt.schedule {
+			t.Errorf("formatSchedule() = %q, want %q", got, tt.schedule)
+		}
+
+		timeRange, err := parseScheduleTimeRangeResponse(tt.resp)
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		if !slices.Equal(timeRange.DaysOfWeek, tt.timeRange.DaysOfWeek) || !slices.Equal(timeRange.Dates, tt.timeRange.Dates) ||
+			timeRange.StartTime != tt.timeRange.StartTime || timeRange.StopTime != tt.timeRange.StopTime || timeRange.Description != tt.timeRange.Description {
+			t.Errorf("parsed %+v, want %+v", *timeRange, tt.timeRange)
+		}
+	}
+
+	if _, err := parseScheduleTimeRangeResponse(scheduleTimeRangeResponse{Month: "12,12", Day: "24", Hour: "8:00-12:00"}); !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected unable to parse error for mismatched months and days, got %v", err)
+	}
+}
@@ -0,0 +1,434 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	VirtualIPModeCARP     = "carp"
+	VirtualIPModeIPAlias  = "ipalias"
+	VirtualIPModeProxyARP = "proxyarp"
+
+	virtualIPVHIDMin    = 1
+	virtualIPVHIDMax    = 255
+	virtualIPAdvBaseMin = 1
+	virtualIPAdvBaseMax = 254
+	virtualIPAdvSkewMin = 0
+	virtualIPAdvSkewMax = 254
+
+	virtualIPDefaultAdvBase = 1
+	virtualIPDefaultAdvSkew = 0
+)
+
+var (
+	ErrApplyVirtualIPChange = errors.New("failed to apply virtual IP changes")
+)
+
+func VirtualIPModes() []string {
+	return []string{VirtualIPModeCARP, VirtualIPModeIPAlias, VirtualIPModeProxyARP}
+}
+
+type virtualIPResponse struct {
+	Mode        string `json:"mode"`
+	Interface   string `json:"interface"`
+	Subnet      string `json:"subnet"`
+	SubnetBits  string `json:"subnet_bits"`
+	VHID        string `json:"vhid"`
+	AdvBase     string `json:"advbase"`
+	AdvSkew     string `json:"advskew"`
+	Password    string `json:"password"`
+	Description string `json:"descr"`
+}
+
+type VirtualIP struct {
+	Mode        string
+	Interface   string
+	Subnet      netip.Prefix
+	VHID        int
+	AdvBase     int
+	AdvSkew     int
+	Password    string
+	Description string
+}
+
+func (vip *VirtualIP) SetMode(mode string) error {
+	if !slices.Contains(VirtualIPModes(), mode) {
+		return fmt.Errorf("%w, virtual IP mode must be one of %s", ErrClientValidation, strings.Join(VirtualIPModes(), ", "))
+	}
+
+	vip.Mode = mode
+
+	return nil
+}
+
+func (vip *VirtualIP) SetInterface(iface string) error {
+	if iface == "" {
+		return fmt.Errorf("%w, virtual IP interface required", ErrClientValidation)
+	}
+
+	vip.Interface = iface
+
+	return nil
+}
+
+func (vip *VirtualIP) SetSubnet(subnet string) error {
+	prefix, err := netip.ParsePrefix(subnet)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	vip.Subnet = prefix
+
+	return nil
+}
+
+func (vip *VirtualIP) SetVHID(vhid int) error {
+	if vhid < virtualIPVHIDMin || vhid > virtualIPVHIDMax {
+		return fmt.Errorf("%w, virtual IP VHID must be between %d and %d", ErrClientValidation, virtualIPVHIDMin, virtualIPVHIDMax)
+	}
+
+	vip.VHID = vhid
+
+	return nil
+}
+
+func (vip *VirtualIP) SetAdvBase(advBase int) error {
+	if advBase < virtualIPAdvBaseMin || advBase > virtualIPAdvBaseMax {
+		return fmt.Errorf("%w, virtual IP advertising base must be between %d and %d", ErrClientValidation, virtualIPAdvBaseMin, virtualIPAdvBaseMax)
+	}
+
+	vip.AdvBase = advBase
+
+	return nil
+}
+
+func (vip *VirtualIP) SetAdvSkew(advSkew int) error {
+	if advSkew < virtualIPAdvSkewMin || advSkew > virtualIPAdvSkewMax {
+		return fmt.Errorf("%w, virtual IP advertising skew must be between %d and %d", ErrClientValidation, virtualIPAdvSkewMin, virtualIPAdvSkewMax)
+	}
+
+	vip.AdvSkew = advSkew
+
+	return nil
+}
+
+func (vip *VirtualIP) SetPassword(password string) error {
+	vip.Password = password
+
+	return nil
+}
+
+func (vip *VirtualIP) SetDescription(description string) error {
+	vip.Description = description
+
+	return nil
+}
+
+// Validate checks that CARP virtual IPs have a VHID and password and that other modes have neither.
+func (vip VirtualIP) Validate() error {
+	if vip.Mode == VirtualIPModeCARP {
+		if vip.VHID == 0 {
+			return fmt.Errorf("%w, CARP virtual IP requires a VHID", ErrClientValidation)
+		}
+
+		if vip.Password == "" {
+			return fmt.Errorf("%w, CARP virtual IP requires a password", ErrClientValidation)
+		}
+
+		return nil
+	}
+
+	if vip.VHID != 0 || vip.Password != "" {
+		return fmt.Errorf("%w, VHID and password are only used by CARP virtual IPs", ErrClientValidation)
+	}
+
+	return nil
+}
+
+type VirtualIPs []VirtualIP
+
+func (vips VirtualIPs) GetBySubnetAndVHID(subnet netip.Prefix, vhid int) (*VirtualIP, error) {
+	for _, vip := range vips {
+		if vip.Subnet == subnet && vip.VHID == vhid {
+			return &vip, nil
+		}
+	}
+	return nil, fmt.Errorf("virtual IP %w with subnet '%s' and VHID '%d'", ErrNotFound, subnet, vhid)
+}
+
+func (vips VirtualIPs) GetControlIDBySubnetAndVHID(subnet netip.Prefix, vhid int) (*int, error) {
+	return getControlID(vips, func(vip VirtualIP) bool { return vip.Subnet == subnet && vip.VHID == vhid },
+		positionalControlID, "virtual IP", fmt.Sprintf("subnet '%s' and VHID '%d'", subnet, vhid))
+}
+
+func parseVirtualIPResponse(resp virtualIPResponse) (*VirtualIP, error) {
+	var vip VirtualIP
+	var err error
+
+	err = vip.SetMode(resp.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetSubnet(fmt.Sprintf("%s/%s", resp.Subnet, resp.SubnetBits))
+	if err != nil {
+		return nil, err
+	}
+
+	// the advertising base and skew are stored for CARP virtual IPs only, other modes report the defaults
+	advBase, advSkew := virtualIPDefaultAdvBase, virtualIPDefaultAdvSkew
+
+	if vip.Mode == VirtualIPModeCARP {
+		vhid, err := strconv.Atoi(resp.VHID)
+		if err != nil {
+			return nil, err
+		}
+
+		err = vip.SetVHID(vhid)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.AdvBase != "" {
+			advBase, err = strconv.Atoi(resp.AdvBase)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if resp.AdvSkew != "" {
+			advSkew, err = strconv.Atoi(resp.AdvSkew)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = vip.SetPassword(resp.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = vip.SetAdvBase(advBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetAdvSkew(advSkew)
+	if err != nil {
+		return nil, err
+	}
+
+	err = vip.SetDescription(html.UnescapeString(resp.Description))
+	if err != nil {
+		return nil, err
+	}
+
+	return &vip, nil
+}
+
+func (pf *Client) getFirewallVirtualIPs(ctx context.Context) (*VirtualIPs, error) {
+	command := "$output = array();" +
+		"if (is_array($config['virtualip']['vip'])) {" +
+		"foreach ($config['virtualip']['vip'] as $v) { array_push($output, $v); }" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var vipResp []virtualIPResponse
+	err = json.Unmarshal(b, &vipResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var vips VirtualIPs
+	for _, resp := range vipResp {
+		vip, err := parseVirtualIPResponse(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w virtual IP response, %w", ErrUnableToParse, err)
+		}
+
+		vips = append(vips, *vip)
+	}
+
+	return &vips, nil
+}
+
+func (pf *Client) GetFirewallVirtualIPs(ctx context.Context) (*VirtualIPs, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IPs, %w", ErrGetOperationFailed, err)
+	}
+
+	return vips, nil
+}
+
+func (pf *Client) GetFirewallVirtualIP(ctx context.Context, subnet netip.Prefix, vhid int) (*VirtualIP, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP (subnet '%s', VHID '%d'), %w", ErrGetOperationFailed, subnet, vhid, err)
+	}
+
+	return vips.GetBySubnetAndVHID(subnet, vhid)
+}
+
+func (pf *Client) createOrUpdateFirewallVirtualIP(ctx context.Context, vipReq VirtualIP, controlID *int) (*VirtualIP, error) {
+	err := vipReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Path: "firewall_virtual_ip_edit.php"}
+	v := url.Values{
+		"mode":        {vipReq.Mode},
+		"interface":   {vipReq.Interface},
+		"type":        {"single"},
+		"subnet":      {vipReq.Subnet.Addr().String()},
+		"subnet_bits": {strconv.Itoa(vipReq.Subnet.Bits())},
+		"descr":       {vipReq.Description},
+		"save":        {"Save"},
+	}
+
+	if vipReq.Mode == VirtualIPModeCARP {
+		v.Set("vhid", strconv.Itoa(vipReq.VHID))
+		v.Set("advbase", strconv.Itoa(vipReq.AdvBase))
+		v.Set("advskew", strconv.Itoa(vipReq.AdvSkew))
+		v.Set("password", vipReq.Password)
+		v.Set("password_confirm", vipReq.Password)
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return vips.GetBySubnetAndVHID(vipReq.Subnet, vipReq.VHID)
+}
+
+func (pf *Client) CreateFirewallVirtualIP(ctx context.Context, vipReq VirtualIP) (*VirtualIP, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vip, err := pf.createOrUpdateFirewallVirtualIP(ctx, vipReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrCreateOperationFailed, err)
+	}
+
+	return vip, nil
+}
+
+func (pf *Client) UpdateFirewallVirtualIP(ctx context.Context, vipReq VirtualIP) (*VirtualIP, error) {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := vips.GetControlIDBySubnetAndVHID(vipReq.Subnet, vipReq.VHID)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrUpdateOperationFailed, err)
+	}
+
+	vip, err := pf.createOrUpdateFirewallVirtualIP(ctx, vipReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w virtual IP, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return vip, nil
+}
+
+func (pf *Client) DeleteFirewallVirtualIP(ctx context.Context, subnet netip.Prefix, vhid int) error {
+	pf.mutexes.FirewallVirtualIP.Lock()
+	defer pf.mutexes.FirewallVirtualIP.Unlock()
+
+	vips, err := pf.getFirewallVirtualIPs(ctx)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := vips.GetControlIDBySubnetAndVHID(subnet, vhid)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "firewall_virtual_ip.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// deleting a virtual IP still referenced (e.g. by a NAT rule or an IP alias on a CARP address) is refused with an input error
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w virtual IP, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) ApplyFirewallVirtualIPChanges(ctx context.Context) error {
+	pf.mutexes.FirewallVirtualIPApply.Lock()
+	defer pf.mutexes.FirewallVirtualIPApply.Unlock()
+
+	u := url.URL{Path: "firewall_virtual_ip.php"}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyVirtualIPChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
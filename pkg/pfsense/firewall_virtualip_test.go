@@ -0,0 +1,167 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestVirtualIPSetVHID(t *testing.T) {
+	tests := []struct {
+		vhid  int
+		valid bool
+	}{
+		{0, false},
+		{1, true},
+		{255, true},
+		{256, false},
+	}
+
+	for _, tt := range tests {
+		var vip VirtualIP
+		err := vip.SetVHID(tt.vhid)
+		if tt.valid && err != nil {
+			t.Errorf("SetVHID(%d) unexpected error, %s", tt.vhid, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetVHID(%d) expected validation error, got %v", tt.vhid, err)
+		}
+	}
+}
+
+func TestVirtualIPValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		vip   VirtualIP
+		valid bool
+	}{
+		{"carp", VirtualIP{Mode: VirtualIPModeCARP, VHID: 1, Password: "secret"}, true},
+		{"carp without vhid", VirtualIP{Mode: VirtualIPModeCARP, Password: "secret"}, false},
+		{"carp without password", VirtualIP{Mode: VirtualIPModeCARP, VHID: 1}, false},
+		{"ipalias", VirtualIP{Mode: VirtualIPModeIPAlias}, true},
+		{"ipalias with vhid", VirtualIP{Mode: VirtualIPModeIPAlias, VHID: 1}, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.vip.Validate()
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s: expected validation error, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestCreateFirewallVirtualIPCARP(t *testing.T) {
+	var mutex sync.Mutex
+	vips := []map[string]string{
+		{"mode": "ipalias", "interface": "lan", "subnet": "192.168.1.5", "subnet_bits": "32", "descr": "alias"},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/firewall_virtual_ip_edit.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		_ = r.ParseForm()
+		if r.PostFormValue("password_confirm") != r.PostFormValue("password") {
+			t.Errorf("expected password confirmation to match, got %v", r.PostForm)
+		}
+
+		vip := map[string]string{}
+		for _, key := range []string{"mode", "interface", "subnet", "subnet_bits", "vhid", "advbase", "advskew", "password", "descr"} {
+			vip[key] = r.PostFormValue(key)
+		}
+
+		if r.PostForm.Has("id") {
+			id, _ := strconv.Atoi(r.PostFormValue("id"))
+			vips[id] = vip
+		} else {
+			vips = append(vips, vip)
+		}
+
+		fmt.Fprint(w, `<html><body></body></html>`)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(vips)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var vipReq VirtualIP
+	_ = vipReq.SetMode(VirtualIPModeCARP)
+	_ = vipReq.SetInterface("lan")
+	_ = vipReq.SetSubnet("192.168.1.2/24")
+	_ = vipReq.SetVHID(12)
+	_ = vipReq.SetAdvBase(2)
+	_ = vipReq.SetAdvSkew(100)
+	_ = vipReq.SetPassword("secret")
+	_ = vipReq.SetDescription("carp")
+
+	vip, err := pf.CreateFirewallVirtualIP(context.Background(), vipReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if *vip != vipReq {
+		t.Errorf("read back %+v, want %+v", *vip, vipReq)
+	}
+
+	// the skew changes in place at the same position
+	_ = vipReq.SetAdvSkew(200)
+
+	vip, err = pf.UpdateFirewallVirtualIP(context.Background(), vipReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if vip.VHID != 12 || vip.AdvSkew != 200 {
+		t.Errorf("read back VHID %d and skew %d, want 12 and 200", vip.VHID, vip.AdvSkew)
+	}
+
+	vip, err = pf.GetFirewallVirtualIP(context.Background(), netip.MustParsePrefix("192.168.1.2/24"), 12)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if vip.AdvBase != 2 || vip.AdvSkew != 200 {
+		t.Errorf("read back advertising base %d and skew %d, want 2 and 200", vip.AdvBase, vip.AdvSkew)
+	}
+
+	// the non-CARP virtual IP reports the defaults
+	other, err := pf.GetFirewallVirtualIP(context.Background(), netip.MustParsePrefix("192.168.1.5/32"), 0)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if other.AdvBase != 1 || other.AdvSkew != 0 {
+		t.Errorf("read back advertising base %d and skew %d, want defaults", other.AdvBase, other.AdvSkew)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(vips) != 2 {
+		t.Errorf("expected 2 virtual IPs, got %d", len(vips))
+	}
+}
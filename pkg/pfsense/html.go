@@ -2,6 +2,7 @@ package pfsense
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 
@@ -16,7 +17,7 @@ func scrapeHTMLValidationErrors(doc *goquery.Document) error {
 		inputErrorList.Find("li").Each(func(i int, e *goquery.Selection) {
 			inputErrors = append(inputErrors, strings.TrimSpace(e.Text()))
 		})
-		return fmt.Errorf("%w, '%s'", ErrServerValidation, strings.Join(inputErrors, ", "))
+		return &ServerValidationError{Messages: inputErrors}
 	}
 	return nil
 }
@@ -29,3 +30,71 @@ func sanitizeHTMLMessage(text string) (string, error) {
 	sanitize := regexp.MustCompile(`[^a-zA-Z0-9 ]+`)
 	return sanitize.ReplaceAllString(doc.Text(), ""), nil
 }
+
+// scrapeHTMLFormValues returns the current values of a settings form, allowing a subset of fields to be changed
+// without resetting the remaining fields to their defaults on submit.
+func scrapeHTMLFormValues(doc *goquery.Document) url.Values {
+	values := url.Values{}
+	form := doc.FindMatcher(goquery.Single("form[method='post']"))
+
+	form.Find("input[name]").Each(func(i int, e *goquery.Selection) {
+		name, _ := e.Attr("name")
+		t, _ := e.Attr("type")
+		switch t {
+		case "submit", "button", "reset", "file":
+			return
+		case "checkbox", "radio":
+			if _, checked := e.Attr("checked"); !checked {
+				return
+			}
+		}
+		value, _ := e.Attr("value")
+		values.Add(name, value)
+	})
+
+	form.Find("textarea[name]").Each(func(i int, e *goquery.Selection) {
+		name, _ := e.Attr("name")
+		values.Add(name, e.Text())
+	})
+
+	form.Find("select[name]").Each(func(i int, e *goquery.Selection) {
+		name, _ := e.Attr("name")
+		selected := e.Find("option[selected]")
+
+		// like browsers, a single select without a selected option submits its first option
+		if _, multiple := e.Attr("multiple"); selected.Length() == 0 && !multiple {
+			selected = e.Find("option").First()
+		}
+
+		selected.Each(func(i int, o *goquery.Selection) {
+			value, ok := o.Attr("value")
+			if !ok {
+				value = strings.TrimSpace(o.Text())
+			}
+			values.Add(name, value)
+		})
+	})
+
+	return values
+}
+
+// scrapeHTMLSelectOptions returns the option values of a select field, such as the choices available on this system.
+func scrapeHTMLSelectOptions(doc *goquery.Document, name string) []string {
+	var options []string
+	doc.Find(fmt.Sprintf("select[name='%s'] option", name)).Each(func(i int, o *goquery.Selection) {
+		if value, ok := o.Attr("value"); ok && value != "" {
+			options = append(options, value)
+		}
+	})
+
+	return options
+}
+
+// setFormCheckbox sets or removes a checkbox form value, unchecked boxes are not submitted by browsers.
+func setFormCheckbox(v url.Values, key string, checked bool) {
+	if checked {
+		v.Set(key, "yes")
+	} else {
+		v.Del(key)
+	}
+}
@@ -0,0 +1,63 @@
+package pfsense
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func testHTMLDocument(t *testing.T, name string) *goquery.Document {
+	t.Helper()
+
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatalf("unable to open fixture, %s", err)
+	}
+	defer f.Close()
+
+	doc, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatalf("unable to parse fixture, %s", err)
+	}
+
+	return doc
+}
+
+func TestScrapeHTMLFormValues(t *testing.T) {
+	values := scrapeHTMLFormValues(testHTMLDocument(t, "testdata/settings_form.html"))
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"__csrf_magic", []string{"sid:token"}},
+		{"hostname", []string{"pfSense"}},
+		{"enable", []string{"yes"}},
+		{"disabled", nil},
+		{"mode", []string{"b"}},
+		{"save", nil},
+		{"notes", []string{"some notes"}},
+		{"crypto_hardware", []string{"aesni"}},
+		{"thermal_hardware", []string{"none"}},
+		{"timezone", []string{"Etc/UTC"}},
+		{"members[]", nil},
+		{"groups[]", []string{"admins", "users"}},
+	}
+
+	for _, tt := range tests {
+		if got := values[tt.name]; !slices.Equal(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestScrapeHTMLSelectOptions(t *testing.T) {
+	doc := testHTMLDocument(t, "testdata/settings_form.html")
+
+	want := []string{"aesni"}
+	if got := scrapeHTMLSelectOptions(doc, "crypto_hardware"); !slices.Equal(got, want) {
+		t.Errorf("scrapeHTMLSelectOptions() = %v, want %v", got, want)
+	}
+}
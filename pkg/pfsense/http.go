@@ -3,24 +3,104 @@ package pfsense
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/http"
 	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func shouldRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+var keyChunk = regexp.MustCompile(`\d+|\D+`)
+
+// naturalKeyLess compares keys chunk by chunk, runs of digits by their numeric value and anything else as strings, so
+// the order is consistent for any mix of keys (e.g. "a1x" before "a2" before "a10").
+func naturalKeyLess(a, b string) bool {
+	aChunks := keyChunk.FindAllString(a, -1)
+	bChunks := keyChunk.FindAllString(b, -1)
+
+	for i := 0; i < len(aChunks) && i < len(bChunks); i++ {
+		aChunk, bChunk := aChunks[i], bChunks[i]
+		if isDigit(aChunk[0]) && isDigit(bChunk[0]) {
+			aChunk, bChunk = strings.TrimLeft(aChunk, "0"), strings.TrimLeft(bChunk, "0")
+			if len(aChunk) != len(bChunk) {
+				return len(aChunk) < len(bChunk)
+			}
+		}
+
+		if aChunk != bChunk {
+			return aChunk < bChunk
+		}
+	}
+
+	if len(aChunks) != len(bChunks) {
+		return len(aChunks) < len(bChunks)
+	}
+
+	// only leading zeros differ
+	return a < b
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// encodeValues is like url.Values.Encode, but keys with a numeric suffix are ordered by index (e.g. "address2" before
+// "address10"). pfSense iterates over $_POST when saving indexed fields, so this keeps the written order equal to the
+// configured order.
+func encodeValues(values url.Values) string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return naturalKeyLess(keys[i], keys[j])
+	})
+
+	var buf strings.Builder
+	for _, k := range keys {
+		keyEscaped := url.QueryEscape(k)
+		for _, v := range values[k] {
+			if buf.Len() > 0 {
+				buf.WriteByte('&')
+			}
+			buf.WriteString(keyEscaped)
+			buf.WriteByte('=')
+			buf.WriteString(url.QueryEscape(v))
+		}
+	}
+
+	return buf.String()
+}
+
+// isDialError reports whether the connection could not be established, the request was not sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// shouldRetry does not retry requests that change state (form POSTs) after a transport error unless the connection
+// could not be established, a request that timed out may already have been applied.
+func shouldRetry(ctx context.Context, method string, resp *http.Response, err error) (bool, error) {
 	if ctx.Err() != nil {
 		return false, ctx.Err()
 	}
 
 	if err != nil {
-		return true, nil //lint:ignore nilerr httpDoErr handled elsewhere
+		idempotent := method == http.MethodGet || method == http.MethodHead
+		return idempotent || isDialError(err), nil //lint:ignore nilerr httpDoErr handled elsewhere
 	}
 
-	if resp.StatusCode == 0 || (resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented) {
+	if resp.StatusCode == 0 || resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented) {
 		return true, fmt.Errorf("%w %s", ErrHTTPStatus, resp.Status)
 	}
 
@@ -34,6 +114,25 @@ func linearJitter(min, max time.Duration, attempt int) *time.Timer {
 	return time.NewTimer(duration)
 }
 
+// retryAfter returns the delay requested by a Retry-After header (in seconds) up to maxWait, zero if absent or invalid.
+func retryAfter(resp *http.Response, maxWait time.Duration) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	// compared in seconds as large values overflow a duration
+	if seconds > int(maxWait/time.Second) {
+		return maxWait
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
 func (pf *Client) retryableDo(req *http.Request, reqBody *[]byte) (*http.Response, error) {
 	var resp *http.Response
 	var attempt int
@@ -46,18 +145,31 @@ func (pf *Client) retryableDo(req *http.Request, reqBody *[]byte) (*http.Respons
 		}
 
 		resp, httpDoErr = pf.httpClient.Do(req)
-		retry, shouldRetryErr = shouldRetry(req.Context(), resp, httpDoErr)
+		retry, shouldRetryErr = shouldRetry(req.Context(), req.Method, resp, httpDoErr)
 
 		if !retry || (*pf.Options.MaxAttempts-attempt) <= 0 {
 			break
 		}
 
+		timer := linearJitter(*pf.Options.RetryMinWait, *pf.Options.RetryMaxWait, attempt)
+		if wait := retryAfter(resp, *pf.Options.RetryMaxWait); wait > 0 {
+			timer.Stop()
+			timer = time.NewTimer(wait)
+		}
+
+		tflog.Debug(req.Context(), "Retrying pfSense request", map[string]any{
+			"method":             req.Method,
+			"path":               req.URL.Path,
+			"attempt":            attempt,
+			"remaining_attempts": *pf.Options.MaxAttempts - attempt,
+			"error":              fmt.Sprint(errors.Join(httpDoErr, shouldRetryErr)),
+		})
+
 		if httpDoErr == nil {
 			resp.Body.Close()
 			_, _ = io.Copy(io.Discard, resp.Body)
 		}
 
-		timer := linearJitter(*pf.Options.RetryMinWait, *pf.Options.RetryMaxWait, attempt)
 		select {
 		case <-req.Context().Done():
 			timer.Stop()
@@ -96,7 +208,7 @@ func (pf *Client) call(ctx context.Context, method string, relativeURL url.URL,
 		if pf.tokenKey != "" && pf.token != "" {
 			values.Set(pf.tokenKey, pf.token)
 		}
-		reqBytes := []byte(values.Encode())
+		reqBytes := []byte(encodeValues(*values))
 		reqBody = &reqBytes
 		reqBodyContentLength = int64(len(reqBytes))
 	}
@@ -109,6 +221,9 @@ func (pf *Client) call(ctx context.Context, method string, relativeURL url.URL,
 
 	req.ContentLength = reqBodyContentLength
 	req.Header.Set("User-Agent", "go-pfsense")
+	if pf.Options.APIKey != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("%s %s", pf.Options.APIKey, pf.Options.APISecret))
+	}
 	if values != nil {
 		req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
 	}
@@ -0,0 +1,163 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNaturalKeyLess(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"address2", "address10", true},
+		{"address10", "address2", false},
+		{"address0", "address0", false},
+		{"address9", "detail0", true},
+		{"a1x", "a2", true},
+		{"a2", "a10", true},
+		{"a1x", "a10", true},
+		{"a10", "a1x", false},
+		{"a01", "a1", true},
+		{"a1", "a01", false},
+		{"a", "a1", true},
+		{"save", "address0", false},
+	}
+
+	for _, tt := range tests {
+		if got := naturalKeyLess(tt.a, tt.b); got != tt.want {
+			t.Errorf("naturalKeyLess(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNaturalKeyLessTransitive(t *testing.T) {
+	keys := []string{"a1x", "a2", "a10", "a1", "a01", "a", "b", "a10x", "a2x1", "a2x10", "a2x9", "10", "9", "x"}
+
+	for _, a := range keys {
+		for _, b := range keys {
+			if naturalKeyLess(a, b) && naturalKeyLess(b, a) {
+				t.Errorf("naturalKeyLess(%q, %q) and naturalKeyLess(%q, %q) are both true", a, b, b, a)
+			}
+
+			for _, c := range keys {
+				if naturalKeyLess(a, b) && naturalKeyLess(b, c) && !naturalKeyLess(a, c) {
+					t.Errorf("naturalKeyLess not transitive for %q < %q < %q", a, b, c)
+				}
+			}
+		}
+	}
+}
+
+func TestEncodeValues(t *testing.T) {
+	values := url.Values{
+		"save":      {"Save"},
+		"address10": {"10.0.0.10"},
+		"address2":  {"10.0.0.2"},
+		"address0":  {"10.0.0.0"},
+		"detail2":   {"two words"},
+		"name":      {"a&b", "c"},
+	}
+
+	want := "address0=10.0.0.0&address2=10.0.0.2&address10=10.0.0.10&detail2=two+words&name=a%26b&name=c&save=Save"
+	if got := encodeValues(values); got != want {
+		t.Errorf("encodeValues() = %q, want %q", got, want)
+	}
+
+	decoded, err := url.ParseQuery(encodeValues(values))
+	if err != nil {
+		t.Fatalf("unable to parse encoded values, %s", err)
+	}
+
+	for k, v := range values {
+		if !slices.Equal(decoded[k], v) {
+			t.Errorf("decoded %q = %v, want %v", k, decoded[k], v)
+		}
+	}
+
+	if got := encodeValues(url.Values{}); got != "" {
+		t.Errorf("encodeValues() of no values = %q, want empty", got)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	maxWait := 5 * time.Second
+
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{"", 0},
+		{"0", 0},
+		{"2", 2 * time.Second},
+		{"5", 5 * time.Second},
+		{"120", maxWait},
+		{"9999999999", maxWait},
+		{"-1", 0},
+		{"soon", 0},
+		{"Wed, 21 Oct 2015 07:28:00 GMT", 0},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{Header: http.Header{}}
+		if tt.header != "" {
+			resp.Header.Set("Retry-After", tt.header)
+		}
+
+		if got := retryAfter(resp, maxWait); got != tt.want {
+			t.Errorf("retryAfter(%q) = %s, want %s", tt.header, got, tt.want)
+		}
+	}
+
+	if got := retryAfter(nil, maxWait); got != 0 {
+		t.Errorf("retryAfter(nil) = %s, want 0", got)
+	}
+}
+
+func TestShouldRetry(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+	readErr := &net.OpError{Op: "read", Err: errors.New("i/o timeout")}
+
+	tests := []struct {
+		method string
+		status int
+		err    error
+		want   bool
+	}{
+		{http.MethodGet, http.StatusOK, nil, false},
+		{http.MethodPost, http.StatusOK, nil, false},
+		{http.MethodGet, http.StatusNotFound, nil, false},
+		{http.MethodGet, http.StatusInternalServerError, nil, true},
+		{http.MethodPost, http.StatusInternalServerError, nil, true},
+		{http.MethodPost, http.StatusBadGateway, nil, true},
+		{http.MethodPost, http.StatusNotImplemented, nil, false},
+		{http.MethodPost, http.StatusTooManyRequests, nil, true},
+		{http.MethodGet, 0, readErr, true},
+		{http.MethodPost, 0, readErr, false},
+		{http.MethodPost, 0, dialErr, true},
+	}
+
+	for _, tt := range tests {
+		var resp *http.Response
+		if tt.err == nil {
+			resp = &http.Response{StatusCode: tt.status, Status: http.StatusText(tt.status)}
+		}
+
+		if got, _ := shouldRetry(context.Background(), tt.method, resp, tt.err); got != tt.want {
+			t.Errorf("shouldRetry(%s, %d, %v) = %t, want %t", tt.method, tt.status, tt.err, got, tt.want)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if retry, err := shouldRetry(ctx, http.MethodGet, nil, readErr); retry || !errors.Is(err, context.Canceled) {
+		t.Errorf("expected cancelled context to stop retries, got %t, %v", retry, err)
+	}
+}
@@ -0,0 +1,331 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+const (
+	vlanTagMin      = 1
+	vlanTagMax      = 4094
+	vlanPriorityMin = 0
+	vlanPriorityMax = 7
+)
+
+type vlanResponse struct {
+	Parent      string `json:"if"`
+	Tag         string `json:"tag"`
+	Priority    string `json:"pcp"`
+	Description string `json:"descr"`
+	Interface   string `json:"vlanif"`
+}
+
+type VLAN struct {
+	Parent      string
+	Tag         int
+	Priority    *int
+	Description string
+	Interface   string
+}
+
+func (vlan *VLAN) SetParent(parent string) error {
+	if parent == "" {
+		return fmt.Errorf("%w, VLAN parent interface required", ErrClientValidation)
+	}
+
+	vlan.Parent = parent
+
+	return nil
+}
+
+func (vlan *VLAN) SetTag(tag int) error {
+	if tag < vlanTagMin || tag > vlanTagMax {
+		return fmt.Errorf("%w, VLAN tag must be between %d and %d", ErrClientValidation, vlanTagMin, vlanTagMax)
+	}
+
+	vlan.Tag = tag
+
+	return nil
+}
+
+func (vlan *VLAN) SetPriority(priority int) error {
+	if priority < vlanPriorityMin || priority > vlanPriorityMax {
+		return fmt.Errorf("%w, VLAN priority must be between %d and %d", ErrClientValidation, vlanPriorityMin, vlanPriorityMax)
+	}
+
+	vlan.Priority = &priority
+
+	return nil
+}
+
+func (vlan *VLAN) SetDescription(description string) error {
+	vlan.Description = description
+
+	return nil
+}
+
+func (vlan *VLAN) SetInterface(iface string) error {
+	vlan.Interface = iface
+
+	return nil
+}
+
+type VLANs []VLAN
+
+func (vlans VLANs) GetByInterface(iface string) (*VLAN, error) {
+	for _, vlan := range vlans {
+		if vlan.Interface == iface {
+			return &vlan, nil
+		}
+	}
+	return nil, fmt.Errorf("VLAN %w with interface '%s'", ErrNotFound, iface)
+}
+
+func (vlans VLANs) GetByParentAndTag(parent string, tag int) (*VLAN, error) {
+	for _, vlan := range vlans {
+		if vlan.Parent == parent && vlan.Tag == tag {
+			return &vlan, nil
+		}
+	}
+	return nil, fmt.Errorf("VLAN %w with parent '%s' and tag '%d'", ErrNotFound, parent, tag)
+}
+
+func (vlans VLANs) GetControlIDByParentAndTag(parent string, tag int) (*int, error) {
+	return getControlID(vlans, func(vlan VLAN) bool { return vlan.Parent == parent && vlan.Tag == tag },
+		positionalControlID, "VLAN", fmt.Sprintf("parent '%s' and tag '%d'", parent, tag))
+}
+
+func parseVLANsResponse(b []byte) (*VLANs, error) {
+	var vlanResp []vlanResponse
+	err := json.Unmarshal(b, &vlanResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var vlans VLANs
+	for _, resp := range vlanResp {
+		var vlan VLAN
+		var err error
+
+		err = vlan.SetParent(resp.Parent)
+		if err != nil {
+			return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+		}
+
+		tag, err := strconv.Atoi(resp.Tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+		}
+
+		err = vlan.SetTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+		}
+
+		if resp.Priority != "" {
+			priority, err := strconv.Atoi(resp.Priority)
+			if err != nil {
+				return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+			}
+
+			err = vlan.SetPriority(priority)
+			if err != nil {
+				return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+			}
+		}
+
+		err = vlan.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+		}
+
+		err = vlan.SetInterface(resp.Interface)
+		if err != nil {
+			return nil, fmt.Errorf("%w VLAN response, %w", ErrUnableToParse, err)
+		}
+
+		vlans = append(vlans, vlan)
+	}
+
+	return &vlans, nil
+}
+
+func (pf *Client) getVLANs(ctx context.Context) (*VLANs, error) {
+	command := "$output = array();" +
+		"if (is_array($config['vlans']['vlan'])) { $output = $config['vlans']['vlan']; }" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseVLANsResponse(b)
+}
+
+func (pf *Client) GetVLANs(ctx context.Context) (*VLANs, error) {
+	pf.mutexes.InterfaceVLAN.Lock()
+	defer pf.mutexes.InterfaceVLAN.Unlock()
+
+	vlans, err := pf.getVLANs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w VLANs, %w", ErrGetOperationFailed, err)
+	}
+
+	return vlans, nil
+}
+
+func (pf *Client) GetVLAN(ctx context.Context, parent string, tag int) (*VLAN, error) {
+	pf.mutexes.InterfaceVLAN.Lock()
+	defer pf.mutexes.InterfaceVLAN.Unlock()
+
+	vlans, err := pf.getVLANs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w VLAN (parent '%s', tag '%d'), %w", ErrGetOperationFailed, parent, tag, err)
+	}
+
+	return vlans.GetByParentAndTag(parent, tag)
+}
+
+func (pf *Client) getVLANParents(ctx context.Context) ([]string, error) {
+	command := "$output = array_keys(get_interface_list());" +
+		"if (is_array($config['laggs']['lagg'])) {" +
+		"foreach ($config['laggs']['lagg'] as $v) { array_push($output, $v['laggif']); }" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var parents []string
+	err = json.Unmarshal(b, &parents)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	return parents, nil
+}
+
+func (pf *Client) createOrUpdateVLAN(ctx context.Context, vlanReq VLAN, controlID *int) (*VLAN, error) {
+	parents, err := pf.getVLANParents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(parents, vlanReq.Parent) {
+		return nil, fmt.Errorf("%w, VLAN parent interface '%s' is not a network interface", ErrClientValidation, vlanReq.Parent)
+	}
+
+	u := url.URL{Path: "interfaces_vlan_edit.php"}
+	v := url.Values{
+		"if":    {vlanReq.Parent},
+		"tag":   {strconv.Itoa(vlanReq.Tag)},
+		"descr": {vlanReq.Description},
+		"save":  {"Save"},
+	}
+
+	if vlanReq.Priority != nil {
+		v.Set("pcp", strconv.Itoa(*vlanReq.Priority))
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	vlans, err := pf.getVLANs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vlan, err := vlans.GetByParentAndTag(vlanReq.Parent, vlanReq.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return vlan, nil
+}
+
+func (pf *Client) CreateVLAN(ctx context.Context, vlanReq VLAN) (*VLAN, error) {
+	pf.mutexes.InterfaceVLAN.Lock()
+	defer pf.mutexes.InterfaceVLAN.Unlock()
+
+	vlan, err := pf.createOrUpdateVLAN(ctx, vlanReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w VLAN, %w", ErrCreateOperationFailed, err)
+	}
+
+	return vlan, nil
+}
+
+func (pf *Client) UpdateVLAN(ctx context.Context, vlanReq VLAN) (*VLAN, error) {
+	pf.mutexes.InterfaceVLAN.Lock()
+	defer pf.mutexes.InterfaceVLAN.Unlock()
+
+	vlans, err := pf.getVLANs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w VLAN, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := vlans.GetControlIDByParentAndTag(vlanReq.Parent, vlanReq.Tag)
+	if err != nil {
+		return nil, fmt.Errorf("%w VLAN, %w", ErrUpdateOperationFailed, err)
+	}
+
+	vlan, err := pf.createOrUpdateVLAN(ctx, vlanReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w VLAN, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return vlan, nil
+}
+
+func (pf *Client) DeleteVLAN(ctx context.Context, parent string, tag int) error {
+	pf.mutexes.InterfaceVLAN.Lock()
+	defer pf.mutexes.InterfaceVLAN.Unlock()
+
+	vlans, err := pf.getVLANs(ctx)
+	if err != nil {
+		return fmt.Errorf("%w VLAN, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := vlans.GetControlIDByParentAndTag(parent, tag)
+	if err != nil {
+		return fmt.Errorf("%w VLAN, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "interfaces_vlan.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w VLAN, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// deleting a VLAN assigned to an interface is refused with an input error
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w VLAN, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package pfsense
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseVLANsResponse(t *testing.T) {
+	vlans, err := parseVLANsResponse([]byte(`[
+		{"if": "igb0", "tag": "10", "pcp": "", "descr": "iot", "vlanif": "igb0.10"},
+		{"if": "lagg0", "tag": "20", "pcp": "5", "descr": "", "vlanif": "lagg0.20"}
+	]`))
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	vlan, err := vlans.GetByParentAndTag("igb0", 10)
+	if err != nil || vlan.Priority != nil || vlan.Description != "iot" || vlan.Interface != "igb0.10" {
+		t.Errorf("unexpected VLAN %+v (%v)", vlan, err)
+	}
+
+	vlan, err = vlans.GetByInterface("lagg0.20")
+	if err != nil || vlan.Priority == nil || *vlan.Priority != 5 {
+		t.Errorf("unexpected VLAN %+v (%v)", vlan, err)
+	}
+
+	if controlID, err := vlans.GetControlIDByParentAndTag("lagg0", 20); err != nil || *controlID != 1 {
+		t.Errorf("expected control ID 1, got %v (%v)", controlID, err)
+	}
+
+	if _, err := vlans.GetByParentAndTag("igb0", 20); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	for _, b := range []string{`[{"if": "igb0", "tag": "0"}]`, `[{"if": "igb0", "tag": "ten"}]`, `[{"if": "", "tag": "10"}]`} {
+		if _, err := parseVLANsResponse([]byte(b)); !errors.Is(err, ErrUnableToParse) {
+			t.Errorf("%s: expected parse error, got %v", b, err)
+		}
+	}
+}
@@ -0,0 +1,511 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const (
+	pfBlockerNGFeedStateEnabled  = "Enabled"
+	pfBlockerNGFeedStateDisabled = "Disabled"
+	pfBlockerNGFeedFormatAuto    = "auto"
+)
+
+var (
+	ErrPfBlockerNGNotInstalled = errors.New("pfBlockerNG package not installed")
+	ErrApplyPfBlockerNGChange  = errors.New("failed to apply pfBlockerNG changes")
+)
+
+var pfBlockerNGFeedConfigKeys = map[string]string{
+	"ipv4":  "pfblockernglistsv4",
+	"ipv6":  "pfblockernglistsv6",
+	"dnsbl": "pfblockerngdnsbl",
+}
+
+func PfBlockerNGFeedTypes() []string {
+	return []string{"ipv4", "ipv6", "dnsbl"}
+}
+
+func PfBlockerNGFeedActions(t string) []string {
+	if t == "dnsbl" {
+		return []string{"Disabled", "unbound"}
+	}
+
+	return []string{
+		"Disabled", "Deny_Inbound", "Deny_Outbound", "Deny_Both", "Permit_Inbound", "Permit_Outbound", "Permit_Both",
+		"Match_Inbound", "Match_Outbound", "Match_Both", "Alias_Deny", "Alias_Permit", "Alias_Match", "Alias_Native",
+	}
+}
+
+type pfBlockerNGFeedSourceResponse struct {
+	Format string `json:"format"`
+	State  string `json:"state"`
+	URL    string `json:"url"`
+	Header string `json:"header"`
+}
+
+type pfBlockerNGFeedResponse struct {
+	Type        string                          `json:"type,omitempty"`
+	Name        string                          `json:"aliasname"`
+	Description string                          `json:"description"`
+	Action      string                          `json:"action"`
+	Sources     []pfBlockerNGFeedSourceResponse `json:"row"`
+}
+
+type pfBlockerNGResponse struct {
+	Installed bool                      `json:"installed"`
+	Enable    string                    `json:"enable_cb"`
+	Feeds     []pfBlockerNGFeedResponse `json:"feeds"`
+}
+
+type PfBlockerNGSettings struct {
+	Enable bool
+}
+
+type PfBlockerFeed struct {
+	Type        string
+	Name        string
+	Description string
+	Action      string
+	Sources     []PfBlockerFeedSource
+}
+
+type PfBlockerFeedSource struct {
+	URL     string
+	Header  string
+	Enabled bool
+	// state and format as stored by pfBlockerNG, preserved on update as states other than enabled and disabled (e.g.
+	// hold and flex) and the format are not managed.
+	state  string
+	format string
+}
+
+func (s *PfBlockerNGSettings) SetEnable(enable bool) error {
+	s.Enable = enable
+
+	return nil
+}
+
+func (feed *PfBlockerFeed) SetType(t string) error {
+	if !slices.Contains(PfBlockerNGFeedTypes(), t) {
+		return fmt.Errorf("%w, feed type must be one of '%s'", ErrClientValidation, strings.Join(PfBlockerNGFeedTypes(), "', '"))
+	}
+
+	feed.Type = t
+
+	return nil
+}
+
+func (feed *PfBlockerFeed) SetName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, feed name must only consist of alphanumeric characters (with underscores)", ErrClientValidation)
+	}
+
+	feed.Name = name
+
+	return nil
+}
+
+func (feed *PfBlockerFeed) SetDescription(description string) error {
+	feed.Description = description
+
+	return nil
+}
+
+// SetAction expects the feed type to be set first, as the valid actions differ between IP and DNSBL feeds.
+func (feed *PfBlockerFeed) SetAction(action string) error {
+	if !slices.Contains(PfBlockerNGFeedActions(feed.Type), action) {
+		return fmt.Errorf("%w, feed action must be one of '%s'", ErrClientValidation, strings.Join(PfBlockerNGFeedActions(feed.Type), "', '"))
+	}
+
+	feed.Action = action
+
+	return nil
+}
+
+func (source *PfBlockerFeedSource) SetURL(u string) error {
+	if u == "" {
+		return fmt.Errorf("%w, feed source URL required", ErrClientValidation)
+	}
+
+	source.URL = u
+
+	return nil
+}
+
+func (source *PfBlockerFeedSource) SetHeader(header string) error {
+	var isValidHeader = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	if !isValidHeader(header) {
+		return fmt.Errorf("%w, feed source header must only consist of alphanumeric characters (with underscores)", ErrClientValidation)
+	}
+
+	source.Header = header
+
+	return nil
+}
+
+func (source *PfBlockerFeedSource) SetEnabled(enabled bool) error {
+	source.Enabled = enabled
+
+	return nil
+}
+
+// formatState returns the existing state when it matches whether the source is enabled, any state other than disabled
+// is considered enabled.
+func (source PfBlockerFeedSource) formatState(existing PfBlockerFeedSource) string {
+	if existing.state != "" && source.Enabled == (existing.state != pfBlockerNGFeedStateDisabled) {
+		return existing.state
+	}
+
+	if source.Enabled {
+		return pfBlockerNGFeedStateEnabled
+	}
+
+	return pfBlockerNGFeedStateDisabled
+}
+
+func (source PfBlockerFeedSource) formatFormat(existing PfBlockerFeedSource) string {
+	if existing.format != "" {
+		return existing.format
+	}
+
+	return pfBlockerNGFeedFormatAuto
+}
+
+type PfBlockerFeeds []PfBlockerFeed
+
+func (feeds PfBlockerFeeds) GetByName(t string, name string) (*PfBlockerFeed, error) {
+	for _, feed := range feeds {
+		if feed.Type == t && feed.Name == name {
+			return &feed, nil
+		}
+	}
+	return nil, fmt.Errorf("pfBlockerNG %s feed %w with name '%s'", t, ErrNotFound, name)
+}
+
+func parsePfBlockerNGResponse(b []byte) (*PfBlockerNGSettings, *PfBlockerFeeds, error) {
+	var resp pfBlockerNGResponse
+	err := json.Unmarshal(b, &resp)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	if !resp.Installed {
+		return nil, nil, ErrPfBlockerNGNotInstalled
+	}
+
+	var settings PfBlockerNGSettings
+	err = settings.SetEnable(resp.Enable == "on")
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w pfBlockerNG response, %w", ErrUnableToParse, err)
+	}
+
+	var feeds PfBlockerFeeds
+	for _, feedResp := range resp.Feeds {
+		var feed PfBlockerFeed
+		var err error
+
+		err = feed.SetType(feedResp.Type)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w pfBlockerNG feed response, %w", ErrUnableToParse, err)
+		}
+
+		// feeds may be managed outside of Terraform, the name, action, and source headers are only validated on write
+		feed.Name = feedResp.Name
+
+		err = feed.SetDescription(feedResp.Description)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w pfBlockerNG feed response, %w", ErrUnableToParse, err)
+		}
+
+		feed.Action = feedResp.Action
+
+		for _, sourceResp := range feedResp.Sources {
+			var source PfBlockerFeedSource
+			var err error
+
+			source.URL = sourceResp.URL
+			source.Header = sourceResp.Header
+			source.state = sourceResp.State
+			source.format = sourceResp.Format
+
+			err = source.SetEnabled(sourceResp.State != "" && sourceResp.State != pfBlockerNGFeedStateDisabled)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w pfBlockerNG feed response, %w", ErrUnableToParse, err)
+			}
+
+			feed.Sources = append(feed.Sources, source)
+		}
+
+		feeds = append(feeds, feed)
+	}
+
+	return &settings, &feeds, nil
+}
+
+func pfBlockerNGOutputCommand() string {
+	command := "$pkg = $config['installedpackages'];" +
+		"$output = array('installed' => is_array($pkg['pfblockerng']), 'enable_cb' => $pkg['pfblockerng']['config'][0]['enable_cb'], 'feeds' => array());" +
+		"foreach (array("
+
+	for _, t := range PfBlockerNGFeedTypes() {
+		command += fmt.Sprintf("'%s' => '%s',", t, pfBlockerNGFeedConfigKeys[t])
+	}
+
+	command += ") as $t => $key) {" +
+		"if (!is_array($pkg[$key]['config'])) { continue; }" +
+		"foreach ($pkg[$key]['config'] as $v) {" +
+		"if (!is_array($v['row'])) { $v['row'] = array(); }" +
+		"$v['type'] = $t; $output['feeds'][] = $v;" +
+		"}}" +
+		"print_r(json_encode($output));"
+
+	return command
+}
+
+func (pf *Client) getPfBlockerNG(ctx context.Context) (*PfBlockerNGSettings, *PfBlockerFeeds, error) {
+	b, err := pf.runPHPCommand(ctx, pfBlockerNGOutputCommand())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parsePfBlockerNGResponse(b)
+}
+
+// updatePfBlockerNG runs the given PHP statements against the pfBlockerNG package config in a single config write.
+// The statements have access to the decoded request ($req) and a reference to the installed packages ($pkg).
+func (pf *Client) updatePfBlockerNG(ctx context.Context, req any, statements string) (*PfBlockerNGSettings, *PfBlockerFeeds, error) {
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	command := fmt.Sprintf("$req = json_decode(base64_decode('%s'), true);", base64.StdEncoding.EncodeToString(reqJSON)) +
+		"$pkg = &$config['installedpackages'];" +
+		"if (is_array($pkg['pfblockerng'])) {" +
+		statements +
+		"write_config('Terraform: updated pfBlockerNG');" +
+		"}" +
+		"unset($pkg);" +
+		pfBlockerNGOutputCommand()
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return parsePfBlockerNGResponse(b)
+}
+
+func (pf *Client) GetPfBlockerNGSettings(ctx context.Context) (*PfBlockerNGSettings, error) {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	settings, _, err := pf.getPfBlockerNG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return settings, nil
+}
+
+func (pf *Client) UpdatePfBlockerNGSettings(ctx context.Context, settingsReq PfBlockerNGSettings) (*PfBlockerNGSettings, error) {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	enable := ""
+	if settingsReq.Enable {
+		enable = "on"
+	}
+
+	statements := "if (!is_array($pkg['pfblockerng']['config'][0])) { $pkg['pfblockerng']['config'][0] = array(); }" +
+		"$pkg['pfblockerng']['config'][0]['enable_cb'] = $req;"
+
+	settings, _, err := pf.updatePfBlockerNG(ctx, enable, statements)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return settings, nil
+}
+
+func (pf *Client) GetPfBlockerNGFeeds(ctx context.Context) (*PfBlockerFeeds, error) {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	_, feeds, err := pf.getPfBlockerNG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feeds, %w", ErrGetOperationFailed, err)
+	}
+
+	return feeds, nil
+}
+
+func (pf *Client) GetPfBlockerNGFeed(ctx context.Context, t string, name string) (*PfBlockerFeed, error) {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	_, feeds, err := pf.getPfBlockerNG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG %s feed (name '%s'), %w", ErrGetOperationFailed, t, name, err)
+	}
+
+	return feeds.GetByName(t, name)
+}
+
+func (pf *Client) createOrUpdatePfBlockerNGFeed(ctx context.Context, feedReq PfBlockerFeed, existing *PfBlockerFeed, create bool) (*PfBlockerFeed, error) {
+	type feedRequest struct {
+		Key    string                  `json:"key"`
+		Create bool                    `json:"create"`
+		Feed   pfBlockerNGFeedResponse `json:"feed"`
+	}
+
+	req := feedRequest{
+		Key:    pfBlockerNGFeedConfigKeys[feedReq.Type],
+		Create: create,
+		Feed: pfBlockerNGFeedResponse{
+			Name:        feedReq.Name,
+			Description: feedReq.Description,
+			Action:      feedReq.Action,
+			Sources:     []pfBlockerNGFeedSourceResponse{},
+		},
+	}
+
+	// existing sources are matched by URL (in order) to preserve their state and format
+	existingSources := map[string][]PfBlockerFeedSource{}
+	if existing != nil {
+		for _, source := range existing.Sources {
+			existingSources[source.URL] = append(existingSources[source.URL], source)
+		}
+	}
+
+	for _, source := range feedReq.Sources {
+		var existingSource PfBlockerFeedSource
+		if matches := existingSources[source.URL]; len(matches) > 0 {
+			existingSource = matches[0]
+			existingSources[source.URL] = matches[1:]
+		}
+
+		req.Feed.Sources = append(req.Feed.Sources, pfBlockerNGFeedSourceResponse{
+			Format: source.formatFormat(existingSource),
+			State:  source.formatState(existingSource),
+			URL:    source.URL,
+			Header: source.Header,
+		})
+	}
+
+	// existing feed settings not managed here (e.g. update frequency) are preserved
+	statements := "if (!is_array($pkg[$req['key']]['config'])) { $pkg[$req['key']]['config'] = array(); }" +
+		"$feeds = &$pkg[$req['key']]['config'];" +
+		"$found = false;" +
+		"foreach ($feeds as $k => $v) { if ($v['aliasname'] === $req['feed']['aliasname']) { $feeds[$k] = array_merge($v, $req['feed']); $found = true; } }" +
+		"if (!$found && $req['create']) { $feeds[] = array_merge(array('cron' => 'EveryDay'), $req['feed']); }"
+
+	_, feeds, err := pf.updatePfBlockerNG(ctx, req, statements)
+	if err != nil {
+		return nil, err
+	}
+
+	return feeds.GetByName(feedReq.Type, feedReq.Name)
+}
+
+func (pf *Client) CreatePfBlockerNGFeed(ctx context.Context, feedReq PfBlockerFeed) (*PfBlockerFeed, error) {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	_, feeds, err := pf.getPfBlockerNG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feed, %w", ErrCreateOperationFailed, err)
+	}
+
+	if _, err := feeds.GetByName(feedReq.Type, feedReq.Name); err == nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feed, %s feed with name '%s' already exists", ErrCreateOperationFailed, feedReq.Type, feedReq.Name)
+	}
+
+	feed, err := pf.createOrUpdatePfBlockerNGFeed(ctx, feedReq, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feed, %w", ErrCreateOperationFailed, err)
+	}
+
+	return feed, nil
+}
+
+func (pf *Client) UpdatePfBlockerNGFeed(ctx context.Context, feedReq PfBlockerFeed) (*PfBlockerFeed, error) {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	_, feeds, err := pf.getPfBlockerNG(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feed, %w", ErrUpdateOperationFailed, err)
+	}
+
+	existing, err := feeds.GetByName(feedReq.Type, feedReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feed, %w", ErrUpdateOperationFailed, err)
+	}
+
+	feed, err := pf.createOrUpdatePfBlockerNGFeed(ctx, feedReq, existing, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w pfBlockerNG feed, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return feed, nil
+}
+
+func (pf *Client) DeletePfBlockerNGFeed(ctx context.Context, t string, name string) error {
+	pf.mutexes.PfBlockerNG.Lock()
+	defer pf.mutexes.PfBlockerNG.Unlock()
+
+	var feed PfBlockerFeed
+	if err := feed.SetType(t); err != nil {
+		return fmt.Errorf("%w pfBlockerNG feed, %w", ErrDeleteOperationFailed, err)
+	}
+
+	req := map[string]string{
+		"key":       pfBlockerNGFeedConfigKeys[t],
+		"aliasname": name,
+	}
+
+	statements := "if (is_array($pkg[$req['key']]['config'])) {" +
+		"$pkg[$req['key']]['config'] = array_values(array_filter($pkg[$req['key']]['config'], function($v) use ($req) { return $v['aliasname'] !== $req['aliasname']; }));" +
+		"}"
+
+	_, feeds, err := pf.updatePfBlockerNG(ctx, req, statements)
+	if err != nil {
+		return fmt.Errorf("%w pfBlockerNG feed, %w", ErrDeleteOperationFailed, err)
+	}
+
+	if _, err := feeds.GetByName(t, name); err == nil {
+		return fmt.Errorf("%w pfBlockerNG feed, %s feed with name '%s' still exists", ErrDeleteOperationFailed, t, name)
+	}
+
+	return nil
+}
+
+// ApplyPfBlockerNGChanges runs a pfBlockerNG update, downloading feeds and reloading firewall and DNSBL config.
+func (pf *Client) ApplyPfBlockerNGChanges(ctx context.Context) error {
+	pf.mutexes.PfBlockerNGApply.Lock()
+	defer pf.mutexes.PfBlockerNGApply.Unlock()
+
+	u := url.URL{Path: "diag_command.php"}
+	v := url.Values{
+		"txtCommand": {"/usr/local/bin/php /usr/local/www/pfblockerng/pfblockerng.php update"},
+		"submit":     {"EXEC"},
+	}
+
+	_, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyPfBlockerNGChange, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type advancedAdminResponse struct {
+	DisableHTTPRedirect     *string `json:"disablehttpredirect"`
+	DisableHTTPRefererCheck *string `json:"nohttpreferercheck"`
+}
+
+type AdvancedAdmin struct {
+	DisableHTTPRedirect     bool
+	DisableHTTPRefererCheck bool
+}
+
+func (aa *AdvancedAdmin) SetDisableHTTPRedirect(disable bool) error {
+	aa.DisableHTTPRedirect = disable
+
+	return nil
+}
+
+func (aa *AdvancedAdmin) SetDisableHTTPRefererCheck(disable bool) error {
+	aa.DisableHTTPRefererCheck = disable
+
+	return nil
+}
+
+func (pf *Client) getSystemAdvancedAdmin(ctx context.Context) (*AdvancedAdmin, error) {
+	b, err := pf.getConfigJSON(ctx, "['system']")
+	if err != nil {
+		return nil, err
+	}
+
+	var system struct {
+		WebGUI advancedAdminResponse `json:"webgui"`
+	}
+
+	err = json.Unmarshal(b, &system)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var aa AdvancedAdmin
+
+	err = aa.SetDisableHTTPRedirect(system.WebGUI.DisableHTTPRedirect != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin response, %w", ErrUnableToParse, err)
+	}
+
+	err = aa.SetDisableHTTPRefererCheck(system.WebGUI.DisableHTTPRefererCheck != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin response, %w", ErrUnableToParse, err)
+	}
+
+	return &aa, nil
+}
+
+func (pf *Client) GetSystemAdvancedAdmin(ctx context.Context) (*AdvancedAdmin, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	aa, err := pf.getSystemAdvancedAdmin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return aa, nil
+}
+
+func (pf *Client) UpdateSystemAdvancedAdmin(ctx context.Context, aaReq AdvancedAdmin) (*AdvancedAdmin, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	u := url.URL{Path: "system_advanced_admin.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	setFormCheckbox(v, "webgui-redirect", aaReq.DisableHTTPRedirect)
+	setFormCheckbox(v, "nohttpreferercheck", aaReq.DisableHTTPRefererCheck)
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	aa, err := pf.getSystemAdvancedAdmin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced admin settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return aa, nil
+}
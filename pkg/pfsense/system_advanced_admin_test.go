@@ -0,0 +1,79 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestUpdateSystemAdvancedAdminRefererCheck(t *testing.T) {
+	var mutex sync.Mutex
+	webGUI := map[string]any{"protocol": "https"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_advanced_admin.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// pfSense only checks the referer when the header is sent, the provider never sends one so disabling or
+		// enabling the check does not affect its own session
+		if referer := r.Header.Get("Referer"); referer != "" {
+			t.Errorf("unexpected referer header '%s'", referer)
+		}
+
+		if r.Method == http.MethodPost {
+			if r.PostFormValue("webguiproto") != "https" {
+				t.Errorf("expected unmanaged setting to be submitted unchanged, got %v", r.PostForm)
+			}
+
+			delete(webGUI, "nohttpreferercheck")
+			if r.PostForm.Has("nohttpreferercheck") {
+				webGUI["nohttpreferercheck"] = ""
+			}
+
+			delete(webGUI, "disablehttpredirect")
+			if r.PostForm.Has("webgui-redirect") {
+				webGUI["disablehttpredirect"] = ""
+			}
+		}
+
+		fmt.Fprint(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="webguiproto" type="radio" value="http" />
+<input name="webguiproto" type="radio" value="https" checked="checked" />
+</form></body></html>`)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(map[string]any{"webgui": webGUI})
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	for _, disable := range []bool{true, false} {
+		var aaReq AdvancedAdmin
+		_ = aaReq.SetDisableHTTPRefererCheck(disable)
+
+		aa, err := pf.UpdateSystemAdvancedAdmin(context.Background(), aaReq)
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		if *aa != aaReq {
+			t.Errorf("disable referer check %t: read back %+v", disable, *aa)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+const advancedMiscDefaultPowerMode = "hadp"
+
+func AdvancedMiscCryptoHardwares() []string {
+	return []string{"aesni", "cryptodev", "aesni_cryptodev"}
+}
+
+func AdvancedMiscThermalHardwares() []string {
+	return []string{"coretemp", "amdtemp"}
+}
+
+func AdvancedMiscPowerModes() []string {
+	return []string{"hadp", "adp", "min", "max"}
+}
+
+type advancedMiscResponse struct {
+	CryptoHardware   string  `json:"crypto_hardware"`
+	ThermalHardware  string  `json:"thermal_hardware"`
+	PowerSaving      *string `json:"powerd_enable"`
+	PowerACMode      string  `json:"powerd_ac_mode"`
+	PowerBatteryMode string  `json:"powerd_battery_mode"`
+	PowerNormalMode  string  `json:"powerd_normal_mode"`
+}
+
+type AdvancedMisc struct {
+	CryptoHardware   string
+	ThermalHardware  string
+	PowerSaving      bool
+	PowerACMode      string
+	PowerBatteryMode string
+	PowerNormalMode  string
+}
+
+func (am *AdvancedMisc) SetCryptoHardware(cryptoHardware string) error {
+	if cryptoHardware != "" && !slices.Contains(AdvancedMiscCryptoHardwares(), cryptoHardware) {
+		return fmt.Errorf("%w, crypto hardware must be one of %v", ErrClientValidation, AdvancedMiscCryptoHardwares())
+	}
+
+	am.CryptoHardware = cryptoHardware
+
+	return nil
+}
+
+func (am *AdvancedMisc) SetThermalHardware(thermalHardware string) error {
+	if thermalHardware != "" && !slices.Contains(AdvancedMiscThermalHardwares(), thermalHardware) {
+		return fmt.Errorf("%w, thermal hardware must be one of %v", ErrClientValidation, AdvancedMiscThermalHardwares())
+	}
+
+	am.ThermalHardware = thermalHardware
+
+	return nil
+}
+
+func (am *AdvancedMisc) SetPowerSaving(powerSaving bool) error {
+	am.PowerSaving = powerSaving
+
+	return nil
+}
+
+func validatePowerMode(mode string) error {
+	if !slices.Contains(AdvancedMiscPowerModes(), mode) {
+		return fmt.Errorf("%w, power mode must be one of %v", ErrClientValidation, AdvancedMiscPowerModes())
+	}
+
+	return nil
+}
+
+func (am *AdvancedMisc) SetPowerACMode(mode string) error {
+	err := validatePowerMode(mode)
+	if err != nil {
+		return err
+	}
+
+	am.PowerACMode = mode
+
+	return nil
+}
+
+func (am *AdvancedMisc) SetPowerBatteryMode(mode string) error {
+	err := validatePowerMode(mode)
+	if err != nil {
+		return err
+	}
+
+	am.PowerBatteryMode = mode
+
+	return nil
+}
+
+func (am *AdvancedMisc) SetPowerNormalMode(mode string) error {
+	err := validatePowerMode(mode)
+	if err != nil {
+		return err
+	}
+
+	am.PowerNormalMode = mode
+
+	return nil
+}
+
+func defaultPowerMode(mode string) string {
+	if mode == "" {
+		return advancedMiscDefaultPowerMode
+	}
+
+	return mode
+}
+
+func (pf *Client) getSystemAdvancedMisc(ctx context.Context) (*AdvancedMisc, error) {
+	b, err := pf.getConfigJSON(ctx, "['system']")
+	if err != nil {
+		return nil, err
+	}
+
+	var amResp advancedMiscResponse
+	err = json.Unmarshal(b, &amResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var am AdvancedMisc
+
+	err = am.SetCryptoHardware(amResp.CryptoHardware)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc response, %w", ErrUnableToParse, err)
+	}
+
+	err = am.SetThermalHardware(amResp.ThermalHardware)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc response, %w", ErrUnableToParse, err)
+	}
+
+	err = am.SetPowerSaving(amResp.PowerSaving != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc response, %w", ErrUnableToParse, err)
+	}
+
+	err = am.SetPowerACMode(defaultPowerMode(amResp.PowerACMode))
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc response, %w", ErrUnableToParse, err)
+	}
+
+	err = am.SetPowerBatteryMode(defaultPowerMode(amResp.PowerBatteryMode))
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc response, %w", ErrUnableToParse, err)
+	}
+
+	err = am.SetPowerNormalMode(defaultPowerMode(amResp.PowerNormalMode))
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc response, %w", ErrUnableToParse, err)
+	}
+
+	return &am, nil
+}
+
+func (pf *Client) GetSystemAdvancedMisc(ctx context.Context) (*AdvancedMisc, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	am, err := pf.getSystemAdvancedMisc(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return am, nil
+}
+
+func (pf *Client) UpdateSystemAdvancedMisc(ctx context.Context, amReq AdvancedMisc) (*AdvancedMisc, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	u := url.URL{Path: "system_advanced_misc.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = validateHardwareModules(doc, amReq.CryptoHardware, amReq.ThermalHardware)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v.Set("crypto_hardware", amReq.CryptoHardware)
+	v.Set("thermal_hardware", amReq.ThermalHardware)
+	v.Set("powerd_ac_mode", amReq.PowerACMode)
+	v.Set("powerd_battery_mode", amReq.PowerBatteryMode)
+	v.Set("powerd_normal_mode", amReq.PowerNormalMode)
+	v.Set("save", "Save")
+
+	if amReq.PowerSaving {
+		v.Set("powerd_enable", "yes")
+	} else {
+		v.Del("powerd_enable")
+	}
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	am, err := pf.getSystemAdvancedMisc(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced misc settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return am, nil
+}
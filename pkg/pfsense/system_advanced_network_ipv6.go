@@ -0,0 +1,105 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type advancedIPv6Response struct {
+	AllowIPv6  *string `json:"ipv6allow"`
+	PreferIPv4 *string `json:"prefer_ipv4"`
+}
+
+type AdvancedIPv6 struct {
+	AllowIPv6  bool
+	PreferIPv4 bool
+}
+
+func (a *AdvancedIPv6) SetAllowIPv6(allow bool) error {
+	a.AllowIPv6 = allow
+
+	return nil
+}
+
+func (a *AdvancedIPv6) SetPreferIPv4(prefer bool) error {
+	a.PreferIPv4 = prefer
+
+	return nil
+}
+
+func (pf *Client) getSystemAdvancedNetworkIPv6(ctx context.Context) (*AdvancedIPv6, error) {
+	b, err := pf.getConfigJSON(ctx, "['system']")
+	if err != nil {
+		return nil, err
+	}
+
+	var aResp advancedIPv6Response
+	err = json.Unmarshal(b, &aResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var a AdvancedIPv6
+
+	err = a.SetAllowIPv6(aResp.AllowIPv6 != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 response, %w", ErrUnableToParse, err)
+	}
+
+	err = a.SetPreferIPv4(aResp.PreferIPv4 != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 response, %w", ErrUnableToParse, err)
+	}
+
+	return &a, nil
+}
+
+func (pf *Client) GetSystemAdvancedNetworkIPv6(ctx context.Context) (*AdvancedIPv6, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	a, err := pf.getSystemAdvancedNetworkIPv6(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return a, nil
+}
+
+func (pf *Client) UpdateSystemAdvancedNetworkIPv6(ctx context.Context, aReq AdvancedIPv6) (*AdvancedIPv6, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	u := url.URL{Path: "system_advanced_network.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	setFormCheckbox(v, "ipv6allow", aReq.AllowIPv6)
+	setFormCheckbox(v, "prefer_ipv4", aReq.PreferIPv4)
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	a, err := pf.getSystemAdvancedNetworkIPv6(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w advanced IPv6 settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return a, nil
+}
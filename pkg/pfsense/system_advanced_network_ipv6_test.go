@@ -0,0 +1,83 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestUpdateSystemAdvancedNetworkIPv6AllowToggle(t *testing.T) {
+	var mutex sync.Mutex
+	config := map[string]string{"hostname": "pfsense", "ipv6allow": "", "ipv6nat_enable": ""}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_advanced_network.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+
+			if !r.PostForm.Has("ipv6nat_enable") {
+				t.Errorf("expected unmanaged network settings to be submitted unchanged, got %v", r.PostForm)
+			}
+
+			for _, key := range []string{"ipv6allow", "prefer_ipv4"} {
+				delete(config, key)
+				if r.PostForm.Has(key) {
+					config[key] = ""
+				}
+			}
+		}
+
+		fmt.Fprint(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="ipv6nat_enable" type="checkbox" value="yes" checked="checked" />
+<input name="ipv6allow" type="checkbox" value="yes" checked="checked" />
+<input name="prefer_ipv4" type="checkbox" value="yes" />
+</form></body></html>`)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(config)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	a, err := pf.GetSystemAdvancedNetworkIPv6(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if !a.AllowIPv6 || a.PreferIPv4 {
+		t.Errorf("read %+v, want IPv6 allowed and IPv4 not preferred", *a)
+	}
+
+	for _, allow := range []bool{false, true} {
+		var aReq AdvancedIPv6
+		_ = aReq.SetAllowIPv6(allow)
+		_ = aReq.SetPreferIPv4(true)
+
+		a, err := pf.UpdateSystemAdvancedNetworkIPv6(context.Background(), aReq)
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		if *a != aReq {
+			t.Errorf("read back %+v, want %+v", *a, aReq)
+		}
+	}
+}
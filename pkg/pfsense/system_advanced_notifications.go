@@ -0,0 +1,312 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	notificationsDefaultSMTPPort          = 25
+	notificationsDefaultSMTPAuthMechanism = "PLAIN"
+)
+
+func NotificationsSMTPAuthMechanisms() []string {
+	return []string{"PLAIN", "LOGIN"}
+}
+
+type notificationsResponse struct {
+	SMTP struct {
+		Disable       *string `json:"disable"`
+		Server        string  `json:"ipaddress"`
+		Port          string  `json:"port"`
+		Secure        *string `json:"ssl"`
+		ValidateCert  string  `json:"sslvalidate"`
+		From          string  `json:"fromaddress"`
+		To            string  `json:"notifyemailaddress"`
+		Username      string  `json:"username"`
+		Password      string  `json:"password"`
+		AuthMechanism string  `json:"authentication_mechanism"`
+	} `json:"smtp"`
+	Telegram struct {
+		Enabled *string `json:"enabled"`
+		APIKey  string  `json:"api"`
+		ChatID  string  `json:"chatid"`
+	} `json:"telegram"`
+}
+
+type Notifications struct {
+	SMTPDisabled      bool
+	SMTPServer        string
+	SMTPPort          int
+	SMTPSecure        bool
+	SMTPValidateCert  bool
+	SMTPFrom          string
+	SMTPTo            string
+	SMTPUsername      string
+	SMTPPassword      string
+	SMTPAuthMechanism string
+	TelegramEnabled   bool
+	TelegramAPIKey    string
+	TelegramChatID    string
+}
+
+func (n *Notifications) SetSMTPDisabled(disabled bool) error {
+	n.SMTPDisabled = disabled
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPServer(server string) error {
+	var isValidHostname = regexp.MustCompile(`^([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`).MatchString
+	if server != "" {
+		if _, err := netip.ParseAddr(server); err != nil && !isValidHostname(server) {
+			return fmt.Errorf("%w, SMTP server must be an IP address or hostname", ErrClientValidation)
+		}
+	}
+
+	n.SMTPServer = server
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPPort(port int) error {
+	if err := validatePort(port); err != nil {
+		return err
+	}
+
+	n.SMTPPort = port
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPSecure(secure bool) error {
+	n.SMTPSecure = secure
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPValidateCert(validate bool) error {
+	n.SMTPValidateCert = validate
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPFrom(from string) error {
+	n.SMTPFrom = from
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPTo(to string) error {
+	n.SMTPTo = to
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPUsername(username string) error {
+	n.SMTPUsername = username
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPPassword(password string) error {
+	n.SMTPPassword = password
+
+	return nil
+}
+
+func (n *Notifications) SetSMTPAuthMechanism(mechanism string) error {
+	if !slices.Contains(NotificationsSMTPAuthMechanisms(), mechanism) {
+		return fmt.Errorf("%w, SMTP authentication mechanism must be one of '%s'", ErrClientValidation, strings.Join(NotificationsSMTPAuthMechanisms(), "', '"))
+	}
+
+	n.SMTPAuthMechanism = mechanism
+
+	return nil
+}
+
+func (n *Notifications) SetTelegramEnabled(enabled bool) error {
+	n.TelegramEnabled = enabled
+
+	return nil
+}
+
+func (n *Notifications) SetTelegramAPIKey(apiKey string) error {
+	n.TelegramAPIKey = apiKey
+
+	return nil
+}
+
+func (n *Notifications) SetTelegramChatID(chatID string) error {
+	n.TelegramChatID = chatID
+
+	return nil
+}
+
+func (pf *Client) getSystemAdvancedNotifications(ctx context.Context) (*Notifications, error) {
+	command := "$output = array('smtp' => new stdClass(), 'telegram' => new stdClass());" +
+		"if (is_array($config['notifications']['smtp'])) { $output['smtp'] = $config['notifications']['smtp']; }" +
+		"if (is_array($config['notifications']['telegram'])) { $output['telegram'] = $config['notifications']['telegram']; }" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var nResp notificationsResponse
+	err = json.Unmarshal(b, &nResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var n Notifications
+
+	err = n.SetSMTPDisabled(nResp.SMTP.Disable != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPServer(nResp.SMTP.Server)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	port := notificationsDefaultSMTPPort
+	if nResp.SMTP.Port != "" {
+		port, err = strconv.Atoi(nResp.SMTP.Port)
+		if err != nil {
+			return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+		}
+	}
+
+	err = n.SetSMTPPort(port)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPSecure(nResp.SMTP.Secure != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPValidateCert(nResp.SMTP.ValidateCert != "disabled")
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPFrom(nResp.SMTP.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPTo(nResp.SMTP.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPUsername(nResp.SMTP.Username)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetSMTPPassword(nResp.SMTP.Password)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	mechanism := notificationsDefaultSMTPAuthMechanism
+	if nResp.SMTP.AuthMechanism != "" {
+		mechanism = nResp.SMTP.AuthMechanism
+	}
+
+	err = n.SetSMTPAuthMechanism(mechanism)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetTelegramEnabled(nResp.Telegram.Enabled != nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetTelegramAPIKey(nResp.Telegram.APIKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	err = n.SetTelegramChatID(nResp.Telegram.ChatID)
+	if err != nil {
+		return nil, fmt.Errorf("%w notifications response, %w", ErrUnableToParse, err)
+	}
+
+	return &n, nil
+}
+
+func (pf *Client) GetSystemAdvancedNotifications(ctx context.Context) (*Notifications, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	n, err := pf.getSystemAdvancedNotifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w notification settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return n, nil
+}
+
+func (pf *Client) UpdateSystemAdvancedNotifications(ctx context.Context, nReq Notifications) (*Notifications, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	u := url.URL{Path: "system_advanced_notifications.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w notification settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v.Set("smtpipaddress", nReq.SMTPServer)
+	v.Set("smtpport", strconv.Itoa(nReq.SMTPPort))
+	v.Set("smtpfromaddress", nReq.SMTPFrom)
+	v.Set("smtpnotifyemailaddress", nReq.SMTPTo)
+	v.Set("smtpusername", nReq.SMTPUsername)
+	v.Set("smtppassword", nReq.SMTPPassword)
+	v.Set("smtppassword_confirm", nReq.SMTPPassword)
+	v.Set("smtpauthmech", nReq.SMTPAuthMechanism)
+	v.Set("api", nReq.TelegramAPIKey)
+	v.Set("chatid", nReq.TelegramChatID)
+	v.Set("save", "Save")
+
+	setFormCheckbox(v, "disable_smtp", nReq.SMTPDisabled)
+	setFormCheckbox(v, "smtpssl", nReq.SMTPSecure)
+	setFormCheckbox(v, "sslvalidate", nReq.SMTPValidateCert)
+	setFormCheckbox(v, "enable_telegram", nReq.TelegramEnabled)
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w notification settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w notification settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	n, err := pf.getSystemAdvancedNotifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w notification settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return n, nil
+}
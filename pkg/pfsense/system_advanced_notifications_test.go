@@ -0,0 +1,155 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestNotificationsSetSMTPServer(t *testing.T) {
+	tests := []struct {
+		server string
+		valid  bool
+	}{
+		{"", true},
+		{"smtp.example.com", true},
+		{"mail", true},
+		{"192.0.2.25", true},
+		{"2001:db8::25", true},
+		{"smtp.example.com:587", false},
+		{"-smtp.example.com", false},
+		{"smtp example.com", false},
+	}
+
+	for _, tt := range tests {
+		var n Notifications
+
+		err := n.SetSMTPServer(tt.server)
+		if tt.valid && err != nil {
+			t.Errorf("SetSMTPServer(%q) unexpected error, %s", tt.server, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetSMTPServer(%q) expected client validation error, got %v", tt.server, err)
+		}
+	}
+}
+
+func TestNotificationsSetSMTPPortAndAuthMechanism(t *testing.T) {
+	var n Notifications
+
+	for _, port := range []int{0, 65536} {
+		if err := n.SetSMTPPort(port); !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetSMTPPort(%d) expected client validation error, got %v", port, err)
+		}
+	}
+
+	if err := n.SetSMTPAuthMechanism("CRAM-MD5"); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("SetSMTPAuthMechanism expected client validation error, got %v", err)
+	}
+}
+
+func TestUpdateSystemAdvancedNotificationsSMTP(t *testing.T) {
+	smtp := map[string]any{}
+	var posted url.Values
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_advanced_notifications.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+			posted = r.PostForm
+
+			// stored the way the page saves the SMTP section
+			smtp = map[string]any{
+				"ipaddress":                r.PostFormValue("smtpipaddress"),
+				"port":                     r.PostFormValue("smtpport"),
+				"fromaddress":              r.PostFormValue("smtpfromaddress"),
+				"notifyemailaddress":       r.PostFormValue("smtpnotifyemailaddress"),
+				"username":                 r.PostFormValue("smtpusername"),
+				"password":                 r.PostFormValue("smtppassword"),
+				"authentication_mechanism": r.PostFormValue("smtpauthmech"),
+				"sslvalidate":              "disabled",
+			}
+
+			if r.PostForm.Has("smtpssl") {
+				smtp["ssl"] = ""
+			}
+
+			if r.PostForm.Has("sslvalidate") {
+				smtp["sslvalidate"] = "enabled"
+			}
+
+			if r.PostForm.Has("disable_smtp") {
+				smtp["disable"] = ""
+			}
+		}
+
+		fmt.Fprint(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="cert_days" type="text" value="27" />
+<input name="smtpipaddress" type="text" value="" />
+</form></body></html>`)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		b, _ := json.Marshal(map[string]any{"smtp": smtp, "telegram": map[string]any{}})
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var nReq Notifications
+	_ = nReq.SetSMTPServer("smtp.example.com")
+	_ = nReq.SetSMTPPort(587)
+	_ = nReq.SetSMTPSecure(true)
+	_ = nReq.SetSMTPValidateCert(false)
+	_ = nReq.SetSMTPFrom("pfsense@example.com")
+	_ = nReq.SetSMTPTo("admin@example.com")
+	_ = nReq.SetSMTPUsername("pfsense")
+	_ = nReq.SetSMTPPassword("secret")
+	_ = nReq.SetSMTPAuthMechanism("LOGIN")
+
+	n, err := pf.UpdateSystemAdvancedNotifications(context.Background(), nReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if *n != nReq {
+		t.Errorf("read back %+v, want %+v", *n, nReq)
+	}
+
+	// the password is confirmed and unmanaged settings on the page are submitted unchanged
+	for key, want := range map[string]string{"smtppassword_confirm": "secret", "cert_days": "27", "save": "Save"} {
+		if got := posted.Get(key); got != want {
+			t.Errorf("posted %s = %q, want %q", key, got, want)
+		}
+	}
+
+	for _, key := range []string{"sslvalidate", "disable_smtp", "enable_telegram"} {
+		if posted.Has(key) {
+			t.Errorf("expected unchecked box '%s' to be omitted", key)
+		}
+	}
+
+	// defaults apply when the SMTP section was never saved
+	smtp = map[string]any{}
+
+	n, err = pf.GetSystemAdvancedNotifications(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if n.SMTPPort != notificationsDefaultSMTPPort || n.SMTPAuthMechanism != notificationsDefaultSMTPAuthMechanism || !n.SMTPValidateCert {
+		t.Errorf("expected defaults, got %+v", *n)
+	}
+}
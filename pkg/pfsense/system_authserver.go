@@ -0,0 +1,680 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	authServerTypeLDAP   = "ldap"
+	authServerTypeRADIUS = "radius"
+)
+
+func AuthServerTypes() []string {
+	return []string{authServerTypeLDAP, authServerTypeRADIUS}
+}
+
+func AuthServerLDAPTransports() []string {
+	return []string{"Standard TCP", "STARTTLS Encrypted", "SSL/TLS Encrypted"}
+}
+
+func AuthServerLDAPScopes() []string {
+	return []string{"one", "subtree"}
+}
+
+func AuthServerRADIUSProtocols() []string {
+	return []string{"PAP", "CHAP_MD5", "MSCHAPv1", "MSCHAPv2"}
+}
+
+type authServerResponse struct {
+	Name                 string `json:"name"`
+	Type                 string `json:"type"`
+	Host                 string `json:"host"`
+	LDAPPort             string `json:"ldap_port"`
+	LDAPTransport        string `json:"ldap_urltype"`
+	LDAPScope            string `json:"ldap_scope"`
+	LDAPBaseDN           string `json:"ldap_basedn"`
+	LDAPAuthContainers   string `json:"ldap_authcn"`
+	LDAPBindDN           string `json:"ldap_binddn"`
+	LDAPBindPassword     string `json:"ldap_bindpw"`
+	LDAPUserAttribute    string `json:"ldap_attr_user"`
+	LDAPGroupAttribute   string `json:"ldap_attr_group"`
+	LDAPMemberAttribute  string `json:"ldap_attr_member"`
+	LDAPTimeout          string `json:"ldap_timeout"`
+	RADIUSSecret         string `json:"radius_secret"`
+	RADIUSProtocol       string `json:"radius_protocol"`
+	RADIUSAuthPort       string `json:"radius_auth_port"`
+	RADIUSAcctPort       string `json:"radius_acct_port"`
+	RADIUSTimeout        string `json:"radius_timeout"`
+	RADIUSNASIPAttribute string `json:"radius_nasip_attribute"`
+}
+
+type AuthServer struct {
+	Name   string
+	Type   string
+	Host   string
+	LDAP   *AuthServerLDAP
+	RADIUS *AuthServerRADIUS
+}
+
+type AuthServerLDAP struct {
+	Port            int
+	Transport       string
+	Scope           string
+	BaseDN          string
+	AuthContainers  string
+	BindDN          string
+	BindPassword    string
+	UserAttribute   string
+	GroupAttribute  string
+	MemberAttribute string
+	Timeout         int
+}
+
+type AuthServerRADIUS struct {
+	Secret         string
+	Protocol       string
+	AuthPort       int
+	AcctPort       int
+	Timeout        int
+	NASIPAttribute string
+}
+
+func (as AuthServer) formatServices() string {
+	switch {
+	case as.RADIUS.AuthPort != 0 && as.RADIUS.AcctPort != 0:
+		return "both"
+	case as.RADIUS.AcctPort != 0:
+		return "acct"
+	default:
+		return "auth"
+	}
+}
+
+func formatOptionalInt(i int) string {
+	if i == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(i)
+}
+
+func parseOptionalInt(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(s)
+}
+
+func validatePort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("%w, port must be between 1 and 65535", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (as *AuthServer) SetName(name string) error {
+	if name == "" {
+		return fmt.Errorf("%w, authentication server name required", ErrClientValidation)
+	}
+
+	as.Name = name
+
+	return nil
+}
+
+func (as *AuthServer) SetType(t string) error {
+	if !slices.Contains(AuthServerTypes(), t) {
+		return fmt.Errorf("%w, authentication server type must be one of '%s'", ErrClientValidation, strings.Join(AuthServerTypes(), "', '"))
+	}
+
+	as.Type = t
+
+	return nil
+}
+
+func (as *AuthServer) SetHost(host string) error {
+	var isValidHostname = regexp.MustCompile(`^([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`).MatchString
+	if _, err := netip.ParseAddr(host); err != nil && !isValidHostname(host) {
+		return fmt.Errorf("%w, authentication server host must be an IP address or hostname", ErrClientValidation)
+	}
+
+	as.Host = host
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetPort(port int) error {
+	if err := validatePort(port); err != nil {
+		return err
+	}
+
+	ldap.Port = port
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetTransport(transport string) error {
+	if !slices.Contains(AuthServerLDAPTransports(), transport) {
+		return fmt.Errorf("%w, LDAP transport must be one of '%s'", ErrClientValidation, strings.Join(AuthServerLDAPTransports(), "', '"))
+	}
+
+	ldap.Transport = transport
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetScope(scope string) error {
+	if !slices.Contains(AuthServerLDAPScopes(), scope) {
+		return fmt.Errorf("%w, LDAP scope must be one of '%s'", ErrClientValidation, strings.Join(AuthServerLDAPScopes(), "', '"))
+	}
+
+	ldap.Scope = scope
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetBaseDN(baseDN string) error {
+	ldap.BaseDN = baseDN
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetAuthContainers(authContainers string) error {
+	if authContainers == "" {
+		return fmt.Errorf("%w, LDAP authentication containers required", ErrClientValidation)
+	}
+
+	ldap.AuthContainers = authContainers
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetBindDN(bindDN string) error {
+	ldap.BindDN = bindDN
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetBindPassword(bindPassword string) error {
+	ldap.BindPassword = bindPassword
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetUserAttribute(attr string) error {
+	if attr == "" {
+		return fmt.Errorf("%w, LDAP user naming attribute required", ErrClientValidation)
+	}
+
+	ldap.UserAttribute = attr
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetGroupAttribute(attr string) error {
+	if attr == "" {
+		return fmt.Errorf("%w, LDAP group naming attribute required", ErrClientValidation)
+	}
+
+	ldap.GroupAttribute = attr
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetMemberAttribute(attr string) error {
+	if attr == "" {
+		return fmt.Errorf("%w, LDAP group member attribute required", ErrClientValidation)
+	}
+
+	ldap.MemberAttribute = attr
+
+	return nil
+}
+
+func (ldap *AuthServerLDAP) SetTimeout(timeout int) error {
+	if timeout < 1 {
+		return fmt.Errorf("%w, LDAP timeout must be a positive number of seconds", ErrClientValidation)
+	}
+
+	ldap.Timeout = timeout
+
+	return nil
+}
+
+func (radius *AuthServerRADIUS) SetSecret(secret string) error {
+	if secret == "" {
+		return fmt.Errorf("%w, RADIUS shared secret required", ErrClientValidation)
+	}
+
+	radius.Secret = secret
+
+	return nil
+}
+
+func (radius *AuthServerRADIUS) SetProtocol(protocol string) error {
+	if !slices.Contains(AuthServerRADIUSProtocols(), protocol) {
+		return fmt.Errorf("%w, RADIUS protocol must be one of '%s'", ErrClientValidation, strings.Join(AuthServerRADIUSProtocols(), "', '"))
+	}
+
+	radius.Protocol = protocol
+
+	return nil
+}
+
+// SetAuthPort accepts 0 to disable authentication.
+func (radius *AuthServerRADIUS) SetAuthPort(port int) error {
+	if port != 0 {
+		if err := validatePort(port); err != nil {
+			return err
+		}
+	}
+
+	radius.AuthPort = port
+
+	return nil
+}
+
+// SetAcctPort accepts 0 to disable accounting.
+func (radius *AuthServerRADIUS) SetAcctPort(port int) error {
+	if port != 0 {
+		if err := validatePort(port); err != nil {
+			return err
+		}
+	}
+
+	radius.AcctPort = port
+
+	return nil
+}
+
+func (radius *AuthServerRADIUS) SetTimeout(timeout int) error {
+	if timeout < 1 {
+		return fmt.Errorf("%w, RADIUS timeout must be a positive number of seconds", ErrClientValidation)
+	}
+
+	radius.Timeout = timeout
+
+	return nil
+}
+
+func (radius *AuthServerRADIUS) SetNASIPAttribute(nasIPAttribute string) error {
+	if nasIPAttribute == "" {
+		return fmt.Errorf("%w, RADIUS NAS IP attribute interface required", ErrClientValidation)
+	}
+
+	radius.NASIPAttribute = nasIPAttribute
+
+	return nil
+}
+
+type AuthServers []AuthServer
+
+func (ass AuthServers) GetByName(name string) (*AuthServer, error) {
+	for _, as := range ass {
+		if as.Name == name {
+			return &as, nil
+		}
+	}
+	return nil, fmt.Errorf("authentication server %w with name '%s'", ErrNotFound, name)
+}
+
+func (ass AuthServers) GetControlIDByName(name string) (*int, error) {
+	return getControlID(ass, func(as AuthServer) bool { return as.Name == name },
+		positionalControlID, "authentication server", fmt.Sprintf("name '%s'", name))
+}
+
+func parseAuthServerResponse(resp authServerResponse) (*AuthServer, error) {
+	var authServer AuthServer
+	var err error
+
+	err = authServer.SetName(resp.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authServer.SetType(resp.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	err = authServer.SetHost(resp.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	switch authServer.Type {
+	case authServerTypeLDAP:
+		var ldap AuthServerLDAP
+
+		port, err := strconv.Atoi(resp.LDAPPort)
+		if err != nil {
+			return nil, err
+		}
+
+		timeout, err := parseOptionalInt(resp.LDAPTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		// pfSense treats a missing timeout as the default of 25 seconds
+		if timeout == 0 {
+			timeout = 25
+		}
+
+		err = ldap.SetPort(port)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetTransport(resp.LDAPTransport)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetScope(resp.LDAPScope)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetBaseDN(resp.LDAPBaseDN)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetAuthContainers(resp.LDAPAuthContainers)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetBindDN(resp.LDAPBindDN)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetBindPassword(resp.LDAPBindPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetUserAttribute(resp.LDAPUserAttribute)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetGroupAttribute(resp.LDAPGroupAttribute)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetMemberAttribute(resp.LDAPMemberAttribute)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ldap.SetTimeout(timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		authServer.LDAP = &ldap
+	case authServerTypeRADIUS:
+		var radius AuthServerRADIUS
+
+		authPort, err := parseOptionalInt(resp.RADIUSAuthPort)
+		if err != nil {
+			return nil, err
+		}
+
+		acctPort, err := parseOptionalInt(resp.RADIUSAcctPort)
+		if err != nil {
+			return nil, err
+		}
+
+		timeout, err := parseOptionalInt(resp.RADIUSTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		// pfSense treats a missing timeout as the default of 5 seconds
+		if timeout == 0 {
+			timeout = 5
+		}
+
+		err = radius.SetSecret(resp.RADIUSSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		err = radius.SetProtocol(resp.RADIUSProtocol)
+		if err != nil {
+			return nil, err
+		}
+
+		err = radius.SetAuthPort(authPort)
+		if err != nil {
+			return nil, err
+		}
+
+		err = radius.SetAcctPort(acctPort)
+		if err != nil {
+			return nil, err
+		}
+
+		err = radius.SetTimeout(timeout)
+		if err != nil {
+			return nil, err
+		}
+
+		err = radius.SetNASIPAttribute(resp.RADIUSNASIPAttribute)
+		if err != nil {
+			return nil, err
+		}
+
+		authServer.RADIUS = &radius
+	}
+
+	return &authServer, nil
+}
+
+func (pf *Client) getSystemAuthServers(ctx context.Context) (*AuthServers, error) {
+	b, err := pf.getConfigJSON(ctx, "['system']['authserver']")
+	if err != nil {
+		return nil, err
+	}
+
+	var asResp []authServerResponse
+	err = json.Unmarshal(b, &asResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var authServers AuthServers
+	for _, resp := range asResp {
+		authServer, err := parseAuthServerResponse(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w authentication server response, %w", ErrUnableToParse, err)
+		}
+
+		authServers = append(authServers, *authServer)
+	}
+
+	return &authServers, nil
+}
+
+func (pf *Client) GetSystemAuthServers(ctx context.Context) (*AuthServers, error) {
+	pf.mutexes.SystemAuthServer.Lock()
+	defer pf.mutexes.SystemAuthServer.Unlock()
+
+	authServers, err := pf.getSystemAuthServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w authentication servers, %w", ErrGetOperationFailed, err)
+	}
+
+	return authServers, nil
+}
+
+func (pf *Client) GetSystemAuthServer(ctx context.Context, name string) (*AuthServer, error) {
+	pf.mutexes.SystemAuthServer.Lock()
+	defer pf.mutexes.SystemAuthServer.Unlock()
+
+	authServers, err := pf.getSystemAuthServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w authentication server (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return authServers.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateSystemAuthServer(ctx context.Context, authServerReq AuthServer, controlID *int) (*AuthServer, error) {
+	u := url.URL{Path: "system_authservers.php"}
+	v := url.Values{
+		"name": {authServerReq.Name},
+		"type": {authServerReq.Type},
+		"save": {"Save"},
+	}
+
+	switch authServerReq.Type {
+	case authServerTypeLDAP:
+		ldap := authServerReq.LDAP
+		v.Set("ldap_host", authServerReq.Host)
+		v.Set("ldap_port", strconv.Itoa(ldap.Port))
+		v.Set("ldap_urltype", ldap.Transport)
+		v.Set("ldap_protver", "3")
+		v.Set("ldap_timeout", strconv.Itoa(ldap.Timeout))
+		v.Set("ldap_scope", ldap.Scope)
+		v.Set("ldap_basedn", ldap.BaseDN)
+		v.Set("ldapauthcontainers", ldap.AuthContainers)
+		v.Set("ldap_attr_user", ldap.UserAttribute)
+		v.Set("ldap_attr_group", ldap.GroupAttribute)
+		v.Set("ldap_attr_member", ldap.MemberAttribute)
+		v.Set("ldap_caref", "global")
+
+		if ldap.BindDN == "" {
+			v.Set("ldap_anon", "yes")
+		} else {
+			v.Set("ldap_binddn", ldap.BindDN)
+			v.Set("ldap_bindpw", ldap.BindPassword)
+		}
+	case authServerTypeRADIUS:
+		radius := authServerReq.RADIUS
+		v.Set("radius_host", authServerReq.Host)
+		v.Set("radius_secret", radius.Secret)
+		v.Set("radius_secret_confirm", radius.Secret)
+		v.Set("radius_protocol", radius.Protocol)
+		v.Set("radius_srvcs", authServerReq.formatServices())
+		v.Set("radius_auth_port", formatOptionalInt(radius.AuthPort))
+		v.Set("radius_acct_port", formatOptionalInt(radius.AcctPort))
+		v.Set("radius_timeout", strconv.Itoa(radius.Timeout))
+		v.Set("radius_nasip_attribute", radius.NASIPAttribute)
+	}
+
+	q := u.Query()
+	if controlID != nil {
+		q.Set("act", "edit")
+		q.Set("id", strconv.Itoa(*controlID))
+	} else {
+		q.Set("act", "new")
+	}
+	u.RawQuery = q.Encode()
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	authServers, err := pf.getSystemAuthServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	authServer, err := authServers.GetByName(authServerReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return authServer, nil
+}
+
+func (pf *Client) CreateSystemAuthServer(ctx context.Context, authServerReq AuthServer) (*AuthServer, error) {
+	pf.mutexes.SystemAuthServer.Lock()
+	defer pf.mutexes.SystemAuthServer.Unlock()
+
+	authServer, err := pf.createOrUpdateSystemAuthServer(ctx, authServerReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w authentication server, %w", ErrCreateOperationFailed, err)
+	}
+
+	return authServer, nil
+}
+
+func (pf *Client) UpdateSystemAuthServer(ctx context.Context, authServerReq AuthServer) (*AuthServer, error) {
+	pf.mutexes.SystemAuthServer.Lock()
+	defer pf.mutexes.SystemAuthServer.Unlock()
+
+	authServers, err := pf.getSystemAuthServers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w authentication server, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := authServers.GetControlIDByName(authServerReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w authentication server, %w", ErrUpdateOperationFailed, err)
+	}
+
+	authServer, err := pf.createOrUpdateSystemAuthServer(ctx, authServerReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w authentication server, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return authServer, nil
+}
+
+func (pf *Client) DeleteSystemAuthServer(ctx context.Context, name string) error {
+	pf.mutexes.SystemAuthServer.Lock()
+	defer pf.mutexes.SystemAuthServer.Unlock()
+
+	authServers, err := pf.getSystemAuthServers(ctx)
+	if err != nil {
+		return fmt.Errorf("%w authentication server, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := authServers.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w authentication server, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_authservers.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w authentication server, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// pfSense refuses to delete a server that is in use (e.g. webConfigurator authentication)
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w authentication server, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
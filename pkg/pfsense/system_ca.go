@@ -0,0 +1,417 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	CertificateAuthorityMethodExisting = "existing"
+	CertificateAuthorityMethodInternal = "internal"
+)
+
+func CertificateAuthorityMethods() []string {
+	return []string{CertificateAuthorityMethodExisting, CertificateAuthorityMethodInternal}
+}
+
+func CertificateAuthorityKeyLengths() []int {
+	return []int{1024, 2048, 3072, 4096, 6144, 7680, 8192, 15360, 16384}
+}
+
+func CertificateAuthorityDigests() []string {
+	return []string{"sha1", "sha224", "sha256", "sha384", "sha512"}
+}
+
+type certificateAuthorityResponse struct {
+	RefID       string  `json:"refid"`
+	Description string  `json:"descr"`
+	Certificate string  `json:"crt"`
+	PrivateKey  string  `json:"prv"`
+	Trust       *string `json:"trust"`
+}
+
+// CertificateAuthority is either an existing CA that is imported (certificate and optional private key) or an
+// internal CA generated by pfSense (common name, key length, digest and lifetime).
+type CertificateAuthority struct {
+	RefID       string
+	Description string
+	Method      string
+	Certificate string
+	PrivateKey  string
+	CommonName  string
+	KeyLength   int
+	Digest      string
+	Lifetime    int
+	Trust       bool
+}
+
+func (ca *CertificateAuthority) SetRefID(refID string) error {
+	if refID == "" {
+		return fmt.Errorf("%w, certificate authority reference ID required", ErrClientValidation)
+	}
+
+	ca.RefID = refID
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetDescription(description string) error {
+	ca.Description = description
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetMethod(method string) error {
+	if !slices.Contains(CertificateAuthorityMethods(), method) {
+		return fmt.Errorf("%w, certificate authority method must be one of '%s'", ErrClientValidation, strings.Join(CertificateAuthorityMethods(), "', '"))
+	}
+
+	ca.Method = method
+
+	return nil
+}
+
+// SetCertificate expects a PEM encoded CA certificate.
+func (ca *CertificateAuthority) SetCertificate(certificate string) error {
+	c, err := parsePEMCertificate(certificate)
+	if err != nil {
+		return err
+	}
+
+	if !c.IsCA {
+		return fmt.Errorf("%w, certificate is not a certificate authority", ErrClientValidation)
+	}
+
+	ca.Certificate = certificate
+
+	return nil
+}
+
+// SetPrivateKey expects a PEM encoded private key (PKCS#1, PKCS#8 or EC).
+func (ca *CertificateAuthority) SetPrivateKey(privateKey string) error {
+	err := validatePEMPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	ca.PrivateKey = privateKey
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetCommonName(commonName string) error {
+	if commonName == "" {
+		return fmt.Errorf("%w, certificate authority common name required", ErrClientValidation)
+	}
+
+	ca.CommonName = commonName
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetKeyLength(keyLength int) error {
+	if !slices.Contains(CertificateAuthorityKeyLengths(), keyLength) {
+		return fmt.Errorf("%w, certificate authority key length must be one of %v", ErrClientValidation, CertificateAuthorityKeyLengths())
+	}
+
+	ca.KeyLength = keyLength
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetDigest(digest string) error {
+	if !slices.Contains(CertificateAuthorityDigests(), digest) {
+		return fmt.Errorf("%w, certificate authority digest must be one of '%s'", ErrClientValidation, strings.Join(CertificateAuthorityDigests(), "', '"))
+	}
+
+	ca.Digest = digest
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetLifetime(lifetime int) error {
+	if lifetime < 1 {
+		return fmt.Errorf("%w, certificate authority lifetime must be at least 1 day", ErrClientValidation)
+	}
+
+	ca.Lifetime = lifetime
+
+	return nil
+}
+
+func (ca *CertificateAuthority) SetTrust(trust bool) error {
+	ca.Trust = trust
+
+	return nil
+}
+
+// Validate checks the fields required by the method.
+func (ca CertificateAuthority) Validate() error {
+	switch ca.Method {
+	case CertificateAuthorityMethodExisting:
+		if ca.Certificate == "" {
+			return fmt.Errorf("%w, certificate required when importing an existing certificate authority", ErrClientValidation)
+		}
+
+		if ca.PrivateKey != "" {
+			if _, err := tls.X509KeyPair([]byte(ca.Certificate), []byte(ca.PrivateKey)); err != nil {
+				return fmt.Errorf("%w, %w", ErrClientValidation, err)
+			}
+		}
+	case CertificateAuthorityMethodInternal:
+		if ca.CommonName == "" {
+			return fmt.Errorf("%w, common name required when creating an internal certificate authority", ErrClientValidation)
+		}
+	}
+
+	return nil
+}
+
+type CertificateAuthorities []CertificateAuthority
+
+func (cas CertificateAuthorities) GetByRefID(refID string) (*CertificateAuthority, error) {
+	for _, ca := range cas {
+		if ca.RefID == refID {
+			return &ca, nil
+		}
+	}
+	return nil, fmt.Errorf("certificate authority %w with reference ID '%s'", ErrNotFound, refID)
+}
+
+func (pf *Client) getSystemCertificateAuthorities(ctx context.Context) (*CertificateAuthorities, error) {
+	command := "$output = array();" +
+		"if (is_array($config['ca'])) {" +
+		"foreach ($config['ca'] as $v) { array_push($output, $v); }" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var caResp []certificateAuthorityResponse
+	err = json.Unmarshal(b, &caResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var cas CertificateAuthorities
+	for _, resp := range caResp {
+		var ca CertificateAuthority
+		var err error
+
+		err = ca.SetRefID(resp.RefID)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+		}
+
+		err = ca.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+		}
+
+		// certificates and keys are stored base64 encoded
+		crt, err := base64.StdEncoding.DecodeString(resp.Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+		}
+
+		err = ca.SetCertificate(string(crt))
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+		}
+
+		if resp.PrivateKey != "" {
+			prv, err := base64.StdEncoding.DecodeString(resp.PrivateKey)
+			if err != nil {
+				return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+			}
+
+			err = ca.SetPrivateKey(string(prv))
+			if err != nil {
+				return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+			}
+		}
+
+		err = ca.SetTrust(resp.Trust != nil && *resp.Trust == "enabled")
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate authority response, %w", ErrUnableToParse, err)
+		}
+
+		cas = append(cas, ca)
+	}
+
+	return &cas, nil
+}
+
+func (pf *Client) GetSystemCertificateAuthorities(ctx context.Context) (*CertificateAuthorities, error) {
+	pf.mutexes.SystemCA.Lock()
+	defer pf.mutexes.SystemCA.Unlock()
+
+	cas, err := pf.getSystemCertificateAuthorities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authorities, %w", ErrGetOperationFailed, err)
+	}
+
+	return cas, nil
+}
+
+func (pf *Client) GetSystemCertificateAuthority(ctx context.Context, refID string) (*CertificateAuthority, error) {
+	pf.mutexes.SystemCA.Lock()
+	defer pf.mutexes.SystemCA.Unlock()
+
+	cas, err := pf.getSystemCertificateAuthorities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority (reference ID '%s'), %w", ErrGetOperationFailed, refID, err)
+	}
+
+	return cas.GetByRefID(refID)
+}
+
+func (pf *Client) submitSystemCertificateAuthority(ctx context.Context, caReq CertificateAuthority, refID *string) error {
+	err := caReq.Validate()
+	if err != nil {
+		return err
+	}
+
+	u := url.URL{Path: "system_camanager.php"}
+	q := u.Query()
+	v := url.Values{
+		"descr": {caReq.Description},
+		"save":  {"Save"},
+	}
+
+	if caReq.Trust {
+		v.Set("trust", "yes")
+	}
+
+	switch {
+	case refID != nil:
+		q.Set("act", "edit")
+		q.Set("id", *refID)
+		v.Set("method", "edit")
+		v.Set("id", *refID)
+		v.Set("cert", caReq.Certificate)
+		v.Set("key", caReq.PrivateKey)
+	case caReq.Method == CertificateAuthorityMethodExisting:
+		q.Set("act", "new")
+		v.Set("method", CertificateAuthorityMethodExisting)
+		v.Set("cert", caReq.Certificate)
+		v.Set("key", caReq.PrivateKey)
+	default:
+		q.Set("act", "new")
+		v.Set("method", CertificateAuthorityMethodInternal)
+		v.Set("keytype", "RSA")
+		v.Set("keylen", strconv.Itoa(caReq.KeyLength))
+		v.Set("digest_alg", caReq.Digest)
+		v.Set("lifetime", strconv.Itoa(caReq.Lifetime))
+		v.Set("dn_commonname", caReq.CommonName)
+		v.Set("randomserial", "yes")
+	}
+
+	u.RawQuery = q.Encode()
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) CreateSystemCertificateAuthority(ctx context.Context, caReq CertificateAuthority) (*CertificateAuthority, error) {
+	pf.mutexes.SystemCA.Lock()
+	defer pf.mutexes.SystemCA.Unlock()
+
+	before, err := pf.getSystemCertificateAuthorities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority, %w", ErrCreateOperationFailed, err)
+	}
+
+	err = pf.submitSystemCertificateAuthority(ctx, caReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority, %w", ErrCreateOperationFailed, err)
+	}
+
+	cas, err := pf.getSystemCertificateAuthorities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority, %w", ErrCreateOperationFailed, err)
+	}
+
+	// pfSense generates the reference ID, identify the new certificate authority as the one that did not exist before
+	for _, ca := range *cas {
+		if _, err := before.GetByRefID(ca.RefID); err == nil {
+			continue
+		}
+
+		if ca.Description == caReq.Description {
+			return &ca, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w certificate authority, %w", ErrCreateOperationFailed, fmt.Errorf("new certificate authority %w", ErrNotFound))
+}
+
+func (pf *Client) UpdateSystemCertificateAuthority(ctx context.Context, caReq CertificateAuthority) (*CertificateAuthority, error) {
+	pf.mutexes.SystemCA.Lock()
+	defer pf.mutexes.SystemCA.Unlock()
+
+	err := pf.submitSystemCertificateAuthority(ctx, caReq, &caReq.RefID)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority, %w", ErrUpdateOperationFailed, err)
+	}
+
+	cas, err := pf.getSystemCertificateAuthorities(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority, %w", ErrUpdateOperationFailed, err)
+	}
+
+	ca, err := cas.GetByRefID(caReq.RefID)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate authority, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return ca, nil
+}
+
+func (pf *Client) DeleteSystemCertificateAuthority(ctx context.Context, refID string) error {
+	pf.mutexes.SystemCA.Lock()
+	defer pf.mutexes.SystemCA.Unlock()
+
+	u := url.URL{Path: "system_camanager.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {refID},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w certificate authority, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w certificate authority, %w", ErrDeleteOperationFailed, err)
+	}
+
+	cas, err := pf.getSystemCertificateAuthorities(ctx)
+	if err != nil {
+		return fmt.Errorf("%w certificate authority, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// certificate authorities still in use (e.g. by issued certificates) are refused with a notice rather than an input error
+	if _, err := cas.GetByRefID(refID); err == nil {
+		return fmt.Errorf("%w certificate authority, certificate authority still exists (may be in use)", ErrDeleteOperationFailed)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+)
+
+func TestCertificateAuthoritySetCertificate(t *testing.T) {
+	caPEM, _ := testCertificateKeyPairPEM(t, "test CA", true)
+	certPEM, _ := testCertificateKeyPairPEM(t, "test", false)
+
+	var ca CertificateAuthority
+	if err := ca.SetCertificate(caPEM); err != nil {
+		t.Errorf("unexpected error, %s", err)
+	}
+
+	if err := ca.SetCertificate(certPEM); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error for a certificate that is not a CA, got %v", err)
+	}
+}
+
+func TestCertificateAuthorityValidate(t *testing.T) {
+	caPEM, caKeyPEM := testCertificateKeyPairPEM(t, "test CA", true)
+	_, otherKeyPEM := testCertificateKeyPairPEM(t, "other CA", true)
+
+	tests := []struct {
+		name  string
+		ca    CertificateAuthority
+		valid bool
+	}{
+		{"existing", CertificateAuthority{Method: CertificateAuthorityMethodExisting, Certificate: caPEM}, true},
+		{"existing with key", CertificateAuthority{Method: CertificateAuthorityMethodExisting, Certificate: caPEM, PrivateKey: caKeyPEM}, true},
+		{"existing with mismatched key", CertificateAuthority{Method: CertificateAuthorityMethodExisting, Certificate: caPEM, PrivateKey: otherKeyPEM}, false},
+		{"existing without certificate", CertificateAuthority{Method: CertificateAuthorityMethodExisting}, false},
+		{"internal", CertificateAuthority{Method: CertificateAuthorityMethodInternal, CommonName: "internal-ca"}, true},
+		{"internal without common name", CertificateAuthority{Method: CertificateAuthorityMethodInternal}, false},
+	}
+
+	for _, tt := range tests {
+		err := tt.ca.Validate()
+
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s: expected client validation error, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestCreateSystemCertificateAuthorityInternal(t *testing.T) {
+	// stands in for the certificate authority pfSense generates
+	generatedPEM, generatedKeyPEM := testCertificateKeyPairPEM(t, "internal-ca", true)
+
+	var mutex sync.Mutex
+	var posted url.Values
+	stored := []certificateAuthorityResponse{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_camanager.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		_ = r.ParseForm()
+		posted = r.PostForm
+
+		if r.URL.Query().Get("act") == "new" {
+			stored = append(stored, certificateAuthorityResponse{
+				RefID:       fmt.Sprintf("6a7b8c9d0e1f%d", len(stored)),
+				Description: r.PostFormValue("descr"),
+				Certificate: base64.StdEncoding.EncodeToString([]byte(generatedPEM)),
+				PrivateKey:  base64.StdEncoding.EncodeToString([]byte(generatedKeyPEM)),
+			})
+		}
+
+		fmt.Fprint(w, "<html><body></body></html>")
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var caReq CertificateAuthority
+	_ = caReq.SetDescription("internal CA")
+	_ = caReq.SetMethod(CertificateAuthorityMethodInternal)
+	_ = caReq.SetCommonName("internal-ca")
+	_ = caReq.SetKeyLength(4096)
+	_ = caReq.SetDigest("sha512")
+	_ = caReq.SetLifetime(3650)
+
+	ca, err := pf.CreateSystemCertificateAuthority(context.Background(), caReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if ca.RefID != "6a7b8c9d0e1f0" || ca.Certificate != generatedPEM || ca.PrivateKey != generatedKeyPEM {
+		t.Errorf("unexpected certificate authority %+v", *ca)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for key, want := range map[string]string{"method": "internal", "dn_commonname": "internal-ca", "keylen": "4096", "digest_alg": "sha512", "lifetime": "3650"} {
+		if got := posted.Get(key); got != want {
+			t.Errorf("posted %s = %q, want %q", key, got, want)
+		}
+	}
+
+	if posted.Has("cert") || posted.Has("trust") {
+		t.Errorf("unexpected posted values %v", posted)
+	}
+}
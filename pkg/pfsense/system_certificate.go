@@ -0,0 +1,343 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type certificateResponse struct {
+	RefID       string `json:"refid"`
+	Description string `json:"descr"`
+	Certificate string `json:"crt"`
+	PrivateKey  string `json:"prv"`
+	CARefID     string `json:"caref"`
+}
+
+func parsePEMCertificate(certificate string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%w, certificate must be PEM encoded", ErrClientValidation)
+	}
+
+	c, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	return c, nil
+}
+
+func validatePEMPrivateKey(privateKey string) error {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		return fmt.Errorf("%w, private key must be PEM encoded", ErrClientValidation)
+	}
+
+	_, pkcs8Err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	_, pkcs1Err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	_, ecErr := x509.ParseECPrivateKey(block.Bytes)
+	if pkcs8Err != nil && pkcs1Err != nil && ecErr != nil {
+		return fmt.Errorf("%w, private key cannot be parsed, %w", ErrClientValidation, pkcs8Err)
+	}
+
+	return nil
+}
+
+type Certificate struct {
+	RefID       string
+	Description string
+	Certificate string
+	PrivateKey  string
+	CARefID     string
+	NotBefore   time.Time
+	NotAfter    time.Time
+}
+
+func (cert *Certificate) SetRefID(refID string) error {
+	if refID == "" {
+		return fmt.Errorf("%w, certificate reference ID required", ErrClientValidation)
+	}
+
+	cert.RefID = refID
+
+	return nil
+}
+
+func (cert *Certificate) SetDescription(description string) error {
+	cert.Description = description
+
+	return nil
+}
+
+// SetCertificate expects a PEM encoded certificate and sets the validity period from it.
+func (cert *Certificate) SetCertificate(certificate string) error {
+	c, err := parsePEMCertificate(certificate)
+	if err != nil {
+		return err
+	}
+
+	cert.Certificate = certificate
+	cert.NotBefore = c.NotBefore
+	cert.NotAfter = c.NotAfter
+
+	return nil
+}
+
+// SetPrivateKey expects a PEM encoded private key (PKCS#1, PKCS#8 or EC).
+func (cert *Certificate) SetPrivateKey(privateKey string) error {
+	err := validatePEMPrivateKey(privateKey)
+	if err != nil {
+		return err
+	}
+
+	cert.PrivateKey = privateKey
+
+	return nil
+}
+
+func (cert *Certificate) SetCARefID(caRefID string) error {
+	cert.CARefID = caRefID
+
+	return nil
+}
+
+// ExpiresWithin reports whether the certificate expires within the duration of the given time.
+func (cert Certificate) ExpiresWithin(now time.Time, d time.Duration) bool {
+	return !cert.NotAfter.After(now.Add(d))
+}
+
+type Certificates []Certificate
+
+func (certs Certificates) GetByRefID(refID string) (*Certificate, error) {
+	for _, cert := range certs {
+		if cert.RefID == refID {
+			return &cert, nil
+		}
+	}
+	return nil, fmt.Errorf("certificate %w with reference ID '%s'", ErrNotFound, refID)
+}
+
+func (pf *Client) getSystemCertificates(ctx context.Context) (*Certificates, error) {
+	command := "$output = array();" +
+		"foreach ($config['cert'] as $v) {" +
+		"if (empty($v['crt'])) { continue; }" +
+		"array_push($output, array('refid' => $v['refid'], 'descr' => $v['descr'], 'crt' => $v['crt'], 'prv' => $v['prv'], 'caref' => $v['caref']));" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var certResp []certificateResponse
+	err = json.Unmarshal(b, &certResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var certs Certificates
+	for _, resp := range certResp {
+		var cert Certificate
+		var err error
+
+		err = cert.SetRefID(resp.RefID)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+		}
+
+		err = cert.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+		}
+
+		// certificates are stored base64 encoded
+		crt, err := base64.StdEncoding.DecodeString(resp.Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+		}
+
+		err = cert.SetCertificate(string(crt))
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+		}
+
+		// certificate signing requests and external signing leave no private key behind
+		if resp.PrivateKey != "" {
+			prv, err := base64.StdEncoding.DecodeString(resp.PrivateKey)
+			if err != nil {
+				return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+			}
+
+			err = cert.SetPrivateKey(string(prv))
+			if err != nil {
+				return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+			}
+		}
+
+		err = cert.SetCARefID(resp.CARefID)
+		if err != nil {
+			return nil, fmt.Errorf("%w certificate response, %w", ErrUnableToParse, err)
+		}
+
+		certs = append(certs, cert)
+	}
+
+	return &certs, nil
+}
+
+func (pf *Client) GetSystemCertificates(ctx context.Context) (*Certificates, error) {
+	pf.mutexes.SystemCertificate.Lock()
+	defer pf.mutexes.SystemCertificate.Unlock()
+
+	certs, err := pf.getSystemCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificates, %w", ErrGetOperationFailed, err)
+	}
+
+	return certs, nil
+}
+
+func (pf *Client) GetSystemCertificate(ctx context.Context, refID string) (*Certificate, error) {
+	pf.mutexes.SystemCertificate.Lock()
+	defer pf.mutexes.SystemCertificate.Unlock()
+
+	certs, err := pf.getSystemCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate (reference ID '%s'), %w", ErrGetOperationFailed, refID, err)
+	}
+
+	return certs.GetByRefID(refID)
+}
+
+func (pf *Client) submitSystemCertificate(ctx context.Context, certReq Certificate, refID *string) error {
+	_, err := tls.X509KeyPair([]byte(certReq.Certificate), []byte(certReq.PrivateKey))
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	u := url.URL{Path: "system_certmanager.php"}
+	q := u.Query()
+	v := url.Values{
+		"descr": {certReq.Description},
+		"cert":  {certReq.Certificate},
+		"key":   {certReq.PrivateKey},
+		"save":  {"Save"},
+	}
+
+	if refID != nil {
+		q.Set("act", "edit")
+		q.Set("id", *refID)
+		v.Set("method", "edit")
+		v.Set("id", *refID)
+	} else {
+		q.Set("act", "new")
+		v.Set("method", "import")
+	}
+
+	u.RawQuery = q.Encode()
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) CreateSystemCertificate(ctx context.Context, certReq Certificate) (*Certificate, error) {
+	pf.mutexes.SystemCertificate.Lock()
+	defer pf.mutexes.SystemCertificate.Unlock()
+
+	before, err := pf.getSystemCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate, %w", ErrCreateOperationFailed, err)
+	}
+
+	err = pf.submitSystemCertificate(ctx, certReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate, %w", ErrCreateOperationFailed, err)
+	}
+
+	certs, err := pf.getSystemCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate, %w", ErrCreateOperationFailed, err)
+	}
+
+	// pfSense generates the reference ID, identify the new certificate as the one that did not exist before
+	for _, cert := range *certs {
+		if _, err := before.GetByRefID(cert.RefID); err == nil {
+			continue
+		}
+
+		if strings.TrimSpace(cert.Certificate) == strings.TrimSpace(certReq.Certificate) {
+			return &cert, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w certificate, %w", ErrCreateOperationFailed, fmt.Errorf("imported certificate %w", ErrNotFound))
+}
+
+func (pf *Client) UpdateSystemCertificate(ctx context.Context, certReq Certificate) (*Certificate, error) {
+	pf.mutexes.SystemCertificate.Lock()
+	defer pf.mutexes.SystemCertificate.Unlock()
+
+	err := pf.submitSystemCertificate(ctx, certReq, &certReq.RefID)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate, %w", ErrUpdateOperationFailed, err)
+	}
+
+	certs, err := pf.getSystemCertificates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate, %w", ErrUpdateOperationFailed, err)
+	}
+
+	cert, err := certs.GetByRefID(certReq.RefID)
+	if err != nil {
+		return nil, fmt.Errorf("%w certificate, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return cert, nil
+}
+
+func (pf *Client) DeleteSystemCertificate(ctx context.Context, refID string) error {
+	pf.mutexes.SystemCertificate.Lock()
+	defer pf.mutexes.SystemCertificate.Unlock()
+
+	u := url.URL{Path: "system_certmanager.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {refID},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w certificate, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w certificate, %w", ErrDeleteOperationFailed, err)
+	}
+
+	certs, err := pf.getSystemCertificates(ctx)
+	if err != nil {
+		return fmt.Errorf("%w certificate, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// certificates still in use (e.g. by the web configurator) are refused with a notice rather than an input error
+	if _, err := certs.GetByRefID(refID); err == nil {
+		return fmt.Errorf("%w certificate, certificate still exists (may be in use)", ErrDeleteOperationFailed)
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testCertificateKeyPairPEM returns a self-signed certificate and its PKCS#8 private key.
+func testCertificateKeyPairPEM(t *testing.T, commonName string, isCA bool) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key, %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign
+		template.BasicConstraintsValid = true
+		template.IsCA = true
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create certificate, %s", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("unable to marshal key, %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+func TestCertificateSetCertificate(t *testing.T) {
+	certPEM, keyPEM := testCertificateKeyPairPEM(t, "test", false)
+
+	var cert Certificate
+	if err := cert.SetCertificate(certPEM); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if cert.NotAfter.IsZero() || !cert.NotBefore.Before(cert.NotAfter) {
+		t.Errorf("expected validity period to be set, got %s - %s", cert.NotBefore, cert.NotAfter)
+	}
+
+	for _, certificate := range []string{"", "not a certificate", keyPEM, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}))} {
+		if err := cert.SetCertificate(certificate); !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetCertificate(%q) expected client validation error, got %v", certificate, err)
+		}
+	}
+}
+
+func TestCertificateSetPrivateKey(t *testing.T) {
+	certPEM, pkcs8PEM := testCertificateKeyPairPEM(t, "test", false)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unable to generate key, %s", err)
+	}
+
+	ecDER, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("unable to marshal key, %s", err)
+	}
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unable to generate key, %s", err)
+	}
+
+	tests := []struct {
+		name       string
+		privateKey string
+		valid      bool
+	}{
+		{"PKCS#8", pkcs8PEM, true},
+		{"EC", string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDER})), true},
+		{"PKCS#1", string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})), true},
+		{"empty", "", false},
+		{"certificate", certPEM, false},
+		{"garbage", string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("garbage")})), false},
+	}
+
+	for _, tt := range tests {
+		var cert Certificate
+		err := cert.SetPrivateKey(tt.privateKey)
+
+		if tt.valid && err != nil {
+			t.Errorf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s: expected client validation error, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestCreateSystemCertificate(t *testing.T) {
+	existingPEM, existingKeyPEM := testCertificateKeyPairPEM(t, "existing", false)
+	certPEM, keyPEM := testCertificateKeyPairPEM(t, "imported", false)
+	_, otherKeyPEM := testCertificateKeyPairPEM(t, "other", false)
+
+	var mutex sync.Mutex
+	stored := []certificateResponse{{
+		RefID:       "5f0a1b2c3d4e5",
+		Description: "existing",
+		Certificate: base64.StdEncoding.EncodeToString([]byte(existingPEM)),
+		PrivateKey:  base64.StdEncoding.EncodeToString([]byte(existingKeyPEM)),
+	}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_certmanager.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.URL.Query().Get("act") == "new" && r.PostFormValue("method") == "import" {
+			stored = append(stored, certificateResponse{
+				RefID:       fmt.Sprintf("6a7b8c9d0e1f%d", len(stored)),
+				Description: r.PostFormValue("descr"),
+				Certificate: base64.StdEncoding.EncodeToString([]byte(r.PostFormValue("cert"))),
+				PrivateKey:  base64.StdEncoding.EncodeToString([]byte(r.PostFormValue("key"))),
+			})
+		}
+
+		fmt.Fprint(w, "<html><body></body></html>")
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var certReq Certificate
+	_ = certReq.SetDescription("imported")
+	_ = certReq.SetCertificate(certPEM)
+	_ = certReq.SetPrivateKey(keyPEM)
+
+	cert, err := pf.CreateSystemCertificate(context.Background(), certReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if cert.RefID != "6a7b8c9d0e1f1" || cert.Description != "imported" || cert.PrivateKey != keyPEM {
+		t.Errorf("unexpected certificate %+v", *cert)
+	}
+
+	// the private key must match the certificate
+	certReq.PrivateKey = otherKeyPEM
+	if _, err := pf.CreateSystemCertificate(context.Background(), certReq); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error for mismatched key, got %v", err)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(stored) != 2 {
+		t.Errorf("expected 2 stored certificates, got %d", len(stored))
+	}
+}
+
+func TestCertificatesGetByRefID(t *testing.T) {
+	certs := Certificates{{RefID: "5f0a1b2c3d4e5", Description: "first"}, {RefID: "6a7b8c9d0e1f0", Description: "second"}}
+
+	cert, err := certs.GetByRefID("6a7b8c9d0e1f0")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if cert.Description != "second" {
+		t.Errorf("unexpected certificate %+v", *cert)
+	}
+
+	if _, err := certs.GetByRefID("0000000000000"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+type cryptoModuleResponse struct {
+	CryptoHardware  string `json:"crypto_hardware"`
+	ThermalHardware string `json:"thermal_hardware"`
+}
+
+type CryptoModule struct {
+	CryptoHardware  string
+	ThermalHardware string
+}
+
+func (cm *CryptoModule) SetCryptoHardware(cryptoHardware string) error {
+	if cryptoHardware != "" && !slices.Contains(AdvancedMiscCryptoHardwares(), cryptoHardware) {
+		return fmt.Errorf("%w, crypto hardware must be one of %v", ErrClientValidation, AdvancedMiscCryptoHardwares())
+	}
+
+	cm.CryptoHardware = cryptoHardware
+
+	return nil
+}
+
+func (cm *CryptoModule) SetThermalHardware(thermalHardware string) error {
+	if thermalHardware != "" && !slices.Contains(AdvancedMiscThermalHardwares(), thermalHardware) {
+		return fmt.Errorf("%w, thermal hardware must be one of %v", ErrClientValidation, AdvancedMiscThermalHardwares())
+	}
+
+	cm.ThermalHardware = thermalHardware
+
+	return nil
+}
+
+// validateHardwareModules rejects modules the system does not offer, the options listed depend on the pfSense version and hardware.
+func validateHardwareModules(doc *goquery.Document, cryptoHardware string, thermalHardware string) error {
+	if options := scrapeHTMLSelectOptions(doc, "crypto_hardware"); cryptoHardware != "" && !slices.Contains(options, cryptoHardware) {
+		return fmt.Errorf("%w, crypto hardware '%s' is not available, must be one of %v", ErrClientValidation, cryptoHardware, options)
+	}
+
+	if options := scrapeHTMLSelectOptions(doc, "thermal_hardware"); thermalHardware != "" && !slices.Contains(options, thermalHardware) {
+		return fmt.Errorf("%w, thermal hardware '%s' is not available, must be one of %v", ErrClientValidation, thermalHardware, options)
+	}
+
+	return nil
+}
+
+func (pf *Client) getSystemCryptoModule(ctx context.Context) (*CryptoModule, error) {
+	b, err := pf.getConfigJSON(ctx, "['system']")
+	if err != nil {
+		return nil, err
+	}
+
+	var cmResp cryptoModuleResponse
+	err = json.Unmarshal(b, &cmResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var cm CryptoModule
+
+	err = cm.SetCryptoHardware(cmResp.CryptoHardware)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module response, %w", ErrUnableToParse, err)
+	}
+
+	err = cm.SetThermalHardware(cmResp.ThermalHardware)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module response, %w", ErrUnableToParse, err)
+	}
+
+	return &cm, nil
+}
+
+func (pf *Client) GetSystemCryptoModule(ctx context.Context) (*CryptoModule, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	cm, err := pf.getSystemCryptoModule(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module, %w", ErrGetOperationFailed, err)
+	}
+
+	return cm, nil
+}
+
+func (pf *Client) UpdateSystemCryptoModule(ctx context.Context, cmReq CryptoModule) (*CryptoModule, error) {
+	pf.mutexes.SystemAdvanced.Lock()
+	defer pf.mutexes.SystemAdvanced.Unlock()
+
+	u := url.URL{Path: "system_advanced_misc.php"}
+
+	// the page saves every field, start from the current form values to leave the other settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = validateHardwareModules(doc, cmReq.CryptoHardware, cmReq.ThermalHardware)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v.Set("crypto_hardware", cmReq.CryptoHardware)
+	v.Set("thermal_hardware", cmReq.ThermalHardware)
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module, %w", ErrUpdateOperationFailed, err)
+	}
+
+	cm, err := pf.getSystemCryptoModule(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w crypto module, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return cm, nil
+}
@@ -0,0 +1,109 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+)
+
+func TestValidateHardwareModules(t *testing.T) {
+	doc := testHTMLDocument(t, "testdata/settings_form.html")
+
+	tests := []struct {
+		cryptoHardware  string
+		thermalHardware string
+		valid           bool
+	}{
+		{"", "", true},
+		{"aesni", "", true},
+		{"aesni", "coretemp", true},
+		{"", "coretemp", true},
+		{"cryptodev", "", false},
+		{"aesni_cryptodev", "", false},
+		{"aesni", "amdtemp", false},
+	}
+
+	for _, tt := range tests {
+		err := validateHardwareModules(doc, tt.cryptoHardware, tt.thermalHardware)
+		if tt.valid && err != nil {
+			t.Errorf("validateHardwareModules(%q, %q) unexpected error, %s", tt.cryptoHardware, tt.thermalHardware, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("validateHardwareModules(%q, %q) expected client validation error, got %v", tt.cryptoHardware, tt.thermalHardware, err)
+		}
+	}
+}
+
+func TestUpdateSystemCryptoModule(t *testing.T) {
+	form, err := os.ReadFile("testdata/settings_form.html")
+	if err != nil {
+		t.Fatalf("unable to read fixture, %s", err)
+	}
+
+	var posted url.Values
+	stored := map[string]string{"hostname": "pfSense"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_advanced_misc.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+			posted = r.PostForm
+			stored["crypto_hardware"] = r.PostFormValue("crypto_hardware")
+			stored["thermal_hardware"] = r.PostFormValue("thermal_hardware")
+		}
+
+		_, _ = w.Write(form)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var cmReq CryptoModule
+	if err := cmReq.SetCryptoHardware("aesni"); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	cm, err := pf.UpdateSystemCryptoModule(context.Background(), cmReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if cm.CryptoHardware != "aesni" || cm.ThermalHardware != "" {
+		t.Errorf("expected crypto hardware 'aesni' and no thermal hardware, got '%s' and '%s'", cm.CryptoHardware, cm.ThermalHardware)
+	}
+
+	// other settings on the page are submitted unchanged
+	for key, want := range map[string]string{"crypto_hardware": "aesni", "thermal_hardware": "", "hostname": "pfSense", "enable": "yes", "save": "Save"} {
+		if got := posted.Get(key); got != want {
+			t.Errorf("posted %s = %q, want %q", key, got, want)
+		}
+	}
+
+	// a module the system does not offer is rejected before the form is saved
+	posted = nil
+	_ = cmReq.SetCryptoHardware("cryptodev")
+
+	_, err = pf.UpdateSystemCryptoModule(context.Background(), cmReq)
+	if !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error, got %v", err)
+	}
+
+	if posted != nil {
+		t.Errorf("expected no form submission, got %v", posted)
+	}
+}
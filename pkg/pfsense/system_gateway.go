@@ -0,0 +1,648 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+const (
+	DefaultGatewayAutomatic = "automatic"
+	DefaultGatewayNone      = "none"
+)
+
+var (
+	ErrApplySystemGatewayChange = errors.New("failed to apply gateway changes")
+)
+
+type gatewayNamesResponse struct {
+	IPv4   []string `json:"inet"`
+	IPv6   []string `json:"inet6"`
+	Groups []string `json:"groups"`
+}
+
+type gatewayNames struct {
+	IPv4   []string
+	IPv6   []string
+	Groups []string
+}
+
+type defaultGatewayResponse struct {
+	IPv4 string `json:"defaultgw4"`
+	IPv6 string `json:"defaultgw6"`
+}
+
+type DefaultGateway struct {
+	IPv4 string
+	IPv6 string
+}
+
+func validateDefaultGateway(gateway string) error {
+	if gateway == "" {
+		return fmt.Errorf("%w, default gateway required", ErrClientValidation)
+	}
+
+	return nil
+}
+
+func (dgw *DefaultGateway) SetIPv4(gateway string) error {
+	err := validateDefaultGateway(gateway)
+	if err != nil {
+		return err
+	}
+
+	dgw.IPv4 = gateway
+
+	return nil
+}
+
+func (dgw *DefaultGateway) SetIPv6(gateway string) error {
+	err := validateDefaultGateway(gateway)
+	if err != nil {
+		return err
+	}
+
+	dgw.IPv6 = gateway
+
+	return nil
+}
+
+// formatDefaultGateway converts to the value used by pfSense, an empty string for automatic and a dash for none.
+func formatDefaultGateway(gateway string) string {
+	switch gateway {
+	case DefaultGatewayAutomatic:
+		return ""
+	case DefaultGatewayNone:
+		return "-"
+	default:
+		return gateway
+	}
+}
+
+func parseDefaultGateway(gateway string) string {
+	switch gateway {
+	case "":
+		return DefaultGatewayAutomatic
+	case "-":
+		return DefaultGatewayNone
+	default:
+		return gateway
+	}
+}
+
+// validate checks that a gateway or gateway group of the address family exists.
+func (names gatewayNames) validate(gateway string, ipv4 bool) error {
+	if slices.Contains(names.Groups, gateway) {
+		return nil
+	}
+
+	gateways, family := names.IPv6, "IPv6"
+	if ipv4 {
+		gateways, family = names.IPv4, "IPv4"
+	}
+
+	if !slices.Contains(gateways, gateway) {
+		return fmt.Errorf("%w, %s gateway or gateway group '%s' does not exist", ErrClientValidation, family, gateway)
+	}
+
+	return nil
+}
+
+func (names gatewayNames) validateDefault(gateway string, ipv4 bool) error {
+	if gateway == DefaultGatewayAutomatic || gateway == DefaultGatewayNone {
+		return nil
+	}
+
+	return names.validate(gateway, ipv4)
+}
+
+func (pf *Client) getGatewayNames(ctx context.Context) (*gatewayNames, error) {
+	// dynamic gateways are not stored in the config, use the gateway library to include them
+	command := "$output = array('inet' => array(), 'inet6' => array(), 'groups' => array());" +
+		"foreach (return_gateways_array(true, false, true) as $name => $gw) {" +
+		"if (in_array($gw['ipprotocol'], array('inet', 'inet6'))) { array_push($output[$gw['ipprotocol']], $name); }" +
+		"}" +
+		"foreach (array_keys(return_gateway_groups_array()) as $name) { array_push($output['groups'], $name); }" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var namesResp gatewayNamesResponse
+	err = json.Unmarshal(b, &namesResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	names := gatewayNames(namesResp)
+
+	return &names, nil
+}
+
+func (pf *Client) getDefaultGateway(ctx context.Context) (*DefaultGateway, error) {
+	b, err := pf.getConfigJSON(ctx, "['gateways']")
+	if err != nil {
+		return nil, err
+	}
+
+	var dgwResp defaultGatewayResponse
+	err = json.Unmarshal(b, &dgwResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var dgw DefaultGateway
+
+	err = dgw.SetIPv4(parseDefaultGateway(dgwResp.IPv4))
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway response, %w", ErrUnableToParse, err)
+	}
+
+	err = dgw.SetIPv6(parseDefaultGateway(dgwResp.IPv6))
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway response, %w", ErrUnableToParse, err)
+	}
+
+	return &dgw, nil
+}
+
+func (pf *Client) GetDefaultGateway(ctx context.Context) (*DefaultGateway, error) {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	dgw, err := pf.getDefaultGateway(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrGetOperationFailed, err)
+	}
+
+	return dgw, nil
+}
+
+func (pf *Client) UpdateDefaultGateway(ctx context.Context, dgwReq DefaultGateway) (*DefaultGateway, error) {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	names, err := pf.getGatewayNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = names.validateDefault(dgwReq.IPv4, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = names.validateDefault(dgwReq.IPv6, false)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_gateways.php"}
+	v := url.Values{
+		"defaultgw4": {formatDefaultGateway(dgwReq.IPv4)},
+		"defaultgw6": {formatDefaultGateway(dgwReq.IPv6)},
+		"save":       {"Save"},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	dgw, err := pf.getDefaultGateway(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w default gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return dgw, nil
+}
+
+const gatewayDynamicAddress = "dynamic"
+
+func GatewayIPProtocols() []string {
+	return []string{"inet", "inet6"}
+}
+
+type gatewayResponse struct {
+	Name        string `json:"name"`
+	Interface   string `json:"friendlyiface"`
+	Address     string `json:"gateway"`
+	IPProtocol  string `json:"ipprotocol"`
+	Monitor     string `json:"monitor"`
+	Weight      string `json:"weight"`
+	Description string `json:"descr"`
+	Disabled    bool   `json:"disabled"`
+	Default     bool   `json:"default"`
+	ControlID   int    `json:"controlID"`
+}
+
+type Gateway struct {
+	Name        string
+	Interface   string
+	Address     string
+	IPProtocol  string
+	Monitor     string
+	Weight      int
+	Description string
+	Disabled    bool
+	Default     bool
+	controlID   int
+}
+
+func (gw *Gateway) SetName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9_]{1,31}$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, gateway name must only consist of alphanumeric characters and underscores (max 31)", ErrClientValidation)
+	}
+
+	gw.Name = name
+
+	return nil
+}
+
+func (gw *Gateway) SetInterface(iface string) error {
+	var isValidInterface = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	if !isValidInterface(iface) {
+		return fmt.Errorf("%w, interface must be an interface name (e.g. 'wan', 'lan', 'opt1')", ErrClientValidation)
+	}
+
+	gw.Interface = iface
+
+	return nil
+}
+
+// SetAddress expects an IP address, or 'dynamic' for gateways provided by DHCP, PPP, etc.
+func (gw *Gateway) SetAddress(address string) error {
+	if address != gatewayDynamicAddress {
+		if _, err := netip.ParseAddr(address); err != nil {
+			return fmt.Errorf("%w, gateway address must be an IP address, %w", ErrClientValidation, err)
+		}
+	}
+
+	gw.Address = address
+
+	return nil
+}
+
+func (gw *Gateway) SetIPProtocol(ipProtocol string) error {
+	if !slices.Contains(GatewayIPProtocols(), ipProtocol) {
+		return fmt.Errorf("%w, IP protocol must be one of %v", ErrClientValidation, GatewayIPProtocols())
+	}
+
+	gw.IPProtocol = ipProtocol
+
+	return nil
+}
+
+func (gw *Gateway) SetMonitor(monitor string) error {
+	if monitor != "" {
+		if _, err := netip.ParseAddr(monitor); err != nil {
+			return fmt.Errorf("%w, monitor must be an IP address, %w", ErrClientValidation, err)
+		}
+	}
+
+	gw.Monitor = monitor
+
+	return nil
+}
+
+func (gw *Gateway) SetWeight(weight int) error {
+	if weight < 1 || weight > 30 {
+		return fmt.Errorf("%w, weight must be between 1 and 30", ErrClientValidation)
+	}
+
+	gw.Weight = weight
+
+	return nil
+}
+
+func (gw *Gateway) SetDescription(description string) error {
+	gw.Description = description
+
+	return nil
+}
+
+func (gw *Gateway) SetDisabled(disabled bool) error {
+	gw.Disabled = disabled
+
+	return nil
+}
+
+func (gw *Gateway) SetDefault(isDefault bool) error {
+	gw.Default = isDefault
+
+	return nil
+}
+
+// Validate checks that the gateway and monitor addresses match the address family.
+func (gw Gateway) Validate() error {
+	ipv4 := gw.IPProtocol == "inet"
+
+	for _, address := range []string{gw.Address, gw.Monitor} {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			continue
+		}
+
+		if addr.Is4() != ipv4 {
+			return fmt.Errorf("%w, address '%s' does not match IP protocol '%s'", ErrClientValidation, address, gw.IPProtocol)
+		}
+	}
+
+	return nil
+}
+
+type Gateways []Gateway
+
+func (gws Gateways) GetByName(name string) (*Gateway, error) {
+	for _, gw := range gws {
+		if gw.Name == name {
+			return &gw, nil
+		}
+	}
+	return nil, fmt.Errorf("gateway %w with name '%s'", ErrNotFound, name)
+}
+
+func (gws Gateways) GetControlIDByName(name string) (*int, error) {
+	return getControlID(gws, func(gw Gateway) bool { return gw.Name == name },
+		func(_ int, gw Gateway) int { return gw.controlID }, "gateway", fmt.Sprintf("name '%s'", name))
+}
+
+func parseGatewayResponse(resp gatewayResponse) (*Gateway, error) {
+	var gw Gateway
+	var err error
+
+	err = gw.SetName(resp.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gw.SetInterface(resp.Interface)
+	if err != nil {
+		return nil, err
+	}
+
+	address := resp.Address
+	if address == "" {
+		address = gatewayDynamicAddress
+	}
+
+	err = gw.SetAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gw.SetIPProtocol(resp.IPProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gw.SetMonitor(resp.Monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	weight := 1
+	if resp.Weight != "" {
+		weight, err = strconv.Atoi(resp.Weight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = gw.SetWeight(weight)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gw.SetDescription(resp.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gw.SetDisabled(resp.Disabled)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gw.SetDefault(resp.Default)
+	if err != nil {
+		return nil, err
+	}
+
+	gw.controlID = resp.ControlID
+
+	return &gw, nil
+}
+
+func (pf *Client) getGateways(ctx context.Context) (*Gateways, error) {
+	// the web interface identifies gateways by their index in the integer indexed gateway list (including dynamic gateways)
+	command := "$output = array();" +
+		"$defaults = array($config['gateways']['defaultgw4'] ?? '', $config['gateways']['defaultgw6'] ?? '');" +
+		"foreach (return_gateways_array(true, false, true, true) as $id => $gw) {" +
+		"$gw['controlID'] = $id;" +
+		"$gw['disabled'] = isset($gw['disabled']);" +
+		"$gw['default'] = in_array($gw['name'], $defaults, true);" +
+		"array_push($output, $gw);" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var gwResp []gatewayResponse
+	err = json.Unmarshal(b, &gwResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var gws Gateways
+	for _, resp := range gwResp {
+		gw, err := parseGatewayResponse(resp)
+		if err != nil {
+			return nil, fmt.Errorf("%w gateway response, %w", ErrUnableToParse, err)
+		}
+
+		gws = append(gws, *gw)
+	}
+
+	return &gws, nil
+}
+
+func (pf *Client) GetGateways(ctx context.Context) (*Gateways, error) {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	gws, err := pf.getGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w gateways, %w", ErrGetOperationFailed, err)
+	}
+
+	return gws, nil
+}
+
+func (pf *Client) GetGateway(ctx context.Context, name string) (*Gateway, error) {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	gws, err := pf.getGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w gateway (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return gws.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateGateway(ctx context.Context, gwReq Gateway, controlID *int) (*Gateway, error) {
+	err := gwReq.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Path: "system_gateways_edit.php"}
+	v := url.Values{
+		"interface":  {gwReq.Interface},
+		"ipprotocol": {gwReq.IPProtocol},
+		"name":       {gwReq.Name},
+		"gateway":    {gwReq.Address},
+		"monitor":    {gwReq.Monitor},
+		"weight":     {strconv.Itoa(gwReq.Weight)},
+		"descr":      {gwReq.Description},
+		"save":       {"Save"},
+	}
+
+	if gwReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	gws, err := pf.getGateways(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gw, err := gws.GetByName(gwReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return gw, nil
+}
+
+func (pf *Client) CreateGateway(ctx context.Context, gwReq Gateway) (*Gateway, error) {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	gw, err := pf.createOrUpdateGateway(ctx, gwReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w gateway, %w", ErrCreateOperationFailed, err)
+	}
+
+	return gw, nil
+}
+
+func (pf *Client) UpdateGateway(ctx context.Context, gwReq Gateway) (*Gateway, error) {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	gws, err := pf.getGateways(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := gws.GetControlIDByName(gwReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	gw, err := pf.createOrUpdateGateway(ctx, gwReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w gateway, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return gw, nil
+}
+
+func (pf *Client) DeleteGateway(ctx context.Context, name string) error {
+	pf.mutexes.SystemGateway.Lock()
+	defer pf.mutexes.SystemGateway.Unlock()
+
+	gws, err := pf.getGateways(ctx)
+	if err != nil {
+		return fmt.Errorf("%w gateway, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := gws.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w gateway, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_gateways.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w gateway, %w", ErrDeleteOperationFailed, err)
+	}
+
+	// gateways referenced by routes, groups, etc. cannot be deleted
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w gateway, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) ApplySystemGatewayChanges(ctx context.Context) error {
+	pf.mutexes.SystemGatewayApply.Lock()
+	defer pf.mutexes.SystemGatewayApply.Unlock()
+
+	u := url.URL{Path: "system_gateways.php"}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplySystemGatewayChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDefaultGatewayFormat(t *testing.T) {
+	tests := []struct {
+		gateway string
+		value   string
+	}{
+		{DefaultGatewayAutomatic, ""},
+		{DefaultGatewayNone, "-"},
+		{"WAN_DHCP", "WAN_DHCP"},
+	}
+
+	for _, tt := range tests {
+		if got := formatDefaultGateway(tt.gateway); got != tt.value {
+			t.Errorf("formatDefaultGateway(%q) = %q, want %q", tt.gateway, got, tt.value)
+		}
+
+		if got := parseDefaultGateway(tt.value); got != tt.gateway {
+			t.Errorf("parseDefaultGateway(%q) = %q, want %q", tt.value, got, tt.gateway)
+		}
+	}
+}
+
+func TestUpdateDefaultGateway(t *testing.T) {
+	stored := defaultGatewayResponse{}
+	var posts int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_gateways.php", func(w http.ResponseWriter, r *http.Request) {
+		posts++
+		stored.IPv4 = r.PostFormValue("defaultgw4")
+		stored.IPv6 = r.PostFormValue("defaultgw6")
+		fmt.Fprint(w, testDashboardPage)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(command string) string {
+		if strings.Contains(command, "return_gateways_array") {
+			return `{"inet":["WAN_DHCP"],"inet6":["WAN_DHCP6"],"groups":["FAILOVER"]}`
+		}
+
+		b, _ := json.Marshal(stored)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	tests := []struct {
+		ipv4, ipv6       string
+		stored4, stored6 string
+	}{
+		{"WAN_DHCP", DefaultGatewayNone, "WAN_DHCP", "-"},
+		{"FAILOVER", "WAN_DHCP6", "FAILOVER", "WAN_DHCP6"},
+		{DefaultGatewayAutomatic, DefaultGatewayAutomatic, "", ""},
+	}
+
+	for _, tt := range tests {
+		var dgwReq DefaultGateway
+		_ = dgwReq.SetIPv4(tt.ipv4)
+		_ = dgwReq.SetIPv6(tt.ipv6)
+
+		dgw, err := pf.UpdateDefaultGateway(context.Background(), dgwReq)
+		if err != nil {
+			t.Fatalf("%s/%s: unexpected error, %s", tt.ipv4, tt.ipv6, err)
+		}
+
+		if stored.IPv4 != tt.stored4 || stored.IPv6 != tt.stored6 {
+			t.Errorf("%s/%s: stored '%s'/'%s', want '%s'/'%s'", tt.ipv4, tt.ipv6, stored.IPv4, stored.IPv6, tt.stored4, tt.stored6)
+		}
+
+		if *dgw != dgwReq {
+			t.Errorf("%s/%s: read back %+v", tt.ipv4, tt.ipv6, *dgw)
+		}
+	}
+
+	// gateways of the wrong address family or unknown gateways are rejected before saving
+	posts = 0
+	for _, dgwReq := range []DefaultGateway{{IPv4: "WAN_DHCP6", IPv6: DefaultGatewayNone}, {IPv4: DefaultGatewayNone, IPv6: "missing"}} {
+		_, err := pf.UpdateDefaultGateway(context.Background(), dgwReq)
+		if !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%+v: expected client validation error, got %v", dgwReq, err)
+		}
+	}
+
+	if posts != 0 {
+		t.Errorf("expected no form submissions, got %d", posts)
+	}
+}
+
+func TestGatewayValidate(t *testing.T) {
+	tests := []struct {
+		ipProtocol string
+		address    string
+		monitor    string
+		valid      bool
+	}{
+		{"inet", "192.168.1.254", "", true},
+		{"inet", "192.168.1.254", "8.8.8.8", true},
+		{"inet", "dynamic", "", true},
+		{"inet", "fd00::1", "", false},
+		{"inet", "192.168.1.254", "fd00::1", false},
+		{"inet6", "fd00::1", "2001:4860:4860::8888", true},
+		{"inet6", "192.168.1.254", "", false},
+	}
+
+	for _, tt := range tests {
+		gw := Gateway{IPProtocol: tt.ipProtocol, Address: tt.address, Monitor: tt.monitor}
+
+		err := gw.Validate()
+		if tt.valid && err != nil {
+			t.Errorf("%s %s/%s: unexpected error, %s", tt.ipProtocol, tt.address, tt.monitor, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("%s %s/%s: expected client validation error, got %v", tt.ipProtocol, tt.address, tt.monitor, err)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+type groupResponse struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Privileges  []string `json:"priv"`
+	ControlID   int      `json:"controlID"`
+}
+
+// Group is a local group, membership is managed on the user.
+type Group struct {
+	Name        string
+	Description string
+	Privileges  []string
+	controlID   int
+}
+
+func validateGroupName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, group name '%s' must be 1-64 letters, digits, '.', '-' or '_'", ErrClientValidation, name)
+	}
+
+	return nil
+}
+
+func (group *Group) SetName(name string) error {
+	err := validateGroupName(name)
+	if err != nil {
+		return err
+	}
+
+	group.Name = name
+
+	return nil
+}
+
+func (group *Group) SetDescription(description string) error {
+	group.Description = description
+
+	return nil
+}
+
+func (group *Group) SetPrivileges(privileges []string) error {
+	err := validatePrivileges(privileges)
+	if err != nil {
+		return err
+	}
+
+	group.Privileges = privileges
+
+	return nil
+}
+
+type Groups []Group
+
+func (groups Groups) GetByName(name string) (*Group, error) {
+	for _, group := range groups {
+		if group.Name == name {
+			return &group, nil
+		}
+	}
+	return nil, fmt.Errorf("group %w with name '%s'", ErrNotFound, name)
+}
+
+func (groups Groups) GetControlIDByName(name string) (*int, error) {
+	return getControlID(groups, func(group Group) bool { return group.Name == name },
+		func(_ int, group Group) int { return group.controlID }, "group", fmt.Sprintf("name '%s'", name))
+}
+
+func (pf *Client) getGroups(ctx context.Context) (*Groups, error) {
+	command := "$output = array();" +
+		"foreach ($config['system']['group'] as $k => $v) {" +
+		"array_push($output, array('name' => $v['name'], 'description' => $v['description'], 'priv' => $v['priv'], 'controlID' => $k));" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupResp []groupResponse
+	err = json.Unmarshal(b, &groupResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var groups Groups
+	for _, resp := range groupResp {
+		var group Group
+		var err error
+
+		err = group.SetName(resp.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w group response, %w", ErrUnableToParse, err)
+		}
+
+		err = group.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w group response, %w", ErrUnableToParse, err)
+		}
+
+		err = group.SetPrivileges(resp.Privileges)
+		if err != nil {
+			return nil, fmt.Errorf("%w group response, %w", ErrUnableToParse, err)
+		}
+
+		group.controlID = resp.ControlID
+
+		groups = append(groups, group)
+	}
+
+	return &groups, nil
+}
+
+func (pf *Client) GetGroups(ctx context.Context) (*Groups, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	groups, err := pf.getGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w groups, %w", ErrGetOperationFailed, err)
+	}
+
+	return groups, nil
+}
+
+func (pf *Client) GetGroup(ctx context.Context, name string) (*Group, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	groups, err := pf.getGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w group (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return groups.GetByName(name)
+}
+
+func (pf *Client) createOrUpdateGroup(ctx context.Context, groupReq Group, controlID *int) (*Group, error) {
+	u := url.URL{Path: "system_groupmanager.php"}
+	q := u.Query()
+	if controlID != nil {
+		q.Set("act", "edit")
+		q.Set("groupid", strconv.Itoa(*controlID))
+	} else {
+		q.Set("act", "new")
+	}
+	u.RawQuery = q.Encode()
+
+	// the page saves every field, start from the current form values to leave members unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v.Set("groupname", groupReq.Name)
+	v.Set("description", groupReq.Description)
+	v.Set("gtype", "local")
+	v.Set("save", "Save")
+
+	if controlID != nil {
+		v.Set("groupid", strconv.Itoa(*controlID))
+	}
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := pf.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := groups.GetByName(groupReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = reconcilePrivileges(ctx, group.Privileges, groupReq.Privileges,
+		func(ctx context.Context, privileges []string) error {
+			return pf.submitPrivileges(ctx, "system_groupmanager_addprivs.php", "groupid", group.controlID, privileges)
+		},
+		func(ctx context.Context, i int) error {
+			_, err := pf.callHTML(ctx, http.MethodPost, url.URL{Path: "system_groupmanager.php"}, &url.Values{
+				"act":     {"delpriv"},
+				"groupid": {strconv.Itoa(group.controlID)},
+				"privid":  {strconv.Itoa(i)},
+			})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err = pf.getGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups.GetByName(groupReq.Name)
+}
+
+func (pf *Client) CreateGroup(ctx context.Context, groupReq Group) (*Group, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	group, err := pf.createOrUpdateGroup(ctx, groupReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w group, %w", ErrCreateOperationFailed, err)
+	}
+
+	return group, nil
+}
+
+func (pf *Client) UpdateGroup(ctx context.Context, groupReq Group) (*Group, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	groups, err := pf.getGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w group, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := groups.GetControlIDByName(groupReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w group, %w", ErrUpdateOperationFailed, err)
+	}
+
+	group, err := pf.createOrUpdateGroup(ctx, groupReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w group, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return group, nil
+}
+
+func (pf *Client) DeleteGroup(ctx context.Context, name string) error {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	groups, err := pf.getGroups(ctx)
+	if err != nil {
+		return fmt.Errorf("%w group, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := groups.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w group, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_groupmanager.php"}
+	v := url.Values{
+		"act":       {"delgroup"},
+		"groupid":   {strconv.Itoa(*controlID)},
+		"groupname": {name},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w group, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w group, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,166 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type systemInfoResponse struct {
+	Version        string    `json:"version"`
+	Now            int64     `json:"now"`
+	BootTime       string    `json:"boottime"`
+	CPUCount       int       `json:"ncpu"`
+	CPUTimeStart   string    `json:"cp_time_start"`
+	CPUTimeEnd     string    `json:"cp_time_end"`
+	PageCount      int64     `json:"page_count"`
+	InactiveCount  int64     `json:"inactive_count"`
+	CacheCount     int64     `json:"cache_count"`
+	FreeCount      int64     `json:"free_count"`
+	PageSize       int64     `json:"page_size"`
+	Temperature    string    `json:"temperature"`
+	DiskTotalBytes float64   `json:"disk_total"`
+	DiskFreeBytes  float64   `json:"disk_free"`
+	LoadAverage    []float64 `json:"loadavg"`
+}
+
+type SystemInfo struct {
+	Version          string
+	UptimeSeconds    int64
+	CPUCount         int
+	CPUUsage         float64
+	MemoryTotalBytes int64
+	MemoryUsage      float64
+	Temperature      *float64
+	DiskTotalBytes   int64
+	DiskUsage        float64
+	LoadAverage      []float64
+}
+
+func parseCPUTime(cpTime string) ([]int64, error) {
+	var values []int64
+	for _, field := range strings.Fields(cpTime) {
+		value, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	// user, nice, system, interrupt, idle
+	if len(values) != 5 {
+		return nil, fmt.Errorf("%w, CPU time '%s' must have 5 fields", ErrUnableToParse, cpTime)
+	}
+
+	return values, nil
+}
+
+func percentage(part float64, total float64) float64 {
+	if total <= 0 {
+		return 0
+	}
+
+	return part / total * 100
+}
+
+func parseSystemInfoResponse(resp systemInfoResponse) (*SystemInfo, error) {
+	var info SystemInfo
+
+	info.Version = strings.TrimSpace(resp.Version)
+	info.CPUCount = resp.CPUCount
+	info.LoadAverage = resp.LoadAverage
+
+	// kern.boottime, e.g. '{ sec = 1700000000, usec = 0 } Tue Nov 14 22:13:20 2023'
+	match := regexp.MustCompile(`sec = ([0-9]+)`).FindStringSubmatch(resp.BootTime)
+	if match == nil {
+		return nil, fmt.Errorf("%w, boot time '%s'", ErrUnableToParse, resp.BootTime)
+	}
+
+	bootTime, err := strconv.ParseInt(match[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w, boot time '%s', %w", ErrUnableToParse, resp.BootTime, err)
+	}
+
+	info.UptimeSeconds = resp.Now - bootTime
+
+	start, err := parseCPUTime(resp.CPUTimeStart)
+	if err != nil {
+		return nil, err
+	}
+
+	end, err := parseCPUTime(resp.CPUTimeEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	var total, idle int64
+	for i := range end {
+		total += end[i] - start[i]
+	}
+	idle = end[4] - start[4]
+
+	info.CPUUsage = percentage(float64(total-idle), float64(total))
+
+	info.MemoryTotalBytes = resp.PageCount * resp.PageSize
+	used := resp.PageCount - resp.InactiveCount - resp.CacheCount - resp.FreeCount
+	info.MemoryUsage = percentage(float64(used), float64(resp.PageCount))
+
+	// dev.cpu.0.temperature (e.g. '45.0C') or hw.acpi.thermal.tz0.temperature, empty when no sensor is available
+	if temperature := strings.TrimSuffix(strings.TrimSpace(resp.Temperature), "C"); temperature != "" {
+		value, err := strconv.ParseFloat(temperature, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w, temperature '%s', %w", ErrUnableToParse, resp.Temperature, err)
+		}
+		info.Temperature = &value
+	}
+
+	info.DiskTotalBytes = int64(resp.DiskTotalBytes)
+	info.DiskUsage = percentage(resp.DiskTotalBytes-resp.DiskFreeBytes, resp.DiskTotalBytes)
+
+	return &info, nil
+}
+
+// GetSystemInfo reads status information of the system, CPU usage is sampled over half a second.
+func (pf *Client) GetSystemInfo(ctx context.Context) (*SystemInfo, error) {
+	command := "$s = function($name) { return get_single_sysctl($name); };" +
+		"$start = $s('kern.cp_time'); usleep(500000); $end = $s('kern.cp_time');" +
+		"$temperature = $s('dev.cpu.0.temperature');" +
+		"if ($temperature == '') { $temperature = $s('hw.acpi.thermal.tz0.temperature'); }" +
+		"print_r(json_encode(array(" +
+		"'version' => file_get_contents('/etc/version')," +
+		"'now' => time()," +
+		"'boottime' => $s('kern.boottime')," +
+		"'ncpu' => (int) $s('hw.ncpu')," +
+		"'cp_time_start' => $start," +
+		"'cp_time_end' => $end," +
+		"'page_count' => (int) $s('vm.stats.vm.v_page_count')," +
+		"'inactive_count' => (int) $s('vm.stats.vm.v_inactive_count')," +
+		"'cache_count' => (int) $s('vm.stats.vm.v_cache_count')," +
+		"'free_count' => (int) $s('vm.stats.vm.v_free_count')," +
+		"'page_size' => (int) $s('hw.pagesize')," +
+		"'temperature' => $temperature," +
+		"'disk_total' => disk_total_space('/')," +
+		"'disk_free' => disk_free_space('/')," +
+		"'loadavg' => sys_getloadavg())));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w system info, %w", ErrGetOperationFailed, err)
+	}
+
+	var infoResp systemInfoResponse
+	err = json.Unmarshal(b, &infoResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w system info, %w, %w", ErrGetOperationFailed, ErrUnableToParse, err)
+	}
+
+	info, err := parseSystemInfoResponse(infoResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w system info, %w", ErrGetOperationFailed, err)
+	}
+
+	return info, nil
+}
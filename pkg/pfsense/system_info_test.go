@@ -0,0 +1,93 @@
+package pfsense
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+const testSystemInfoResponse = `{
+  "version": "2.7.2-RELEASE\n",
+  "now": 1700086400,
+  "boottime": "{ sec = 1700000000, usec = 123456 } Tue Nov 14 22:13:20 2023",
+  "ncpu": 4,
+  "cp_time_start": "1000 0 500 100 8400",
+  "cp_time_end": "1200 0 600 100 9100",
+  "page_count": 1000000,
+  "inactive_count": 200000,
+  "cache_count": 0,
+  "free_count": 300000,
+  "page_size": 4096,
+  "temperature": "45.0C",
+  "disk_total": 100000000000,
+  "disk_free": 75000000000,
+  "loadavg": [0.52, 0.38, 0.31]
+}`
+
+func TestParseSystemInfoResponse(t *testing.T) {
+	var resp systemInfoResponse
+	if err := json.Unmarshal([]byte(testSystemInfoResponse), &resp); err != nil {
+		t.Fatalf("unable to unmarshal sample response, %s", err)
+	}
+
+	info, err := parseSystemInfoResponse(resp)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if info.Version != "2.7.2-RELEASE" || info.UptimeSeconds != 86400 || info.CPUCount != 4 {
+		t.Errorf("unexpected version, uptime or CPU count %+v", *info)
+	}
+
+	if info.CPUUsage != 30 || info.MemoryUsage != 50 || info.DiskUsage != 25 {
+		t.Errorf("CPU, memory and disk usage = %v, %v, %v, want 30, 50, 25", info.CPUUsage, info.MemoryUsage, info.DiskUsage)
+	}
+
+	if info.MemoryTotalBytes != 4096000000 || info.DiskTotalBytes != 100000000000 {
+		t.Errorf("memory and disk total = %d, %d", info.MemoryTotalBytes, info.DiskTotalBytes)
+	}
+
+	if info.Temperature == nil || *info.Temperature != 45 {
+		t.Errorf("temperature = %v, want 45", info.Temperature)
+	}
+
+	if !slices.Equal(info.LoadAverage, []float64{0.52, 0.38, 0.31}) {
+		t.Errorf("load average = %v", info.LoadAverage)
+	}
+
+	// systems without a temperature sensor
+	resp.Temperature = ""
+	info, err = parseSystemInfoResponse(resp)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if info.Temperature != nil {
+		t.Errorf("expected no temperature, got %v", *info.Temperature)
+	}
+}
+
+func TestParseSystemInfoResponseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*systemInfoResponse)
+	}{
+		{"boot time", func(resp *systemInfoResponse) { resp.BootTime = "" }},
+		{"CPU time fields", func(resp *systemInfoResponse) { resp.CPUTimeEnd = "1200 0 600 100" }},
+		{"temperature", func(resp *systemInfoResponse) { resp.Temperature = "hot" }},
+	}
+
+	for _, tt := range tests {
+		var resp systemInfoResponse
+		if err := json.Unmarshal([]byte(testSystemInfoResponse), &resp); err != nil {
+			t.Fatalf("unable to unmarshal sample response, %s", err)
+		}
+
+		tt.modify(&resp)
+
+		if _, err := parseSystemInfoResponse(resp); !errors.Is(err, ErrUnableToParse) {
+			t.Errorf("%s: expected unable to parse error, got %v", tt.name, err)
+		}
+	}
+}
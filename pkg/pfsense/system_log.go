@@ -0,0 +1,44 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+)
+
+var (
+	ErrClearSystemLog = errors.New("failed to clear log")
+)
+
+func SystemLogs() []string {
+	return []string{"system", "filter", "dhcpd"}
+}
+
+func (pf *Client) ClearSystemLog(ctx context.Context, log string) error {
+	if !slices.Contains(SystemLogs(), log) {
+		return fmt.Errorf("%w, %w, log must be one of %v", ErrClearSystemLog, ErrClientValidation, SystemLogs())
+	}
+
+	u := url.URL{Path: "status_logs.php"}
+	q := u.Query()
+	q.Set("logfile", log)
+	u.RawQuery = q.Encode()
+	v := url.Values{
+		"logfile": {log},
+		"clear":   {"Clear log"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrClearSystemLog, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClearSystemLog(t *testing.T) {
+	var cleared []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_logs.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.PostFormValue("clear") == "" {
+			t.Errorf("expected clear log form submission, got %s %v", r.Method, r.PostForm)
+		}
+
+		if r.URL.Query().Get("logfile") != r.PostFormValue("logfile") {
+			t.Errorf("expected log file '%s' in query, got '%s'", r.PostFormValue("logfile"), r.URL.Query().Get("logfile"))
+		}
+
+		cleared = append(cleared, r.PostFormValue("logfile"))
+		fmt.Fprint(w, testDashboardPage)
+	})
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	for _, log := range SystemLogs() {
+		if err := pf.ClearSystemLog(context.Background(), log); err != nil {
+			t.Errorf("%s: unexpected error, %s", log, err)
+		}
+	}
+
+	if len(cleared) != len(SystemLogs()) {
+		t.Errorf("expected %d logs cleared, got %v", len(SystemLogs()), cleared)
+	}
+
+	err = pf.ClearSystemLog(context.Background(), "../system")
+	if !errors.Is(err, ErrClientValidation) || !errors.Is(err, ErrClearSystemLog) {
+		t.Errorf("expected client validation error, got %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	ntpDefaultOrphanStratum = 12
+	ntpMaxTimeServers       = 10
+	ntpDefaultTimezone      = "Etc/UTC"
+)
+
+type ntpSettingsResponse struct {
+	TimeServers      string `json:"timeservers"`
+	ListenInterfaces string `json:"interface"`
+	OrphanStratum    string `json:"orphan"`
+	Timezone         string `json:"timezone"`
+}
+
+type NTPSettings struct {
+	TimeServers      []string
+	ListenInterfaces []string
+	OrphanStratum    int
+	Timezone         string
+}
+
+func (s *NTPSettings) SetTimeServers(servers []string) error {
+	if len(servers) > ntpMaxTimeServers {
+		return fmt.Errorf("%w, at most %d time servers are supported", ErrClientValidation, ntpMaxTimeServers)
+	}
+
+	var isValidHostname = regexp.MustCompile(`^([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`).MatchString
+	var errs []error
+	for _, server := range servers {
+		if _, err := netip.ParseAddr(server); err != nil && !isValidHostname(server) {
+			errs = append(errs, fmt.Errorf("%w, time server '%s' must be a hostname or IP address", ErrClientValidation, server))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	s.TimeServers = servers
+
+	return nil
+}
+
+// SetListenInterfaces sets the interfaces NTP listens on, none for all interfaces.
+func (s *NTPSettings) SetListenInterfaces(ifaces []string) error {
+	var isValidInterface = regexp.MustCompile(`^[a-zA-Z0-9_]+$`).MatchString
+	var errs []error
+	for _, iface := range ifaces {
+		if !isValidInterface(iface) {
+			errs = append(errs, fmt.Errorf("%w, interface '%s' must be an interface name (e.g. 'lan', 'opt1', 'lo0')", ErrClientValidation, iface))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	s.ListenInterfaces = ifaces
+
+	return nil
+}
+
+func (s *NTPSettings) SetOrphanStratum(stratum int) error {
+	if stratum < 1 || stratum > 15 {
+		return fmt.Errorf("%w, orphan mode stratum must be between 1 and 15", ErrClientValidation)
+	}
+
+	s.OrphanStratum = stratum
+
+	return nil
+}
+
+func (s *NTPSettings) SetTimezone(timezone string) error {
+	var isValidTimezone = regexp.MustCompile(`^[A-Za-z_]+(/[A-Za-z0-9_+-]+)*$`).MatchString
+	if !isValidTimezone(timezone) {
+		return fmt.Errorf("%w, timezone must be a tz database name (e.g. 'Etc/UTC', 'America/New_York')", ErrClientValidation)
+	}
+
+	s.Timezone = timezone
+
+	return nil
+}
+
+func (pf *Client) getSystemNTPSettings(ctx context.Context) (*NTPSettings, error) {
+	command := "print_r(json_encode(array(" +
+		"'timeservers' => $config['system']['timeservers']," +
+		"'interface' => $config['ntpd']['interface']," +
+		"'orphan' => $config['ntpd']['orphan']," +
+		"'timezone' => $config['system']['timezone'])));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var sResp ntpSettingsResponse
+	err = json.Unmarshal(b, &sResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var s NTPSettings
+
+	err = s.SetTimeServers(strings.Fields(sResp.TimeServers))
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings response, %w", ErrUnableToParse, err)
+	}
+
+	err = s.SetListenInterfaces(removeEmptyStrings(strings.Split(sResp.ListenInterfaces, ",")))
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings response, %w", ErrUnableToParse, err)
+	}
+
+	orphanStratum := ntpDefaultOrphanStratum
+	if sResp.OrphanStratum != "" {
+		orphanStratum, err = strconv.Atoi(sResp.OrphanStratum)
+		if err != nil {
+			return nil, fmt.Errorf("%w NTP settings response, %w", ErrUnableToParse, err)
+		}
+	}
+
+	err = s.SetOrphanStratum(orphanStratum)
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings response, %w", ErrUnableToParse, err)
+	}
+
+	timezone := sResp.Timezone
+	if timezone == "" {
+		timezone = ntpDefaultTimezone
+	}
+
+	err = s.SetTimezone(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings response, %w", ErrUnableToParse, err)
+	}
+
+	return &s, nil
+}
+
+func (pf *Client) GetSystemNTPSettings(ctx context.Context) (*NTPSettings, error) {
+	pf.mutexes.SystemNTP.Lock()
+	defer pf.mutexes.SystemNTP.Unlock()
+
+	s, err := pf.getSystemNTPSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return s, nil
+}
+
+func (pf *Client) updateNTPServices(ctx context.Context, sReq NTPSettings) error {
+	u := url.URL{Path: "services_ntpd.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	v := scrapeHTMLFormValues(doc)
+
+	// keep the prefer, noselect and pool flags of servers that remain configured
+	isServerField := regexp.MustCompile(`^(server|servprefer|servselect|servistype)([0-9]+)$`)
+	flags := map[string]map[string]string{}
+	for key := range v {
+		match := isServerField.FindStringSubmatch(key)
+		if match == nil || match[1] == "server" {
+			continue
+		}
+
+		server := v.Get("server" + match[2])
+		if flags[server] == nil {
+			flags[server] = map[string]string{}
+		}
+		flags[server][match[1]] = v.Get(key)
+	}
+
+	for key := range v {
+		if isServerField.MatchString(key) {
+			v.Del(key)
+		}
+	}
+
+	for i, server := range sReq.TimeServers {
+		v.Set(fmt.Sprintf("server%d", i), server)
+		for flag, value := range flags[server] {
+			v.Set(fmt.Sprintf("%s%d", flag, i), value)
+		}
+	}
+
+	v.Del("interface[]")
+	for _, iface := range sReq.ListenInterfaces {
+		v.Add("interface[]", iface)
+	}
+
+	v.Set("orphan", strconv.Itoa(sReq.OrphanStratum))
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) updateTimezone(ctx context.Context, timezone string) error {
+	u := url.URL{Path: "system.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v.Set("timezone", timezone)
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) UpdateSystemNTPSettings(ctx context.Context, sReq NTPSettings) (*NTPSettings, error) {
+	pf.mutexes.SystemNTP.Lock()
+	defer pf.mutexes.SystemNTP.Unlock()
+
+	current, err := pf.getSystemNTPSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = pf.updateNTPServices(ctx, sReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	// the timezone is part of the general setup page, only submit it when changed
+	if current.Timezone != sReq.Timezone {
+		err = pf.updateTimezone(ctx, sReq.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("%w NTP settings, %w", ErrUpdateOperationFailed, err)
+		}
+	}
+
+	s, err := pf.getSystemNTPSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w NTP settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return s, nil
+}
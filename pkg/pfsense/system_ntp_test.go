@@ -0,0 +1,131 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestUpdateSystemNTPSettings(t *testing.T) {
+	var mutex sync.Mutex
+	config := map[string]string{"timeservers": "0.pfsense.pool.ntp.org old.example.com", "interface": "", "orphan": "", "timezone": "Etc/UTC"}
+	var ntpPosted url.Values
+	var timezonePosts int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/services_ntpd.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+			ntpPosted = r.PostForm
+
+			var servers []string
+			for i := 0; r.PostForm.Has(fmt.Sprintf("server%d", i)); i++ {
+				servers = append(servers, r.PostFormValue(fmt.Sprintf("server%d", i)))
+			}
+
+			config["timeservers"] = strings.Join(servers, " ")
+			config["interface"] = strings.Join(r.PostForm["interface[]"], ",")
+			config["orphan"] = r.PostFormValue("orphan")
+		}
+
+		fmt.Fprint(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="server0" type="text" value="0.pfsense.pool.ntp.org" />
+<input name="servistype0" type="checkbox" value="on" checked="checked" />
+<input name="server1" type="text" value="old.example.com" />
+<input name="servprefer1" type="checkbox" value="on" checked="checked" />
+<input name="statsgraph" type="checkbox" value="yes" checked="checked" />
+</form></body></html>`)
+	})
+	mux.HandleFunc("/system.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.Method == http.MethodPost {
+			timezonePosts++
+			config["timezone"] = r.PostFormValue("timezone")
+
+			if r.PostFormValue("hostname") != "pfsense" {
+				t.Errorf("expected unmanaged general settings to be submitted unchanged, got %v", r.PostForm)
+			}
+		}
+
+		fmt.Fprint(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="hostname" type="text" value="pfsense" />
+<select name="timezone"><option value="Etc/UTC" selected="selected">Etc/UTC</option><option value="America/New_York">America/New_York</option></select>
+</form></body></html>`)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(config)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var sReq NTPSettings
+	_ = sReq.SetTimeServers([]string{"time.example.com", "0.pfsense.pool.ntp.org", "192.0.2.123"})
+	_ = sReq.SetListenInterfaces([]string{"lan", "opt1"})
+	_ = sReq.SetOrphanStratum(10)
+	_ = sReq.SetTimezone("America/New_York")
+
+	for range 2 {
+		s, err := pf.UpdateSystemNTPSettings(context.Background(), sReq)
+		if err != nil {
+			t.Fatalf("unexpected error, %s", err)
+		}
+
+		if !slices.Equal(s.TimeServers, sReq.TimeServers) || !slices.Equal(s.ListenInterfaces, sReq.ListenInterfaces) ||
+			s.OrphanStratum != sReq.OrphanStratum || s.Timezone != sReq.Timezone {
+			t.Errorf("read back %+v, want %+v", *s, sReq)
+		}
+	}
+
+	// the timezone is only submitted when changed
+	if timezonePosts != 1 {
+		t.Errorf("expected 1 timezone submission, got %d", timezonePosts)
+	}
+
+	// server flags follow the server to its new position, flags of removed servers are dropped
+	want := url.Values{
+		"server0":     {"time.example.com"},
+		"server1":     {"0.pfsense.pool.ntp.org"},
+		"servistype1": {"on"},
+		"server2":     {"192.0.2.123"},
+	}
+
+	for key, value := range ntpPosted {
+		if strings.HasPrefix(key, "serv") && !slices.Equal(value, want[key]) {
+			t.Errorf("posted %s = %v, want %v", key, value, want[key])
+		}
+	}
+
+	for key := range want {
+		if !ntpPosted.Has(key) {
+			t.Errorf("expected %s to be posted", key)
+		}
+	}
+
+	if ntpPosted.Get("statsgraph") != "yes" {
+		t.Errorf("expected unmanaged NTP settings to be submitted unchanged, got %v", ntpPosted)
+	}
+}
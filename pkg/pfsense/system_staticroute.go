@@ -0,0 +1,292 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+var (
+	ErrApplyStaticRouteChange = errors.New("failed to apply static route changes")
+)
+
+type staticRouteResponse struct {
+	Network     string  `json:"network"`
+	Gateway     string  `json:"gateway"`
+	Description string  `json:"descr"`
+	Disabled    *string `json:"disabled"`
+}
+
+type StaticRoute struct {
+	Network     netip.Prefix
+	Gateway     string
+	Description string
+	Disabled    bool
+}
+
+func (route *StaticRoute) SetNetwork(network string) error {
+	prefix, err := netip.ParsePrefix(network)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrClientValidation, err)
+	}
+
+	if prefix != prefix.Masked() {
+		return fmt.Errorf("%w, network must not have host bits set (e.g. '%s')", ErrClientValidation, prefix.Masked())
+	}
+
+	route.Network = prefix
+
+	return nil
+}
+
+func (route *StaticRoute) SetGateway(gateway string) error {
+	var isValidGateway = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`).MatchString
+	if !isValidGateway(gateway) {
+		return fmt.Errorf("%w, gateway must be the name of a gateway or gateway group", ErrClientValidation)
+	}
+
+	route.Gateway = gateway
+
+	return nil
+}
+
+func (route *StaticRoute) SetDescription(description string) error {
+	route.Description = description
+
+	return nil
+}
+
+func (route *StaticRoute) SetDisabled(disabled bool) error {
+	route.Disabled = disabled
+
+	return nil
+}
+
+type StaticRoutes []StaticRoute
+
+func (routes StaticRoutes) GetByNetwork(network netip.Prefix) (*StaticRoute, error) {
+	for _, route := range routes {
+		if route.Network == network {
+			return &route, nil
+		}
+	}
+	return nil, fmt.Errorf("static route %w with network '%s'", ErrNotFound, network)
+}
+
+func (routes StaticRoutes) GetControlIDByNetwork(network netip.Prefix) (*int, error) {
+	return getControlID(routes, func(route StaticRoute) bool { return route.Network == network },
+		positionalControlID, "static route", fmt.Sprintf("network '%s'", network))
+}
+
+func (pf *Client) getStaticRoutes(ctx context.Context) (*StaticRoutes, error) {
+	command := "$output = array();" +
+		"if (is_array($config['staticroutes']['route'])) {" +
+		"foreach ($config['staticroutes']['route'] as $v) { array_push($output, $v); }" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var routeResp []staticRouteResponse
+	err = json.Unmarshal(b, &routeResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var routes StaticRoutes
+	for _, resp := range routeResp {
+		var route StaticRoute
+		var err error
+
+		err = route.SetNetwork(resp.Network)
+		if err != nil {
+			return nil, fmt.Errorf("%w static route response, %w", ErrUnableToParse, err)
+		}
+
+		err = route.SetGateway(resp.Gateway)
+		if err != nil {
+			return nil, fmt.Errorf("%w static route response, %w", ErrUnableToParse, err)
+		}
+
+		err = route.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w static route response, %w", ErrUnableToParse, err)
+		}
+
+		err = route.SetDisabled(resp.Disabled != nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w static route response, %w", ErrUnableToParse, err)
+		}
+
+		routes = append(routes, route)
+	}
+
+	return &routes, nil
+}
+
+func (pf *Client) GetStaticRoutes(ctx context.Context) (*StaticRoutes, error) {
+	pf.mutexes.SystemStaticRoute.Lock()
+	defer pf.mutexes.SystemStaticRoute.Unlock()
+
+	routes, err := pf.getStaticRoutes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w static routes, %w", ErrGetOperationFailed, err)
+	}
+
+	return routes, nil
+}
+
+func (pf *Client) GetStaticRoute(ctx context.Context, network netip.Prefix) (*StaticRoute, error) {
+	pf.mutexes.SystemStaticRoute.Lock()
+	defer pf.mutexes.SystemStaticRoute.Unlock()
+
+	routes, err := pf.getStaticRoutes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w static route (network '%s'), %w", ErrGetOperationFailed, network, err)
+	}
+
+	return routes.GetByNetwork(network)
+}
+
+func (pf *Client) createOrUpdateStaticRoute(ctx context.Context, routeReq StaticRoute, controlID *int) (*StaticRoute, error) {
+	names, err := pf.getGatewayNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = names.validate(routeReq.Gateway, routeReq.Network.Addr().Is4())
+	if err != nil {
+		return nil, err
+	}
+
+	u := url.URL{Path: "system_routes_edit.php"}
+	v := url.Values{
+		"network":        {routeReq.Network.Addr().String()},
+		"network_subnet": {strconv.Itoa(routeReq.Network.Bits())},
+		"gateway":        {routeReq.Gateway},
+		"descr":          {routeReq.Description},
+		"save":           {"Save"},
+	}
+
+	if routeReq.Disabled {
+		v.Set("disabled", "yes")
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := pf.getStaticRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	route, err := routes.GetByNetwork(routeReq.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	return route, nil
+}
+
+func (pf *Client) CreateStaticRoute(ctx context.Context, routeReq StaticRoute) (*StaticRoute, error) {
+	pf.mutexes.SystemStaticRoute.Lock()
+	defer pf.mutexes.SystemStaticRoute.Unlock()
+
+	route, err := pf.createOrUpdateStaticRoute(ctx, routeReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w static route, %w", ErrCreateOperationFailed, err)
+	}
+
+	return route, nil
+}
+
+func (pf *Client) UpdateStaticRoute(ctx context.Context, routeReq StaticRoute) (*StaticRoute, error) {
+	pf.mutexes.SystemStaticRoute.Lock()
+	defer pf.mutexes.SystemStaticRoute.Unlock()
+
+	routes, err := pf.getStaticRoutes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w static route, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := routes.GetControlIDByNetwork(routeReq.Network)
+	if err != nil {
+		return nil, fmt.Errorf("%w static route, %w", ErrUpdateOperationFailed, err)
+	}
+
+	route, err := pf.createOrUpdateStaticRoute(ctx, routeReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w static route, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return route, nil
+}
+
+func (pf *Client) DeleteStaticRoute(ctx context.Context, network netip.Prefix) error {
+	pf.mutexes.SystemStaticRoute.Lock()
+	defer pf.mutexes.SystemStaticRoute.Unlock()
+
+	routes, err := pf.getStaticRoutes(ctx)
+	if err != nil {
+		return fmt.Errorf("%w static route, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := routes.GetControlIDByNetwork(network)
+	if err != nil {
+		return fmt.Errorf("%w static route, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_routes.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w static route, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) ApplyStaticRouteChanges(ctx context.Context) error {
+	pf.mutexes.SystemStaticRouteApply.Lock()
+	defer pf.mutexes.SystemStaticRouteApply.Unlock()
+
+	u := url.URL{Path: "system_routes.php"}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplyStaticRouteChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+)
+
+const syslogMaxRemoteServers = 3
+
+// syslogCategories maps each log category to its form field and config key.
+var syslogCategories = []struct {
+	name      string
+	formKey   string
+	configKey string
+}{
+	{"everything", "logall", "logall"},
+	{"system", "system", "system"},
+	{"firewall", "logfilter", "filter"},
+	{"dhcp", "dhcp", "dhcp"},
+	{"auth", "auth", "auth"},
+	{"portal_auth", "portalauth", "portalauth"},
+	{"vpn", "vpn", "vpn"},
+	{"gateway_monitor", "dpinger", "dpinger"},
+	{"wireless", "hostapd", "hostapd"},
+	{"resolver", "resolver", "resolver"},
+	{"ppp", "ppp", "ppp"},
+	{"routing", "routing", "routing"},
+	{"ntp", "ntpd", "ntpd"},
+}
+
+func SyslogCategories() []string {
+	var names []string
+	for _, category := range syslogCategories {
+		names = append(names, category.name)
+	}
+
+	return names
+}
+
+func SyslogIPProtocols() []string {
+	return []string{"ipv4", "ipv6"}
+}
+
+type SyslogSettings struct {
+	EnableRemote  bool
+	RemoteServers []string
+	Categories    []string
+	IPProtocol    string
+}
+
+// validateIPAddressPort accepts an IP address with an optional port (IPv6 addresses with a port in brackets).
+func validateIPAddressPort(s string) error {
+	if _, err := netip.ParseAddr(s); err == nil {
+		return nil
+	}
+
+	if _, err := netip.ParseAddrPort(s); err != nil {
+		return fmt.Errorf("%w, '%s' must be an IP address with an optional port (e.g. '10.0.0.1:514', '[2001:db8::1]:514')", ErrClientValidation, s)
+	}
+
+	return nil
+}
+
+func (s *SyslogSettings) SetEnableRemote(enable bool) error {
+	s.EnableRemote = enable
+
+	return nil
+}
+
+func (s *SyslogSettings) SetRemoteServers(servers []string) error {
+	if len(servers) > syslogMaxRemoteServers {
+		return fmt.Errorf("%w, at most %d remote servers are supported", ErrClientValidation, syslogMaxRemoteServers)
+	}
+
+	var errs []error
+	for _, server := range servers {
+		err := validateIPAddressPort(server)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	s.RemoteServers = servers
+
+	return nil
+}
+
+func (s *SyslogSettings) SetCategories(categories []string) error {
+	var errs []error
+	for _, category := range categories {
+		if !slices.Contains(SyslogCategories(), category) {
+			errs = append(errs, fmt.Errorf("%w, log category '%s' must be one of %v", ErrClientValidation, category, SyslogCategories()))
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	s.Categories = categories
+
+	return nil
+}
+
+func (s *SyslogSettings) SetIPProtocol(ipProtocol string) error {
+	if !slices.Contains(SyslogIPProtocols(), ipProtocol) {
+		return fmt.Errorf("%w, IP protocol must be one of %v", ErrClientValidation, SyslogIPProtocols())
+	}
+
+	s.IPProtocol = ipProtocol
+
+	return nil
+}
+
+func (pf *Client) getSystemSyslogSettings(ctx context.Context) (*SyslogSettings, error) {
+	b, err := pf.getConfigJSON(ctx, "['syslog']")
+	if err != nil {
+		return nil, err
+	}
+
+	// flags are stored as empty elements, only their presence matters
+	var sResp map[string]any
+	err = json.Unmarshal(b, &sResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	str := func(key string) string {
+		value, _ := sResp[key].(string)
+		return value
+	}
+
+	var s SyslogSettings
+
+	_, enabled := sResp["enable"]
+	err = s.SetEnableRemote(enabled)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings response, %w", ErrUnableToParse, err)
+	}
+
+	err = s.SetRemoteServers(removeEmptyStrings([]string{str("remoteserver"), str("remoteserver2"), str("remoteserver3")}))
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings response, %w", ErrUnableToParse, err)
+	}
+
+	categories := []string{}
+	for _, category := range syslogCategories {
+		if _, ok := sResp[category.configKey]; ok {
+			categories = append(categories, category.name)
+		}
+	}
+
+	err = s.SetCategories(categories)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings response, %w", ErrUnableToParse, err)
+	}
+
+	ipProtocol := str("ipproto")
+	if ipProtocol == "" {
+		ipProtocol = "ipv4"
+	}
+
+	err = s.SetIPProtocol(ipProtocol)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings response, %w", ErrUnableToParse, err)
+	}
+
+	return &s, nil
+}
+
+func (pf *Client) GetSystemSyslogSettings(ctx context.Context) (*SyslogSettings, error) {
+	pf.mutexes.SystemSyslog.Lock()
+	defer pf.mutexes.SystemSyslog.Unlock()
+
+	s, err := pf.getSystemSyslogSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings, %w", ErrGetOperationFailed, err)
+	}
+
+	return s, nil
+}
+
+func (pf *Client) UpdateSystemSyslogSettings(ctx context.Context, sReq SyslogSettings) (*SyslogSettings, error) {
+	pf.mutexes.SystemSyslog.Lock()
+	defer pf.mutexes.SystemSyslog.Unlock()
+
+	u := url.URL{Path: "status_logs_settings.php"}
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	setFormCheckbox(v, "enable", sReq.EnableRemote)
+	v.Set("ipproto", sReq.IPProtocol)
+
+	for i, key := range []string{"remoteserver", "remoteserver2", "remoteserver3"} {
+		v.Del(key)
+		if i < len(sReq.RemoteServers) {
+			v.Set(key, sReq.RemoteServers[i])
+		}
+	}
+
+	for _, category := range syslogCategories {
+		setFormCheckbox(v, category.formKey, slices.Contains(sReq.Categories, category.name))
+	}
+
+	v.Set("save", "Save")
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	s, err := pf.getSystemSyslogSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w syslog settings, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,142 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSyslogSetRemoteServers(t *testing.T) {
+	tests := []struct {
+		servers []string
+		valid   bool
+	}{
+		{[]string{"10.0.0.1"}, true},
+		{[]string{"10.0.0.1:514", "[2001:db8::1]:514"}, true},
+		{[]string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, true},
+		{[]string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4"}, false},
+		{[]string{"logs.example.com:514"}, false},
+		{[]string{"10.0.0.1:port"}, false},
+	}
+
+	for _, tt := range tests {
+		var s SyslogSettings
+		err := s.SetRemoteServers(tt.servers)
+		if tt.valid && err != nil {
+			t.Errorf("SetRemoteServers(%v) unexpected error, %s", tt.servers, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetRemoteServers(%v) expected validation error, got %v", tt.servers, err)
+		}
+	}
+}
+
+func TestUpdateSystemSyslogSettingsRemote(t *testing.T) {
+	var mutex sync.Mutex
+	config := map[string]string{"nentries": "500", "logall": "", "ipproto": "ipv4"}
+	var posted url.Values
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status_logs_settings.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.Method == http.MethodPost {
+			_ = r.ParseForm()
+			posted = r.PostForm
+
+			config = map[string]string{"nentries": r.PostFormValue("nentries"), "ipproto": r.PostFormValue("ipproto")}
+			if r.PostForm.Has("enable") {
+				config["enable"] = ""
+			}
+
+			for _, key := range []string{"remoteserver", "remoteserver2", "remoteserver3"} {
+				if r.PostForm.Has(key) {
+					config[key] = r.PostFormValue(key)
+				}
+			}
+
+			for _, category := range syslogCategories {
+				if r.PostForm.Has(category.formKey) {
+					config[category.configKey] = ""
+				}
+			}
+		}
+
+		fmt.Fprint(w, `<html><body><form method="post">
+<input name="__csrf_magic" type="hidden" value="sid:token" />
+<input name="nentries" type="text" value="500" />
+<input name="enable" type="checkbox" value="yes" />
+<select name="ipproto"><option value="ipv4" selected="selected">IPv4</option><option value="ipv6">IPv6</option></select>
+<input name="remoteserver" type="text" value="" />
+<input name="remoteserver2" type="text" value="" />
+<input name="remoteserver3" type="text" value="" />
+<input name="logall" type="checkbox" value="yes" checked="checked" />
+</form></body></html>`)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(config)
+		return string(b)
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	var sReq SyslogSettings
+	_ = sReq.SetEnableRemote(true)
+	_ = sReq.SetRemoteServers([]string{"192.0.2.10:514", "192.0.2.11"})
+	_ = sReq.SetCategories([]string{"system", "firewall", "auth"})
+	_ = sReq.SetIPProtocol("ipv4")
+
+	s, err := pf.UpdateSystemSyslogSettings(context.Background(), sReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if !s.EnableRemote || !slices.Equal(s.RemoteServers, sReq.RemoteServers) || !slices.Equal(s.Categories, sReq.Categories) ||
+		s.IPProtocol != sReq.IPProtocol {
+		t.Errorf("read back %+v, want %+v", *s, sReq)
+	}
+
+	// categories persist on a later read
+	s, err = pf.GetSystemSyslogSettings(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if !slices.Equal(s.Categories, sReq.Categories) {
+		t.Errorf("categories = %v, want %v", s.Categories, sReq.Categories)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// the unused third collector is cleared and unselected categories are unchecked
+	if posted.Has("remoteserver3") || posted.Has("logall") {
+		t.Errorf("expected unused fields to be omitted, got %v", posted)
+	}
+
+	if posted.Get("logfilter") != "yes" {
+		t.Errorf("expected firewall category to post 'logfilter', got %v", posted)
+	}
+
+	if posted.Get("nentries") != "500" {
+		t.Errorf("expected unmanaged log settings to be submitted unchanged, got %v", posted)
+	}
+}
@@ -0,0 +1,294 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+var (
+	ErrApplySystemTunableChange = errors.New("failed to apply system tunable changes")
+)
+
+type tunableResponse struct {
+	Name        string `json:"tunable"`
+	Value       string `json:"value"`
+	Description string `json:"descr"`
+}
+
+type Tunable struct {
+	Name        string
+	Value       string
+	Description string
+}
+
+func (t *Tunable) SetName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9_]+(\.[a-zA-Z0-9_%-]+)*$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, tunable name must be a dot separated sysctl name", ErrClientValidation)
+	}
+
+	t.Name = name
+
+	return nil
+}
+
+func (t *Tunable) SetValue(value string) error {
+	if value == "" {
+		return fmt.Errorf("%w, tunable value required", ErrClientValidation)
+	}
+
+	t.Value = value
+
+	return nil
+}
+
+func (t *Tunable) SetDescription(description string) error {
+	t.Description = description
+
+	return nil
+}
+
+type Tunables []Tunable
+
+func (ts Tunables) GetByName(name string) (*Tunable, error) {
+	for _, t := range ts {
+		if t.Name == name {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("tunable %w with name '%s'", ErrNotFound, name)
+}
+
+func (ts Tunables) GetControlIDByName(name string) (*int, error) {
+	return getControlID(ts, func(t Tunable) bool { return t.Name == name },
+		positionalControlID, "tunable", fmt.Sprintf("name '%s'", name))
+}
+
+func parseTunablesResponse(b []byte) (*Tunables, error) {
+	var tResp []tunableResponse
+	err := json.Unmarshal(b, &tResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var tunables Tunables
+	for _, resp := range tResp {
+		var tunable Tunable
+		var err error
+
+		// tunables may be added outside of Terraform (e.g. by packages), the name and value are only validated on write
+		tunable.Name = resp.Name
+		tunable.Value = resp.Value
+
+		err = tunable.SetDescription(resp.Description)
+		if err != nil {
+			return nil, fmt.Errorf("%w tunable response, %w", ErrUnableToParse, err)
+		}
+
+		tunables = append(tunables, tunable)
+	}
+
+	return &tunables, nil
+}
+
+func (pf *Client) getSystemTunables(ctx context.Context) (*Tunables, error) {
+	b, err := pf.getConfigJSON(ctx, "['sysctl']['item']")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseTunablesResponse(b)
+}
+
+func (pf *Client) GetSystemTunables(ctx context.Context) (*Tunables, error) {
+	pf.mutexes.SystemTunable.Lock()
+	defer pf.mutexes.SystemTunable.Unlock()
+
+	tunables, err := pf.getSystemTunables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunables, %w", ErrGetOperationFailed, err)
+	}
+
+	return tunables, nil
+}
+
+func (pf *Client) GetSystemTunable(ctx context.Context, name string) (*Tunable, error) {
+	pf.mutexes.SystemTunable.Lock()
+	defer pf.mutexes.SystemTunable.Unlock()
+
+	tunables, err := pf.getSystemTunables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunable (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return tunables.GetByName(name)
+}
+
+// UpdateSystemTunables sets the value of each requested tunable and removes the named tunables in a single config
+// write, tunables not referenced are left untouched. Existing descriptions are preserved.
+func (pf *Client) UpdateSystemTunables(ctx context.Context, tunablesReq Tunables, remove []string) (*Tunables, error) {
+	pf.mutexes.SystemTunable.Lock()
+	defer pf.mutexes.SystemTunable.Unlock()
+
+	type tunableRequest struct {
+		Set    []tunableResponse `json:"set"`
+		Remove []string          `json:"remove"`
+	}
+
+	req := tunableRequest{Set: []tunableResponse{}, Remove: []string{}}
+	req.Remove = append(req.Remove, remove...)
+	for _, t := range tunablesReq {
+		req.Set = append(req.Set, tunableResponse{Name: t.Name, Value: t.Value, Description: t.Description})
+	}
+
+	reqJSON, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunables, %w", ErrUpdateOperationFailed, err)
+	}
+
+	command := fmt.Sprintf("$req = json_decode(base64_decode('%s'), true);", base64.StdEncoding.EncodeToString(reqJSON)) +
+		"if (!is_array($config['sysctl'])) { $config['sysctl'] = array(); }" +
+		"if (!is_array($config['sysctl']['item'])) { $config['sysctl']['item'] = array(); }" +
+		"$items = &$config['sysctl']['item'];" +
+		"$items = array_values(array_filter($items, function($v) use ($req) { return !in_array($v['tunable'], $req['remove'], true); }));" +
+		"foreach ($req['set'] as $t) {" +
+		"$found = false;" +
+		"foreach ($items as $k => $v) { if ($v['tunable'] === $t['tunable']) { $items[$k]['value'] = $t['value']; $found = true; } }" +
+		"if (!$found) { $items[] = $t; }" +
+		"}" +
+		"write_config('Terraform: updated system tunables');" +
+		"print_r(json_encode($items));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunables, %w", ErrUpdateOperationFailed, err)
+	}
+
+	tunables, err := parseTunablesResponse(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunables, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return tunables, nil
+}
+
+func (pf *Client) createOrUpdateSystemTunable(ctx context.Context, tunableReq Tunable, controlID *int) (*Tunable, error) {
+	u := url.URL{Path: "system_advanced_sysctl.php"}
+	v := url.Values{
+		"tunable": {tunableReq.Name},
+		"value":   {tunableReq.Value},
+		"descr":   {tunableReq.Description},
+		"save":    {"Save"},
+	}
+
+	if controlID != nil {
+		v.Set("id", strconv.Itoa(*controlID))
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	tunables, err := pf.getSystemTunables(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tunables.GetByName(tunableReq.Name)
+}
+
+func (pf *Client) CreateSystemTunable(ctx context.Context, tunableReq Tunable) (*Tunable, error) {
+	pf.mutexes.SystemTunable.Lock()
+	defer pf.mutexes.SystemTunable.Unlock()
+
+	tunable, err := pf.createOrUpdateSystemTunable(ctx, tunableReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunable, %w", ErrCreateOperationFailed, err)
+	}
+
+	return tunable, nil
+}
+
+func (pf *Client) UpdateSystemTunable(ctx context.Context, tunableReq Tunable) (*Tunable, error) {
+	pf.mutexes.SystemTunable.Lock()
+	defer pf.mutexes.SystemTunable.Unlock()
+
+	tunables, err := pf.getSystemTunables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunable, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := tunables.GetControlIDByName(tunableReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunable, %w", ErrUpdateOperationFailed, err)
+	}
+
+	tunable, err := pf.createOrUpdateSystemTunable(ctx, tunableReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w tunable, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return tunable, nil
+}
+
+func (pf *Client) DeleteSystemTunable(ctx context.Context, name string) error {
+	pf.mutexes.SystemTunable.Lock()
+	defer pf.mutexes.SystemTunable.Unlock()
+
+	tunables, err := pf.getSystemTunables(ctx)
+	if err != nil {
+		return fmt.Errorf("%w tunable, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := tunables.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w tunable, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_advanced_sysctl.php"}
+	v := url.Values{
+		"act": {"del"},
+		"id":  {strconv.Itoa(*controlID)},
+	}
+
+	_, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w tunable, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
+
+func (pf *Client) ApplySystemTunableChanges(ctx context.Context) error {
+	pf.mutexes.SystemTunableApply.Lock()
+	defer pf.mutexes.SystemTunableApply.Unlock()
+
+	u := url.URL{Path: "system_advanced_sysctl.php"}
+	v := url.Values{
+		"apply": {"Apply Changes"},
+	}
+
+	resp, err := pf.call(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w, %w", ErrApplySystemTunableChange, err)
+	}
+
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return nil
+}
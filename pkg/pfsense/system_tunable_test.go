@@ -0,0 +1,148 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestTunableSetName(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"net.inet.tcp.tso", true},
+		{"kern.ipc.maxsockbuf", true},
+		{"dev.igb.0.fc", true},
+		{"hw.igb.rx_process_limit", true},
+		{"", false},
+		{"net..inet", false},
+		{".net", false},
+		{"net.inet tcp", false},
+	}
+
+	for _, tt := range tests {
+		var tunable Tunable
+		err := tunable.SetName(tt.name)
+
+		if tt.valid && err != nil {
+			t.Errorf("SetName(%q) unexpected error, %s", tt.name, err)
+		}
+
+		if !tt.valid && !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetName(%q) expected client validation error, got %v", tt.name, err)
+		}
+	}
+}
+
+// testSystemTunableServer stores tunables submitted to system_advanced_sysctl.php the way the page does, tunables are
+// addressed by their position.
+func testSystemTunableServer(t *testing.T, stored *[]tunableResponse) *httptest.Server {
+	t.Helper()
+
+	var mutex sync.Mutex
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/system_advanced_sysctl.php", func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		id, idErr := strconv.Atoi(r.PostFormValue("id"))
+
+		switch {
+		case r.PostFormValue("act") == "del" && idErr == nil:
+			*stored = append((*stored)[:id], (*stored)[id+1:]...)
+		case r.PostFormValue("save") != "":
+			tunable := tunableResponse{Name: r.PostFormValue("tunable"), Value: r.PostFormValue("value"), Description: r.PostFormValue("descr")}
+			if idErr == nil {
+				(*stored)[id] = tunable
+			} else {
+				*stored = append(*stored, tunable)
+			}
+		}
+
+		fmt.Fprint(w, "<html><body></body></html>")
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		b, _ := json.Marshal(*stored)
+		return string(b)
+	}))
+
+	return httptest.NewServer(testPfSenseHandler(mux))
+}
+
+func TestSystemTunableLifecycle(t *testing.T) {
+	stored := []tunableResponse{{Name: "kern.ipc.maxsockbuf", Value: "4262144", Description: "existing"}}
+
+	server := testSystemTunableServer(t, &stored)
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	ctx := context.Background()
+
+	var tunableReq Tunable
+	_ = tunableReq.SetName("net.inet.tcp.tso")
+	_ = tunableReq.SetValue("0")
+	_ = tunableReq.SetDescription("disable TSO")
+
+	tunable, err := pf.CreateSystemTunable(ctx, tunableReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if *tunable != tunableReq {
+		t.Errorf("created %+v, want %+v", *tunable, tunableReq)
+	}
+
+	_ = tunableReq.SetValue("1")
+
+	tunable, err = pf.UpdateSystemTunable(ctx, tunableReq)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if tunable.Value != "1" {
+		t.Errorf("updated value = %q, want %q", tunable.Value, "1")
+	}
+
+	tunable, err = pf.GetSystemTunable(ctx, "net.inet.tcp.tso")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if tunable.Value != "1" || tunable.Description != "disable TSO" {
+		t.Errorf("unexpected tunable %+v", *tunable)
+	}
+
+	err = pf.DeleteSystemTunable(ctx, "net.inet.tcp.tso")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if _, err := pf.GetSystemTunable(ctx, "net.inet.tcp.tso"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected not found error after delete, got %v", err)
+	}
+
+	// the tunable that existed before is left untouched
+	tunable, err = pf.GetSystemTunable(ctx, "kern.ipc.maxsockbuf")
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if tunable.Value != "4262144" || tunable.Description != "existing" {
+		t.Errorf("unexpected tunable %+v", *tunable)
+	}
+}
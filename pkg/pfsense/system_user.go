@@ -0,0 +1,411 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+type userResponse struct {
+	Name       string   `json:"name"`
+	FullName   string   `json:"descr"`
+	Disabled   *string  `json:"disabled"`
+	Expiration string   `json:"expires"`
+	Groups     []string `json:"groups"`
+	Privileges []string `json:"priv"`
+	ControlID  int      `json:"controlID"`
+}
+
+type User struct {
+	Name       string
+	Password   string
+	FullName   string
+	Disabled   bool
+	Expiration string
+	Groups     []string
+	Privileges []string
+	controlID  int
+}
+
+func (user *User) SetName(name string) error {
+	var isValidName = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,32}$`).MatchString
+	if !isValidName(name) {
+		return fmt.Errorf("%w, username must be 1-32 letters, digits, '.', '-' or '_'", ErrClientValidation)
+	}
+
+	user.Name = name
+
+	return nil
+}
+
+// SetPassword sets the password to submit, an empty password leaves the current password unchanged on update.
+func (user *User) SetPassword(password string) error {
+	user.Password = password
+
+	return nil
+}
+
+func (user *User) SetFullName(fullName string) error {
+	user.FullName = fullName
+
+	return nil
+}
+
+func (user *User) SetDisabled(disabled bool) error {
+	user.Disabled = disabled
+
+	return nil
+}
+
+// SetExpiration sets the account expiration date (MM/DD/YYYY) as stored by pfSense, it is read only and kept unchanged
+// on update.
+func (user *User) SetExpiration(expiration string) error {
+	user.Expiration = expiration
+
+	return nil
+}
+
+func (user *User) SetGroups(groups []string) error {
+	var errs []error
+	for _, group := range groups {
+		if err := validateGroupName(group); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		return err
+	}
+
+	user.Groups = groups
+
+	return nil
+}
+
+func (user *User) SetPrivileges(privileges []string) error {
+	err := validatePrivileges(privileges)
+	if err != nil {
+		return err
+	}
+
+	user.Privileges = privileges
+
+	return nil
+}
+
+func validatePrivileges(privileges []string) error {
+	var isValidPrivilege = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`).MatchString
+	var errs []error
+	for _, privilege := range privileges {
+		if !isValidPrivilege(privilege) {
+			errs = append(errs, fmt.Errorf("%w, privilege '%s' is not a valid privilege name (e.g. 'page-all')", ErrClientValidation, privilege))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type Users []User
+
+func (users Users) GetByName(name string) (*User, error) {
+	for _, user := range users {
+		if user.Name == name {
+			return &user, nil
+		}
+	}
+	return nil, fmt.Errorf("user %w with name '%s'", ErrNotFound, name)
+}
+
+func (users Users) GetControlIDByName(name string) (*int, error) {
+	return getControlID(users, func(user User) bool { return user.Name == name },
+		func(_ int, user User) int { return user.controlID }, "user", fmt.Sprintf("name '%s'", name))
+}
+
+func (pf *Client) getUsers(ctx context.Context) (*Users, error) {
+	command := "$output = array();" +
+		"foreach ($config['system']['user'] as $k => $v) {" +
+		"array_push($output, array('name' => $v['name'], 'descr' => $v['descr'], 'disabled' => $v['disabled'], 'expires' => $v['expires'], " +
+		"'groups' => local_user_get_groups($v), 'priv' => $v['priv'], 'controlID' => $k));" +
+		"}" +
+		"print_r(json_encode($output));"
+
+	b, err := pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	var userResp []userResponse
+	err = json.Unmarshal(b, &userResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %w", ErrUnableToParse, err)
+	}
+
+	var users Users
+	for _, resp := range userResp {
+		var user User
+		var err error
+
+		err = user.SetName(resp.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w user response, %w", ErrUnableToParse, err)
+		}
+
+		err = user.SetFullName(resp.FullName)
+		if err != nil {
+			return nil, fmt.Errorf("%w user response, %w", ErrUnableToParse, err)
+		}
+
+		err = user.SetDisabled(resp.Disabled != nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w user response, %w", ErrUnableToParse, err)
+		}
+
+		err = user.SetExpiration(resp.Expiration)
+		if err != nil {
+			return nil, fmt.Errorf("%w user response, %w", ErrUnableToParse, err)
+		}
+
+		err = user.SetGroups(resp.Groups)
+		if err != nil {
+			return nil, fmt.Errorf("%w user response, %w", ErrUnableToParse, err)
+		}
+
+		err = user.SetPrivileges(resp.Privileges)
+		if err != nil {
+			return nil, fmt.Errorf("%w user response, %w", ErrUnableToParse, err)
+		}
+
+		user.controlID = resp.ControlID
+
+		users = append(users, user)
+	}
+
+	return &users, nil
+}
+
+func (pf *Client) GetUsers(ctx context.Context) (*Users, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	users, err := pf.getUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w users, %w", ErrGetOperationFailed, err)
+	}
+
+	return users, nil
+}
+
+func (pf *Client) GetUser(ctx context.Context, name string) (*User, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	users, err := pf.getUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w user (name '%s'), %w", ErrGetOperationFailed, name, err)
+	}
+
+	return users.GetByName(name)
+}
+
+// reconcilePrivileges adds missing privileges in one submission and removes extra privileges one at a time, highest
+// index first so the remaining indexes stay valid.
+func reconcilePrivileges(ctx context.Context, current []string, desired []string, add func(context.Context, []string) error, remove func(context.Context, int) error) error {
+	var missing []string
+	for _, privilege := range desired {
+		if !slices.Contains(current, privilege) && !slices.Contains(missing, privilege) {
+			missing = append(missing, privilege)
+		}
+	}
+
+	for i := len(current) - 1; i >= 0; i-- {
+		if slices.Contains(desired, current[i]) {
+			continue
+		}
+
+		err := remove(ctx, i)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return add(ctx, missing)
+}
+
+func (pf *Client) submitPrivileges(ctx context.Context, path string, idKey string, controlID int, privileges []string) error {
+	u := url.URL{Path: path}
+	q := u.Query()
+	q.Set(idKey, strconv.Itoa(controlID))
+	u.RawQuery = q.Encode()
+
+	v := url.Values{
+		idKey:        {strconv.Itoa(controlID)},
+		"sysprivs[]": privileges,
+		"save":       {"Save"},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return err
+	}
+
+	return scrapeHTMLValidationErrors(doc)
+}
+
+func (pf *Client) createOrUpdateUser(ctx context.Context, userReq User, controlID *int) (*User, error) {
+	u := url.URL{Path: "system_usermanager.php"}
+	q := u.Query()
+	if controlID != nil {
+		q.Set("act", "edit")
+		q.Set("userid", strconv.Itoa(*controlID))
+	} else {
+		q.Set("act", "new")
+	}
+	u.RawQuery = q.Encode()
+
+	// the page saves every field, start from the current form values to leave unmanaged settings unchanged
+	doc, err := pf.callHTML(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	v := scrapeHTMLFormValues(doc)
+	v.Set("usernamefld", userReq.Name)
+	v.Set("passwordfld1", userReq.Password)
+	v.Set("passwordfld2", userReq.Password)
+	v.Set("descr", userReq.FullName)
+	v["groups[]"] = userReq.Groups
+	v.Set("save", "Save")
+
+	setFormCheckbox(v, "disabled", userReq.Disabled)
+
+	if controlID != nil {
+		v.Set("userid", strconv.Itoa(*controlID))
+	}
+
+	doc, err = pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := pf.getUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := users.GetByName(userReq.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = reconcilePrivileges(ctx, user.Privileges, userReq.Privileges,
+		func(ctx context.Context, privileges []string) error {
+			return pf.submitPrivileges(ctx, "system_usermanager_addprivs.php", "userid", user.controlID, privileges)
+		},
+		func(ctx context.Context, i int) error {
+			_, err := pf.callHTML(ctx, http.MethodPost, url.URL{Path: "system_usermanager.php"}, &url.Values{
+				"act":    {"delprivid"},
+				"userid": {strconv.Itoa(user.controlID)},
+				"privid": {strconv.Itoa(i)},
+			})
+			return err
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err = pf.getUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.GetByName(userReq.Name)
+}
+
+func (pf *Client) CreateUser(ctx context.Context, userReq User) (*User, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	if userReq.Password == "" {
+		return nil, fmt.Errorf("%w user, %w", ErrCreateOperationFailed, fmt.Errorf("%w, password required", ErrClientValidation))
+	}
+
+	user, err := pf.createOrUpdateUser(ctx, userReq, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w user, %w", ErrCreateOperationFailed, err)
+	}
+
+	return user, nil
+}
+
+func (pf *Client) UpdateUser(ctx context.Context, userReq User) (*User, error) {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	users, err := pf.getUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w user, %w", ErrUpdateOperationFailed, err)
+	}
+
+	controlID, err := users.GetControlIDByName(userReq.Name)
+	if err != nil {
+		return nil, fmt.Errorf("%w user, %w", ErrUpdateOperationFailed, err)
+	}
+
+	user, err := pf.createOrUpdateUser(ctx, userReq, controlID)
+	if err != nil {
+		return nil, fmt.Errorf("%w user, %w", ErrUpdateOperationFailed, err)
+	}
+
+	return user, nil
+}
+
+func (pf *Client) DeleteUser(ctx context.Context, name string) error {
+	pf.mutexes.SystemUserManager.Lock()
+	defer pf.mutexes.SystemUserManager.Unlock()
+
+	users, err := pf.getUsers(ctx)
+	if err != nil {
+		return fmt.Errorf("%w user, %w", ErrDeleteOperationFailed, err)
+	}
+
+	controlID, err := users.GetControlIDByName(name)
+	if err != nil {
+		return fmt.Errorf("%w user, %w", ErrDeleteOperationFailed, err)
+	}
+
+	u := url.URL{Path: "system_usermanager.php"}
+	v := url.Values{
+		"act":      {"deluser"},
+		"userid":   {strconv.Itoa(*controlID)},
+		"username": {name},
+	}
+
+	doc, err := pf.callHTML(ctx, http.MethodPost, u, &v)
+	if err != nil {
+		return fmt.Errorf("%w user, %w", ErrDeleteOperationFailed, err)
+	}
+
+	err = scrapeHTMLValidationErrors(doc)
+	if err != nil {
+		return fmt.Errorf("%w user, %w", ErrDeleteOperationFailed, err)
+	}
+
+	return nil
+}
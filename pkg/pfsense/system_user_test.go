@@ -0,0 +1,86 @@
+package pfsense
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestUserValidation(t *testing.T) {
+	var user User
+
+	for _, name := range []string{"", "has space", "a23456789012345678901234567890123"} {
+		if err := user.SetName(name); !errors.Is(err, ErrClientValidation) {
+			t.Errorf("SetName(%q) expected client validation error, got %v", name, err)
+		}
+	}
+
+	if err := user.SetGroups([]string{"admins", "bad group", "ops"}); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error for group name, got %v", err)
+	}
+
+	if err := user.SetPrivileges([]string{"page-all", "user-shell-access", "page all"}); !errors.Is(err, ErrClientValidation) {
+		t.Errorf("expected client validation error for privilege name, got %v", err)
+	}
+
+	if err := user.SetPrivileges([]string{"page-all", "user-shell-access"}); err != nil {
+		t.Errorf("unexpected error, %s", err)
+	}
+}
+
+func TestReconcilePrivileges(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []string
+		desired     []string
+		wantAdded   []string
+		wantRemoved []int
+	}{
+		{"unchanged", []string{"page-all"}, []string{"page-all"}, nil, nil},
+		{"add", nil, []string{"page-all", "user-shell-access"}, []string{"page-all", "user-shell-access"}, nil},
+		{"add once", []string{"page-all"}, []string{"page-all", "user-shell-access", "user-shell-access"}, []string{"user-shell-access"}, nil},
+		{"remove highest index first", []string{"a", "b", "c", "d"}, []string{"b"}, nil, []int{3, 2, 0}},
+		{"replace", []string{"a", "b"}, []string{"b", "c"}, []string{"c"}, []int{0}},
+	}
+
+	for _, tt := range tests {
+		var added []string
+		var removed []int
+
+		err := reconcilePrivileges(context.Background(), tt.current, tt.desired,
+			func(_ context.Context, privileges []string) error {
+				added = append(added, privileges...)
+				return nil
+			},
+			func(_ context.Context, i int) error {
+				removed = append(removed, i)
+				return nil
+			},
+		)
+		if err != nil {
+			t.Fatalf("%s: unexpected error, %s", tt.name, err)
+		}
+
+		if !slices.Equal(added, tt.wantAdded) {
+			t.Errorf("%s: added %v, want %v", tt.name, added, tt.wantAdded)
+		}
+
+		if !slices.Equal(removed, tt.wantRemoved) {
+			t.Errorf("%s: removed %v, want %v", tt.name, removed, tt.wantRemoved)
+		}
+	}
+
+	// a failed removal stops before privileges are added
+	wantErr := errors.New("remove failed")
+	err := reconcilePrivileges(context.Background(), []string{"a"}, []string{"b"},
+		func(context.Context, []string) error {
+			t.Error("unexpected add")
+			return nil
+		},
+		func(context.Context, int) error { return wantErr },
+	)
+	if !errors.Is(err, wantErr) {
+		t.Errorf("expected remove error, got %v", err)
+	}
+}
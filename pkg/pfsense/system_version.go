@@ -7,13 +7,49 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
-type SystemVersion struct {
+type systemVersionResponse struct {
 	Current string `json:"installed_version"`
 	Latest  string `json:"version"`
 }
 
+type systemVersionDetailsResponse struct {
+	Platform       string `json:"platform"`
+	Kernel         string `json:"kernel"`
+	ConfigRevision string `json:"config_revision"`
+}
+
+type SystemVersion struct {
+	Current        string
+	Latest         string
+	Platform       string
+	Kernel         string
+	ConfigRevision int64
+}
+
+func parseSystemVersionResponse(versionResp systemVersionResponse, detailsResp systemVersionDetailsResponse) (*SystemVersion, error) {
+	var version SystemVersion
+
+	// development snapshots report versions such as '2.8.0.a.20240101.0600', keep them verbatim
+	version.Current = strings.TrimSpace(versionResp.Current)
+	version.Latest = strings.TrimSpace(versionResp.Latest)
+	version.Platform = strings.TrimSpace(detailsResp.Platform)
+	version.Kernel = strings.TrimSpace(detailsResp.Kernel)
+
+	if detailsResp.ConfigRevision != "" {
+		revision, err := strconv.ParseInt(detailsResp.ConfigRevision, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w, config revision '%s'", ErrUnableToParse, detailsResp.ConfigRevision)
+		}
+		version.ConfigRevision = revision
+	}
+
+	return &version, nil
+}
+
 func (pf *Client) GetSystemVersion(ctx context.Context) (*SystemVersion, error) {
 	u := url.URL{Path: "pkg_mgr_install.php"}
 	v := url.Values{
@@ -38,11 +74,33 @@ func (pf *Client) GetSystemVersion(ctx context.Context) (*SystemVersion, error)
 		return nil, fmt.Errorf("%w system version, %w", ErrGetOperationFailed, err)
 	}
 
-	var r SystemVersion
-	err = json.Unmarshal(b, &r)
+	var versionResp systemVersionResponse
+	err = json.Unmarshal(b, &versionResp)
 	if err != nil {
 		return nil, fmt.Errorf("%w system version response as JSON, %w", ErrUnableToParse, err)
 	}
 
-	return &r, nil
+	command := "$platform = system_identify_specific_platform();" +
+		"print_r(json_encode(array(" +
+		"'platform' => $platform['descr']," +
+		"'kernel' => php_uname('r')," +
+		"'config_revision' => (string) $config['revision']['time'])));"
+
+	b, err = pf.runPHPCommand(ctx, command)
+	if err != nil {
+		return nil, fmt.Errorf("%w system version, %w", ErrGetOperationFailed, err)
+	}
+
+	var detailsResp systemVersionDetailsResponse
+	err = json.Unmarshal(b, &detailsResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w system version details response as JSON, %w", ErrUnableToParse, err)
+	}
+
+	version, err := parseSystemVersionResponse(versionResp, detailsResp)
+	if err != nil {
+		return nil, fmt.Errorf("%w system version, %w", ErrGetOperationFailed, err)
+	}
+
+	return version, nil
 }
@@ -0,0 +1,99 @@
+package pfsense
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testSystemVersionResponse = `{"installed_version":"2.7.2","version":"2.7.2","pkg_version_compare":"="}`
+
+const testSystemVersionDetailsResponse = `{"platform":"Netgate 4100","kernel":"14.0-CURRENT","config_revision":"1718000000"}`
+
+func TestParseSystemVersionResponse(t *testing.T) {
+	var versionResp systemVersionResponse
+	var detailsResp systemVersionDetailsResponse
+	if err := json.Unmarshal([]byte(testSystemVersionResponse), &versionResp); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if err := json.Unmarshal([]byte(testSystemVersionDetailsResponse), &detailsResp); err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	version, err := parseSystemVersionResponse(versionResp, detailsResp)
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	want := SystemVersion{
+		Current:        "2.7.2",
+		Latest:         "2.7.2",
+		Platform:       "Netgate 4100",
+		Kernel:         "14.0-CURRENT",
+		ConfigRevision: 1718000000,
+	}
+
+	if *version != want {
+		t.Errorf("parsed %+v, want %+v", *version, want)
+	}
+}
+
+func TestParseSystemVersionResponseDevSnapshot(t *testing.T) {
+	versionResp := systemVersionResponse{Current: "2.8.0.a.20240101.0600", Latest: "2.8.0.a.20240102.0600\n"}
+
+	version, err := parseSystemVersionResponse(versionResp, systemVersionDetailsResponse{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if version.Current != "2.8.0.a.20240101.0600" || version.Latest != "2.8.0.a.20240102.0600" {
+		t.Errorf("parsed %+v, want development snapshot versions kept verbatim", *version)
+	}
+
+	if version.ConfigRevision != 0 {
+		t.Errorf("config revision = %d, want 0 when missing", version.ConfigRevision)
+	}
+}
+
+func TestParseSystemVersionResponseInvalidRevision(t *testing.T) {
+	_, err := parseSystemVersionResponse(systemVersionResponse{}, systemVersionDetailsResponse{ConfigRevision: "yesterday"})
+	if !errors.Is(err, ErrUnableToParse) {
+		t.Errorf("expected unable to parse error, got %v", err)
+	}
+}
+
+func TestGetSystemVersion(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pkg_mgr_install.php", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.PostFormValue("getversion") != "yes" {
+			t.Errorf("unexpected version request, %s %v", r.Method, r.PostForm)
+		}
+
+		fmt.Fprint(w, testSystemVersionResponse)
+	})
+	mux.Handle("/diag_command.php", testPHPCommandHandler(func(string) string {
+		return testSystemVersionDetailsResponse
+	}))
+
+	server := httptest.NewServer(testPfSenseHandler(mux))
+	defer server.Close()
+
+	pf, err := newTestClient(t, server.URL, Options{})
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	version, err := pf.GetSystemVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error, %s", err)
+	}
+
+	if version.Current == "" || version.Latest == "" || version.Platform == "" || version.Kernel == "" || version.ConfigRevision == 0 {
+		t.Errorf("expected every field to be populated, got %+v", *version)
+	}
+}